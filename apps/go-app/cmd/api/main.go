@@ -1,15 +1,33 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 	_ "user-review-ingest/docs" // <-- import generated docs package
+	"user-review-ingest/internal/application/modules"
 	"user-review-ingest/internal/infrastructure/config"
 	"user-review-ingest/internal/infrastructure/database"
 	"user-review-ingest/internal/infrastructure/http/router"
+	"user-review-ingest/internal/infrastructure/messaging"
+	"user-review-ingest/internal/infrastructure/messaging/logging"
+	"user-review-ingest/internal/infrastructure/messaging/memory"
 	"user-review-ingest/internal/infrastructure/observability"
+	"user-review-ingest/internal/infrastructure/storage"
+	"user-review-ingest/internal/infrastructure/storage/local"
+	"user-review-ingest/internal/infrastructure/storage/s3"
+
+	"github.com/rs/zerolog"
 )
 
+// shutdownTimeout bounds how long in-flight requests may take to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
 // @title User Review Ingest API
 // @version 1.0
 // @description This is a sample server for a user review ingestion service.
@@ -24,6 +42,11 @@ import (
 
 // @host localhost:8080
 // @BasePath /
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description HS256-signed JWT prefixed with "Bearer ". The "sub" claim carries the numeric user ID and "tenant" the tenant slug.
 func main() {
 	// Initialize logger
 	logger := observability.NewLogger()
@@ -42,13 +65,134 @@ func main() {
 	}
 	defer db.Close()
 
+	// Initialize blob storage
+	blobStore, err := newBlobStore(cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize blob storage")
+	}
+	exportStore, err := newExportStore(cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize export storage")
+	}
+
+	// Initialize domain event publishing
+	publisher, err := newPublisher(cfg, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize event publisher")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Send webhook deliveries in the background
+	webhookDispatcher := modules.NewWebhookDispatcher(db, nil, logger, cfg)
+	go webhookDispatcher.Run(ctx)
+
+	// Relay outbox events in the background, queueing webhook deliveries last
+	// so that a failure there does not leave queued deliveries behind
+	go modules.NewOutboxRelay(db, messaging.NewMultiPublisher(publisher, webhookDispatcher), logger, cfg).Run(ctx)
+
+	// Push review changes committed by any instance to stream subscribers
+	reviewStream := modules.NewReviewStream(db, logger, cfg)
+	go reviewStream.Run(ctx)
+
+	// Wake long-polling change feed readers on changes committed by any instance
+	changeFeed := modules.NewChangeFeed(db, logger, cfg)
+	go changeFeed.Run(ctx)
+
+	// Produce queued review exports in the background
+	go modules.NewReviewExportWorker(db, exportStore, logger, cfg).Run(ctx)
+
+	// Enforce review velocity limits, pruning expired counters in the background
+	velocityLimiter := modules.NewVelocityLimiter(db, logger, cfg)
+	go velocityLimiter.Run(ctx)
+
+	// Publish reviews at the end of their cooling-off period in the background
+	go modules.NewReviewPublisher(db, logger, cfg).Run(ctx)
+
+	// Watch products for review-bombing in the background
+	go modules.NewRatingAnomalyDetector(db, logger, cfg).Run(ctx)
+
 	// Setup router
-	r := router.SetupRouter(db, logger, cfg)
+	r := router.SetupRouter(db, blobStore, exportStore, reviewStream, changeFeed, velocityLimiter, logger, cfg)
 
 	// Start server
 	addr := fmt.Sprintf(":%d", cfg.Port)
+	server := &http.Server{Addr: addr, Handler: r}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error().Err(err).Msg("Failed to shut down server gracefully")
+		}
+	}()
+
 	logger.Info().Msgf("Server starting on %s", addr)
-	if err := http.ListenAndServe(addr, r); err != nil {
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		logger.Fatal().Err(err).Msg("Failed to start server")
 	}
+	logger.Info().Msg("Server stopped")
+}
+
+func newPublisher(cfg *config.Config, logger *zerolog.Logger) (messaging.Publisher, error) {
+	switch cfg.EventPublisher {
+	case "log":
+		return logging.NewPublisher(logger), nil
+	case "memory":
+		return memory.NewPublisher(), nil
+	default:
+		return nil, fmt.Errorf("unknown EVENT_PUBLISHER %q", cfg.EventPublisher)
+	}
+}
+
+func newBlobStore(cfg *config.Config) (storage.BlobStore, error) {
+	switch cfg.BlobStore {
+	case "local":
+		publicBaseURL := cfg.BlobPublicBaseURL
+		if publicBaseURL == "" {
+			publicBaseURL = "/media"
+		}
+		return local.NewStore(cfg.BlobLocalDir, publicBaseURL)
+	case "s3":
+		return s3.NewStore(s3.Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+			PublicBaseURL:   cfg.BlobPublicBaseURL,
+		}, nil)
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", cfg.BlobStore)
+	}
+}
+
+// newExportStore returns the store for queued exports. They are only served
+// through the authenticated download endpoint, so they are kept apart from
+// review media, which may be public: in their own directory locally and in
+// their own bucket on S3.
+func newExportStore(cfg *config.Config) (storage.BlobStore, error) {
+	switch cfg.BlobStore {
+	case "local":
+		return local.NewStore(cfg.ExportLocalDir, "")
+	case "s3":
+		if cfg.ExportS3Bucket == "" {
+			return nil, fmt.Errorf("EXPORT_S3_BUCKET is required with BLOB_STORE=s3")
+		}
+		if cfg.ExportS3Bucket == cfg.S3Bucket {
+			return nil, fmt.Errorf("EXPORT_S3_BUCKET must differ from S3_BUCKET, which holds review media")
+		}
+		return s3.NewStore(s3.Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.ExportS3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+		}, nil)
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", cfg.BlobStore)
+	}
 }
@@ -0,0 +1,41 @@
+package dto
+
+type CategoryAspectInputDTO struct {
+	Key  string `json:"key" binding:"required,max=64"`
+	Name string `json:"name" binding:"required,max=255"`
+	// Required aspects must be rated by every new review
+	Required bool `json:"required"`
+}
+
+// CategoryInputDTO creates a category or replaces every attribute of one.
+// Aspects are matched by key: ratings of kept aspects are preserved, those
+// of removed aspects are deleted.
+type CategoryInputDTO struct {
+	Slug    string                   `json:"slug" binding:"required,max=255"`
+	Name    string                   `json:"name" binding:"required,max=500"`
+	Aspects []CategoryAspectInputDTO `json:"aspects" binding:"max=20,unique=Key,dive"`
+	// RatingScale overrides the tenant's rating scale for reviews of the
+	// category's products; omit to use the tenant's
+	RatingScale *RatingScaleDTO `json:"rating_scale,omitempty"`
+}
+
+type ListCategoriesQuery struct {
+	Offset int `form:"offset,default=0" binding:"min=0"`
+	Limit  int `form:"limit,default=50" binding:"min=1,max=100"`
+}
+
+type CategoryAspectDTO struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+}
+
+type CategoryDTO struct {
+	ID          int64                `json:"id"`
+	Slug        string               `json:"slug"`
+	Name        string               `json:"name"`
+	Aspects     []*CategoryAspectDTO `json:"aspects,omitempty"`
+	RatingScale *RatingScaleDTO      `json:"rating_scale,omitempty"`
+	CreatedAt   string               `json:"created_at"`
+	UpdatedAt   string               `json:"updated_at"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+type ListChangesQuery struct {
+	// Since is the next_token of the previous page; empty starts from the beginning
+	Since string `form:"since"`
+	Limit int    `form:"limit,default=100" binding:"min=1,max=1000"`
+	// Wait holds the request open for up to this many seconds until a change arrives
+	Wait int `form:"wait,default=0" binding:"min=0,max=60"`
+}
+
+type ReviewChangeDTO struct {
+	Seq int64 `json:"seq"`
+	// Operation is insert, update or delete. A delete is a tombstone: the
+	// review is gone or no longer visible to the caller, and carries no review.
+	Operation string     `json:"operation"`
+	ReviewID  int64      `json:"review_id"`
+	ChangedAt string     `json:"changed_at"`
+	Review    *ReviewDTO `json:"review,omitempty"`
+}
+
+type ChangesPageDTO struct {
+	Changes []*ReviewChangeDTO `json:"changes"`
+	// NextToken resumes the feed after the last change of the page
+	NextToken string `json:"next_token"`
+	// HasMore is set when more changes are ready to be read straight away
+	HasMore bool `json:"has_more"`
+}
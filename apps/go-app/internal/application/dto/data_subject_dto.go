@@ -0,0 +1,132 @@
+package dto
+
+// DataSubjectExportQuery names the auth account, if any, to export along
+// with a user's reviews and orders.
+type DataSubjectExportQuery struct {
+	AccountID *string `form:"account_id" binding:"omitempty,uuid"`
+}
+
+// EraseDataSubjectDTO requests the erasure of a user's personal data.
+type EraseDataSubjectDTO struct {
+	// AccountID is the auth account whose credentials, profiles and OAuth
+	// connections are deleted as well
+	AccountID *string `json:"account_id,omitempty" binding:"omitempty,uuid"`
+	// ReviewPolicy anonymizes the user's reviews, keeping them published, or
+	// deletes them; defaults to anonymize
+	ReviewPolicy string `json:"review_policy,omitempty" binding:"omitempty,oneof=anonymize delete"`
+}
+
+// DataSubjectRequestDTO records a fulfilled export or erasure.
+type DataSubjectRequestDTO struct {
+	ID            int64            `json:"id"`
+	Kind          string           `json:"kind"`
+	UserID        int64            `json:"user_id"`
+	AccountID     *string          `json:"account_id,omitempty"`
+	RequestedBy   string           `json:"requested_by"`
+	ReviewPolicy  *string          `json:"review_policy,omitempty"`
+	Summary       map[string]int64 `json:"summary"`
+	ArchiveSHA256 *string          `json:"archive_sha256,omitempty"`
+	FulfilledAt   string           `json:"fulfilled_at"`
+}
+
+// DataSubjectArchiveDTO is the downloadable bundle of everything stored about
+// a user.
+type DataSubjectArchiveDTO struct {
+	UserID          int64                    `json:"user_id"`
+	GeneratedAt     string                   `json:"generated_at"`
+	Account         *ArchivedAccountDTO      `json:"account,omitempty"`
+	Reviews         []*ArchivedReviewDTO     `json:"reviews"`
+	Votes           []*ArchivedVoteDTO       `json:"votes"`
+	Reports         []*ReviewReportDTO       `json:"reports"`
+	Replies         []*ArchivedReplyDTO      `json:"replies"`
+	Questions       []*ArchivedQuestionDTO   `json:"questions"`
+	Answers         []*ArchivedAnswerDTO     `json:"answers"`
+	AnswerVotes     []*ArchivedAnswerVoteDTO `json:"answer_votes"`
+	Attachments     []*ArchivedAttachmentDTO `json:"attachments"`
+	Orders          []*ArchivedOrderDTO      `json:"orders"`
+	Invitations     []*ReviewInvitationDTO   `json:"review_invitations"`
+	Reviewer        *ReviewerProfileDTO      `json:"reviewer_profile,omitempty"`
+	OwnedProductIDs []int64                  `json:"owned_product_ids"`
+	ExportJobs      []*ExportJobDTO          `json:"export_jobs"`
+}
+
+type ArchivedAccountDTO struct {
+	ID          string                   `json:"id"`
+	Email       string                   `json:"email"`
+	Status      string                   `json:"status"`
+	CreatedAt   string                   `json:"created_at"`
+	UpdatedAt   string                   `json:"updated_at"`
+	Profiles    []*ArchivedProfileDTO    `json:"profiles"`
+	Connections []*ArchivedConnectionDTO `json:"connections"`
+}
+
+type ArchivedProfileDTO struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ArchivedConnectionDTO describes a linked OAuth provider. Its tokens are
+// credentials, not personal data, and are left out.
+type ArchivedConnectionDTO struct {
+	Provider       string   `json:"provider"`
+	ProviderUserID string   `json:"provider_user_id"`
+	Email          string   `json:"email"`
+	Name           string   `json:"name"`
+	Scopes         []string `json:"scopes"`
+	CreatedAt      string   `json:"created_at"`
+}
+
+type ArchivedReviewDTO struct {
+	ReviewDTO
+	DeletedAt string `json:"deleted_at,omitempty"`
+}
+
+type ArchivedVoteDTO struct {
+	ReviewID  int64  `json:"review_id"`
+	Helpful   bool   `json:"helpful"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type ArchivedReplyDTO struct {
+	ReviewReplyDTO
+	ReviewID  int64  `json:"review_id"`
+	DeletedAt string `json:"deleted_at,omitempty"`
+}
+
+type ArchivedAttachmentDTO struct {
+	ReviewAttachmentDTO
+	ReviewID  int64  `json:"review_id"`
+	DeletedAt string `json:"deleted_at,omitempty"`
+}
+
+type ArchivedOrderDTO struct {
+	OrderID     string `json:"order_id"`
+	ProductID   int64  `json:"product_id"`
+	PurchasedAt string `json:"purchased_at"`
+}
+
+type ArchivedQuestionDTO struct {
+	ID        int64  `json:"id"`
+	ProductID int64  `json:"product_id"`
+	Body      string `json:"body"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	DeletedAt string `json:"deleted_at,omitempty"`
+}
+
+type ArchivedAnswerDTO struct {
+	ProductAnswerDTO
+	DeletedAt string `json:"deleted_at,omitempty"`
+}
+
+type ArchivedAnswerVoteDTO struct {
+	AnswerID  int64  `json:"answer_id"`
+	Helpful   bool   `json:"helpful"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
@@ -4,3 +4,16 @@ package dto
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// PolicyViolationDTO names a rule of a review policy a review breaks.
+type PolicyViolationDTO struct {
+	Rule    string `json:"rule" example:"min_comment_length"`
+	Message string `json:"message" example:"comment must be at least 50 characters long"`
+}
+
+// PolicyViolationResponse is the error returned for a review breaking its
+// product's review policy, listing every rule it breaks.
+type PolicyViolationResponse struct {
+	Error      string               `json:"error"`
+	Violations []PolicyViolationDTO `json:"violations"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+// ExportReviewsQuery selects the reviews of an export and how it is encoded.
+// The filters are those of the review list.
+type ExportReviewsQuery struct {
+	Format string `form:"format,default=csv" binding:"oneof=csv ndjson parquet"`
+	// Gzip compresses CSV and NDJSON as a whole and Parquet page by page
+	Gzip              bool    `form:"gzip"`
+	Verified          *bool   `form:"verified"`
+	ProductID         *int64  `form:"product_id"`
+	Status            *string `form:"status" binding:"omitempty,oneof=pending approved rejected"`
+	Sentiment         *string `form:"sentiment" binding:"omitempty,oneof=positive neutral negative"`
+	SentimentMismatch *bool   `form:"sentiment_mismatch"`
+	Lang              *string `form:"lang" binding:"omitempty,bcp47_language_tag"`
+	Published         *bool   `form:"published"`
+}
+
+// CreateExportJobDTO requests an export to be produced in the background.
+type CreateExportJobDTO struct {
+	Format            string  `json:"format" binding:"required,oneof=csv ndjson parquet"`
+	Gzip              bool    `json:"gzip"`
+	Verified          *bool   `json:"verified,omitempty"`
+	ProductID         *int64  `json:"product_id,omitempty"`
+	Status            *string `json:"status,omitempty" binding:"omitempty,oneof=pending approved rejected"`
+	Sentiment         *string `json:"sentiment,omitempty" binding:"omitempty,oneof=positive neutral negative"`
+	SentimentMismatch *bool   `json:"sentiment_mismatch,omitempty"`
+	Lang              *string `json:"lang,omitempty" binding:"omitempty,bcp47_language_tag"`
+	Published         *bool   `json:"published,omitempty"`
+}
+
+type ExportFiltersDTO struct {
+	Verified          *bool   `json:"verified,omitempty"`
+	ProductID         *int64  `json:"product_id,omitempty"`
+	Status            *string `json:"status,omitempty"`
+	Sentiment         *string `json:"sentiment,omitempty"`
+	SentimentMismatch *bool   `json:"sentiment_mismatch,omitempty"`
+	// Lang is the ISO 639 language the export is limited to
+	Lang      *string `json:"lang,omitempty"`
+	Published *bool   `json:"published,omitempty"`
+}
+
+type ExportJobDTO struct {
+	ID      int64            `json:"id"`
+	Format  string           `json:"format"`
+	Gzip    bool             `json:"gzip"`
+	Filters ExportFiltersDTO `json:"filters"`
+	// Status is one of queued, running, succeeded and failed
+	Status     string  `json:"status"`
+	RowCount   *int64  `json:"row_count,omitempty"`
+	SizeBytes  *int64  `json:"size_bytes,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+	StartedAt  string  `json:"started_at,omitempty"`
+	FinishedAt string  `json:"finished_at,omitempty"`
+	// FileName is the name the export downloads as
+	FileName string `json:"file_name"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type OrderDTO struct {
+	OrderID     string    `json:"order_id" binding:"required,max=255"`
+	CustomerID  int64     `json:"customer_id" binding:"required"`
+	ProductIDs  []int64   `json:"product_ids" binding:"required,min=1,max=500"`
+	PurchasedAt time.Time `json:"purchased_at" binding:"required"`
+}
+
+type BulkOrdersDTO struct {
+	Orders []OrderDTO `json:"orders" binding:"required,min=1,max=1000,dive"`
+}
+
+type OrderIngestResultDTO struct {
+	OrdersIngested  int   `json:"orders_ingested"`
+	ReviewsVerified int64 `json:"reviews_verified"`
+}
@@ -0,0 +1,96 @@
+package dto
+
+type CreateProductDTO struct {
+	SKU        string  `json:"sku" binding:"required,max=255"`
+	ExternalID *string `json:"external_id,omitempty" binding:"omitempty,max=255"`
+	Name       string  `json:"name" binding:"required,max=500"`
+	GroupID    *int64  `json:"group_id,omitempty"`
+	// CategoryID sets the aspects reviews of the product rate
+	CategoryID *int64 `json:"category_id,omitempty"`
+}
+
+// UpdateProductDTO replaces every editable attribute of a product.
+type UpdateProductDTO struct {
+	SKU        string  `json:"sku" binding:"required,max=255"`
+	ExternalID *string `json:"external_id,omitempty" binding:"omitempty,max=255"`
+	Name       string  `json:"name" binding:"required,max=500"`
+	GroupID    *int64  `json:"group_id,omitempty"`
+	CategoryID *int64  `json:"category_id,omitempty"`
+	Archived   bool    `json:"archived"`
+	// HoldNewReviews queues every new review of the product for moderation
+	HoldNewReviews bool `json:"hold_new_reviews"`
+}
+
+// ProductOwnerInputDTO names a user to act on behalf of the product's brand.
+type ProductOwnerInputDTO struct {
+	UserID int64 `json:"user_id" binding:"required"`
+}
+
+type ProductOwnerDTO struct {
+	UserID    int64  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ListProductsQuery struct {
+	Offset          int    `form:"offset,default=0" binding:"min=0"`
+	Limit           int    `form:"limit,default=50" binding:"min=1,max=100"`
+	IncludeArchived bool   `form:"include_archived"`
+	GroupID         *int64 `form:"group_id"`
+}
+
+type ProductDTO struct {
+	ID             int64   `json:"id"`
+	SKU            string  `json:"sku"`
+	ExternalID     *string `json:"external_id,omitempty"`
+	Name           string  `json:"name"`
+	GroupID        *int64  `json:"group_id,omitempty"`
+	CategoryID     *int64  `json:"category_id,omitempty"`
+	Archived       bool    `json:"archived"`
+	ArchivedAt     string  `json:"archived_at,omitempty"`
+	HoldNewReviews bool    `json:"hold_new_reviews"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+type ProductSyncItemDTO struct {
+	SKU        string  `json:"sku" binding:"required,max=255"`
+	ExternalID *string `json:"external_id,omitempty" binding:"omitempty,max=255"`
+	Name       string  `json:"name" binding:"required,max=500"`
+	// GroupSKU names the primary product of the variant group, if any
+	GroupSKU *string `json:"group_sku,omitempty" binding:"omitempty,max=255"`
+}
+
+type ProductSyncDTO struct {
+	Products []ProductSyncItemDTO `json:"products" binding:"required,min=1,max=5000,dive"`
+	// ArchiveMissing archives live products absent from this sync
+	ArchiveMissing bool `json:"archive_missing"`
+}
+
+type ProductSyncResultDTO struct {
+	Upserted int   `json:"upserted"`
+	Archived int64 `json:"archived"`
+}
+
+// ProductSummaryDTO aggregates the approved reviews of a product and its
+// variants.
+type ProductSummaryDTO struct {
+	ProductID     int64   `json:"product_id"`
+	ReviewCount   int64   `json:"review_count"`
+	AverageRating float64 `json:"average_rating"`
+	// RatingDistribution counts the reviews per star rating, "1" to "5"
+	RatingDistribution map[string]int64 `json:"rating_distribution"`
+	// Aspects are those of the product's category
+	Aspects []*AspectSummaryDTO `json:"aspects,omitempty"`
+	// QuestionCount counts the approved questions, AnsweredQuestionCount
+	// those with an approved answer
+	QuestionCount         int64 `json:"question_count"`
+	AnsweredQuestionCount int64 `json:"answered_question_count"`
+	AnswerCount           int64 `json:"answer_count"`
+}
+
+type AspectSummaryDTO struct {
+	Key           string  `json:"key"`
+	Name          string  `json:"name"`
+	RatingCount   int64   `json:"rating_count"`
+	AverageRating float64 `json:"average_rating"`
+}
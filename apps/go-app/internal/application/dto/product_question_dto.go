@@ -0,0 +1,61 @@
+package dto
+
+type ProductQuestionInputDTO struct {
+	Body string `json:"body" binding:"required,max=2000"`
+}
+
+type ProductAnswerInputDTO struct {
+	Body string `json:"body" binding:"required,max=5000"`
+	// Brand answers on behalf of the product's brand; only its owners and
+	// admins may
+	Brand bool `json:"brand"`
+}
+
+// UpdateProductAnswerDTO replaces the body of an answer. Whether it is a
+// brand answer cannot be changed.
+type UpdateProductAnswerDTO struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+type ListProductQuestionsQuery struct {
+	Offset int `form:"offset,default=0" binding:"min=0"`
+	Limit  int `form:"limit,default=10" binding:"min=1,max=100"`
+	// Status other than approved requires the admin role
+	Status *string `form:"status" binding:"omitempty,oneof=pending approved rejected"`
+}
+
+// ModerateProductQADTO sets the moderation status of a question or answer.
+type ModerateProductQADTO struct {
+	Status string `json:"status" binding:"required,oneof=pending approved rejected"`
+}
+
+type ProductQuestionDTO struct {
+	ID        int64  `json:"id"`
+	ProductID int64  `json:"product_id"`
+	UserID    int64  `json:"user_id"`
+	Body      string `json:"body"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	// Answers are brand answers first, then most helpful first
+	Answers     []*ProductAnswerDTO `json:"answers"`
+	AnswerCount int                 `json:"answer_count"`
+}
+
+type ProductAnswerDTO struct {
+	ID             int64   `json:"id"`
+	QuestionID     int64   `json:"question_id"`
+	UserID         int64   `json:"user_id"`
+	Body           string  `json:"body"`
+	Brand          bool    `json:"brand"`
+	Status         string  `json:"status"`
+	HelpfulCount   int     `json:"helpful_count"`
+	UnhelpfulCount int     `json:"unhelpful_count"`
+	HelpfulScore   float64 `json:"helpful_score"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+type ProductAnswerVoteDTO struct {
+	Helpful *bool `json:"helpful" binding:"required"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+type ListRatingAlertsQuery struct {
+	Offset    int     `form:"offset,default=0" binding:"min=0"`
+	Limit     int     `form:"limit,default=50" binding:"min=1,max=100"`
+	Status    *string `form:"status" binding:"omitempty,oneof=open acknowledged resolved"`
+	ProductID *int64  `form:"product_id"`
+}
+
+// UpdateRatingAlertStatusDTO acknowledges or resolves an alert. Resolving it
+// lets the product be alerted on again; it does not lift a hold on the
+// product, which is done by updating the product.
+type UpdateRatingAlertStatusDTO struct {
+	Status string `json:"status" binding:"required,oneof=open acknowledged resolved"`
+}
+
+type RatingAlertDTO struct {
+	ID        int64 `json:"id"`
+	ProductID int64 `json:"product_id"`
+	// Kind is volume_spike or rating_shift
+	Kind string `json:"kind"`
+	// Status is one of open, acknowledged and resolved
+	Status         string  `json:"status"`
+	WindowStart    string  `json:"window_start"`
+	WindowEnd      string  `json:"window_end"`
+	ReviewCount    int     `json:"review_count"`
+	MeanRating     float64 `json:"mean_rating"`
+	ExpectedCount  float64 `json:"expected_count"`
+	BaselineMean   float64 `json:"baseline_mean"`
+	BaselineStddev float64 `json:"baseline_stddev"`
+	Score          float64 `json:"score"`
+	ProductHeld    bool    `json:"product_held"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
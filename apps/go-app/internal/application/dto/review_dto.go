@@ -1,25 +1,205 @@
 package dto
 
 type CreateReviewDTO struct {
-	UserID    int64  `json:"user_id" binding:"required"`
-	ProductID int64  `json:"product_id" binding:"required"`
-	Rating    int    `json:"rating" binding:"required,min=1,max=5"`
-	Comment   string `json:"comment"`
+	ProductID int64 `json:"product_id" binding:"required"`
+	// Rating is on the rating scale of the product's category or tenant,
+	// 1–5 stars unless configured otherwise
+	Rating  *float64 `json:"rating" binding:"required"`
+	Comment string   `json:"comment"`
+	// Locale is the BCP 47 tag the review is written in, such as "pt-BR". It
+	// overrides the language detected from the comment.
+	Locale *string `json:"locale,omitempty" binding:"omitempty,bcp47_language_tag"`
+	// Aspects rates aspects of the product's category by key, such as
+	// {"fit": 4}, on the same scale as Rating. Required aspects must be rated.
+	Aspects map[string]float64 `json:"aspects,omitempty"`
 }
 
 type UpdateReviewDTO struct {
-	Rating  *int    `json:"rating,omitempty" binding:"min=1,max=5"`
-	Comment *string `json:"comment,omitempty"`
+	// Rating is on the scale the review was written on
+	Rating  *float64 `json:"rating,omitempty"`
+	Comment *string  `json:"comment,omitempty"`
+	// Locale replaces the review's locale; an empty string removes it
+	Locale *string `json:"locale,omitempty" binding:"omitempty,bcp47_language_tag"`
+	// Aspects replaces every aspect rating of the review, on the scale the
+	// review was written on
+	Aspects map[string]float64 `json:"aspects,omitempty"`
+}
+
+type ListReviewsQuery struct {
+	Offset int `form:"offset,default=0" binding:"min=0"`
+	Limit  int `form:"limit,default=10" binding:"min=1,max=100"`
+	// Sort defaults to relevant
+	Sort     string `form:"sort" binding:"omitempty,oneof=relevant newest most_helpful verified"`
+	Verified *bool  `form:"verified"`
+	// ProductID lists reviews of the product and its variants
+	ProductID *int64 `form:"product_id"`
+	// Status defaults to approved; other statuses are for moderators only
+	Status            *string `form:"status" binding:"omitempty,oneof=pending approved rejected"`
+	Sentiment         *string `form:"sentiment" binding:"omitempty,oneof=positive neutral negative"`
+	SentimentMismatch *bool   `form:"sentiment_mismatch"`
+	// Lang only lists reviews in this language
+	Lang *string `form:"lang" binding:"omitempty,bcp47_language_tag"`
+	// Published filters on reviews past or within their cooling-off period;
+	// anything but true is for moderators only
+	Published *bool `form:"published"`
+	// AcceptLanguage is the request's Accept-Language header; reviews in the
+	// languages it prefers are listed first
+	AcceptLanguage string `form:"-"`
+}
+
+// ModerateReviewDTO sets the moderation status of a review.
+type ModerateReviewDTO struct {
+	Status string `json:"status" binding:"required,oneof=pending approved rejected"`
 }
 
 type ReviewDTO struct {
+	ID        int64 `json:"id"`
+	UserID    int64 `json:"user_id"`
+	ProductID int64 `json:"product_id"`
+	// Rating is on RatingScale, the scale the review was written on
+	Rating      float64        `json:"rating"`
+	RatingScale RatingScaleDTO `json:"rating_scale"`
+	// NormalizedRating is the rating mapped onto 1–5 stars, the scale
+	// summaries aggregate on
+	NormalizedRating float64             `json:"normalized_rating"`
+	Comment          string              `json:"comment"`
+	HelpfulCount     int                 `json:"helpful_count"`
+	UnhelpfulCount   int                 `json:"unhelpful_count"`
+	VerifiedPurchase bool                `json:"verified_purchase"`
+	OrderID          *int64              `json:"order_id,omitempty"`
+	Status           string              `json:"status"`
+	Sentiment        *ReviewSentimentDTO `json:"sentiment,omitempty"`
+	// Language is the ISO 639 code of the locale's language, else the
+	// language detected from the comment
+	Language         *string                `json:"language,omitempty"`
+	DetectedLanguage *string                `json:"detected_language,omitempty"`
+	Locale           *string                `json:"locale,omitempty"`
+	Aspects          map[string]float64     `json:"aspects,omitempty"`
+	Reply            *ReviewReplyDTO        `json:"reply,omitempty"`
+	Attachments      []*ReviewAttachmentDTO `json:"attachments,omitempty"`
+	CreatedAt        string                 `json:"created_at"`
+	UpdatedAt        string                 `json:"updated_at,omitempty"`
+	CreatedBy        string                 `json:"created_by,omitempty"`
+	// PublishAt is when the review goes public; PublishedAt is empty until it has
+	PublishAt   string `json:"publish_at"`
+	PublishedAt string `json:"published_at,omitempty"`
+}
+
+// ReviewTombstoneDTO is the payload of events about erased reviews, which
+// name the review and nothing of its content or author.
+type ReviewTombstoneDTO struct {
+	ID        int64 `json:"id"`
+	ProductID int64 `json:"product_id"`
+}
+
+// RatingScaleDTO is the range and granularity of ratings, such as 1–5 in
+// steps of 0.5 for half stars or 0–1 for thumbs down/up.
+type RatingScaleDTO struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Step float64 `json:"step"`
+}
+
+// ReviewSentimentDTO is how positive a review's comment reads.
+type ReviewSentimentDTO struct {
+	// Score runs from -1 (very negative) to 1 (very positive)
+	Score float64 `json:"score"`
+	Label string  `json:"label" enums:"positive,neutral,negative"`
+	// Mismatch is set when the comment contradicts the star rating
+	Mismatch bool `json:"mismatch"`
+}
+
+type ReviewVoteDTO struct {
+	Helpful *bool `json:"helpful" binding:"required"`
+}
+
+type ReviewReplyInputDTO struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+type ReviewReplyDTO struct {
 	ID        int64  `json:"id"`
-	UserID    int64  `json:"user_id"`
-	ProductID int64  `json:"product_id"`
-	Rating    int    `json:"rating"`
-	Comment   string `json:"comment"`
+	AuthorID  int64  `json:"author_id"`
+	Body      string `json:"body"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at,omitempty"`
-	CreatedBy string `json:"created_by,omitempty"`
 }
 
+type ReviewAttachmentDTO struct {
+	ID           int64  `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	ContentType  string `json:"content_type"`
+	SizeBytes    int64  `json:"size_bytes"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type ReviewStreamQuery struct {
+	// ProductID streams reviews of the product and its variants
+	ProductID *int64  `form:"product_id"`
+	Status    *string `form:"status" binding:"omitempty,oneof=pending approved rejected"`
+	// LastEventID resumes after this event, like the Last-Event-ID header,
+	// for clients that cannot set headers
+	LastEventID *int64 `form:"last_event_id"`
+}
+
+// ReviewEventDTO is a review change pushed to stream subscribers. Review is
+// the review after the change; for deletions, as it was when deleted.
+type ReviewEventDTO struct {
+	// ID is the event's position in commit order, to resume after
+	ID     int64      `json:"id"`
+	Type   string     `json:"type"`
+	Review *ReviewDTO `json:"review"`
+}
+
+// ReviewDuplicateDTO is an earlier review that a review is suspected to copy.
+type ReviewDuplicateDTO struct {
+	// Distance is how many of the 64 fingerprint bits differ
+	Distance   int        `json:"distance"`
+	Similarity float64    `json:"similarity"`
+	DetectedAt string     `json:"detected_at"`
+	Original   *ReviewDTO `json:"original"`
+}
+
+// ReviewReportInputDTO reports a review as abusive.
+type ReviewReportInputDTO struct {
+	Reason  string  `json:"reason" binding:"required,oneof=spam offensive fake other"`
+	Details *string `json:"details,omitempty" binding:"omitempty,max=2000"`
+}
+
+type ReviewReportDTO struct {
+	ID         int64   `json:"id"`
+	ReviewID   int64   `json:"review_id"`
+	ReporterID int64   `json:"reporter_id"`
+	Reason     string  `json:"reason"`
+	Details    *string `json:"details,omitempty"`
+	// Status is open until a moderator sets it to upheld or dismissed
+	Status     string  `json:"status"`
+	ResolvedBy *int64  `json:"resolved_by,omitempty"`
+	ResolvedAt *string `json:"resolved_at,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type ListReportedReviewsQuery struct {
+	Offset int `form:"offset,default=0" binding:"min=0"`
+	Limit  int `form:"limit,default=20" binding:"min=1,max=100"`
+	// Status "open" lists reviews with open reports, "all" every reported review
+	Status string `form:"status,default=open" binding:"oneof=open all"`
+}
+
+// ReportedReviewDTO counts the reports of a review by resolution and reason.
+type ReportedReviewDTO struct {
+	Review         *ReviewDTO       `json:"review"`
+	OpenCount      int64            `json:"open_count"`
+	UpheldCount    int64            `json:"upheld_count"`
+	DismissedCount int64            `json:"dismissed_count"`
+	Reasons        map[string]int64 `json:"reasons"`
+	LastReportedAt string           `json:"last_reported_at"`
+}
+
+// ResolveReviewReportsDTO resolves every open report of a review.
+type ResolveReviewReportsDTO struct {
+	Status string `json:"status" binding:"required,oneof=upheld dismissed"`
+}
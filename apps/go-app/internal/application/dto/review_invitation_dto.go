@@ -0,0 +1,57 @@
+package dto
+
+import "time"
+
+// IssueReviewInvitationDTO invites the customer of an ingested order to
+// review one of its products.
+type IssueReviewInvitationDTO struct {
+	// OrderID is the order's ID on the commerce platform
+	OrderID   string `json:"order_id" binding:"required,max=255"`
+	ProductID int64  `json:"product_id" binding:"required"`
+}
+
+type ReviewInvitationDTO struct {
+	ID int64 `json:"id"`
+	// Token goes into the invitation link; it is only returned on issue
+	Token      string `json:"token,omitempty"`
+	OrderID    int64  `json:"order_id"`
+	CustomerID int64  `json:"customer_id"`
+	ProductID  int64  `json:"product_id"`
+	// Status is issued, opened, completed or expired
+	Status      string  `json:"status"`
+	ExpiresAt   string  `json:"expires_at"`
+	OpenedAt    *string `json:"opened_at,omitempty"`
+	CompletedAt *string `json:"completed_at,omitempty"`
+	ReviewID    *int64  `json:"review_id,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+// InvitedReviewDTO is a review written through an invitation; the author and
+// product are those of the invitation.
+type InvitedReviewDTO struct {
+	Rating  *float64           `json:"rating" binding:"required"`
+	Comment string             `json:"comment"`
+	Locale  *string            `json:"locale,omitempty" binding:"omitempty,bcp47_language_tag"`
+	Aspects map[string]float64 `json:"aspects,omitempty"`
+}
+
+// InvitationStatsQuery selects the invitations issued in [from, to), of a
+// product if given.
+type InvitationStatsQuery struct {
+	ProductID *int64     `form:"product_id"`
+	From      *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To        *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// InvitationStatsDTO measures how many invited customers opened their link
+// and wrote a review.
+type InvitationStatsDTO struct {
+	Issued    int64 `json:"issued"`
+	Opened    int64 `json:"opened"`
+	Completed int64 `json:"completed"`
+	Expired   int64 `json:"expired"`
+	// OpenRate and ConversionRate are the shares of issued invitations
+	// opened and completed, 0 when none were issued
+	OpenRate       float64 `json:"open_rate"`
+	ConversionRate float64 `json:"conversion_rate"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+// ReviewPolicyInputDTO creates or replaces the review policy of a product or
+// category. Rules left out are inherited: from the product's group, then its
+// category, then the defaults.
+type ReviewPolicyInputDTO struct {
+	// MinCommentLength is the minimum length of a comment, in characters;
+	// reviews without a comment are only refused if it is required
+	MinCommentLength *int  `json:"min_comment_length,omitempty" binding:"omitempty,min=0,max=5000"`
+	CommentRequired  *bool `json:"comment_required,omitempty"`
+	// VerifiedOnly only accepts reviews of verified purchases
+	VerifiedOnly *bool `json:"verified_only,omitempty"`
+	// Moderation is auto_approve or pre_moderate, which holds every new or
+	// edited review for a moderator
+	Moderation *string `json:"moderation,omitempty" binding:"omitempty,oneof=auto_approve pre_moderate"`
+	// Closed refuses new reviews and edits
+	Closed *bool `json:"closed,omitempty"`
+}
+
+type ReviewPolicyDTO struct {
+	ID               int64   `json:"id"`
+	ProductID        *int64  `json:"product_id,omitempty"`
+	CategoryID       *int64  `json:"category_id,omitempty"`
+	MinCommentLength *int    `json:"min_comment_length,omitempty"`
+	CommentRequired  *bool   `json:"comment_required,omitempty"`
+	VerifiedOnly     *bool   `json:"verified_only,omitempty"`
+	Moderation       *string `json:"moderation,omitempty"`
+	Closed           *bool   `json:"closed,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+// ReviewRulesDTO are the rules reviews of a product are held to once its
+// own, its group's and its category's policies are resolved.
+type ReviewRulesDTO struct {
+	MinCommentLength int    `json:"min_comment_length"`
+	CommentRequired  bool   `json:"comment_required"`
+	VerifiedOnly     bool   `json:"verified_only"`
+	Moderation       string `json:"moderation"`
+	Closed           bool   `json:"closed"`
+}
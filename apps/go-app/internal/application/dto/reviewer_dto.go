@@ -0,0 +1,16 @@
+package dto
+
+type ReviewerProfileDTO struct {
+	UserID         int64   `json:"user_id"`
+	ReviewCount    int     `json:"review_count"`
+	ApprovedCount  int     `json:"approved_count"`
+	RejectedCount  int     `json:"rejected_count"`
+	VerifiedCount  int     `json:"verified_count"`
+	VerifiedRatio  float64 `json:"verified_ratio"`
+	HelpfulVotes   int     `json:"helpful_votes"`
+	UnhelpfulVotes int     `json:"unhelpful_votes"`
+	// Reputation ranges from 0 to 100
+	Reputation float64  `json:"reputation"`
+	Badges     []string `json:"badges"`
+	UpdatedAt  string   `json:"updated_at"`
+}
@@ -0,0 +1,53 @@
+package dto
+
+type CreateWebhookDTO struct {
+	URL string `json:"url" binding:"required,max=2048"`
+	// EventTypes lists the events to deliver, e.g. "review.created"; "review.*" matches every review event and "*" every event
+	EventTypes []string `json:"event_types" binding:"required,min=1,max=50,dive,required,max=100"`
+	// Secret signs the deliveries; one is generated when omitted
+	Secret *string `json:"secret,omitempty" binding:"omitempty,min=16,max=256"`
+}
+
+// UpdateWebhookDTO replaces the editable attributes of a subscription.
+// Activating a disabled subscription resets its failure count.
+type UpdateWebhookDTO struct {
+	URL        string   `json:"url" binding:"required,max=2048"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,max=50,dive,required,max=100"`
+	Active     bool     `json:"active"`
+}
+
+type WebhookDTO struct {
+	ID         int64    `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// Secret is only returned when the subscription is created
+	Secret              string `json:"secret,omitempty"`
+	Active              bool   `json:"active"`
+	ConsecutiveFailures int    `json:"consecutive_failures"`
+	DisabledAt          string `json:"disabled_at,omitempty"`
+	CreatedAt           string `json:"created_at"`
+	UpdatedAt           string `json:"updated_at"`
+}
+
+type ListWebhookDeliveriesQuery struct {
+	Offset int     `form:"offset,default=0" binding:"min=0"`
+	Limit  int     `form:"limit,default=50" binding:"min=1,max=100"`
+	Status *string `form:"status" binding:"omitempty,oneof=pending succeeded failed"`
+}
+
+type WebhookDeliveryDTO struct {
+	ID             int64   `json:"id"`
+	SubscriptionID int64   `json:"subscription_id"`
+	EventID        int64   `json:"event_id"`
+	EventType      string  `json:"event_type"`
+	Status         string  `json:"status"`
+	Attempts       int     `json:"attempts"`
+	ResponseStatus *int    `json:"response_status,omitempty"`
+	LastError      *string `json:"last_error,omitempty"`
+	NextAttemptAt  string  `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  string  `json:"last_attempt_at,omitempty"`
+	DeliveredAt    string  `json:"delivered_at,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+	// Payload is the request body sent to the endpoint
+	Payload any `json:"payload"`
+}
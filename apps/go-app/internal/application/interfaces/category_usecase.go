@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// CategoryUseCase manages product categories and the aspects their
+// reviewers rate.
+type CategoryUseCase interface {
+	Create(ctx context.Context, categoryDTO dto.CategoryInputDTO) (*dto.CategoryDTO, error)
+	Retrieve(ctx context.Context, id int64) (*dto.CategoryDTO, error)
+	Update(ctx context.Context, id int64, categoryDTO dto.CategoryInputDTO) (*dto.CategoryDTO, error)
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context, query dto.ListCategoriesQuery) ([]*dto.CategoryDTO, error)
+}
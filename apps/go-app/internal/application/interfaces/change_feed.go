@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ChangeFeed serves the ordered, resumable feed of review changes.
+type ChangeFeed interface {
+	List(ctx context.Context, query dto.ListChangesQuery) (*dto.ChangesPageDTO, error)
+}
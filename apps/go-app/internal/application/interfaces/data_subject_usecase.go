@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// DataSubjectUseCase answers access and erasure requests about a user's
+// personal data. Every fulfilled request is recorded and the record cannot be
+// changed afterwards.
+type DataSubjectUseCase interface {
+	// Export returns the JSON archive of the user's data, and of the auth
+	// account when the query names one, along with the record of the request.
+	Export(ctx context.Context, userID int64, query dto.DataSubjectExportQuery) ([]byte, *dto.DataSubjectRequestDTO, error)
+	// ExportSelf exports the data of the calling user.
+	ExportSelf(ctx context.Context) ([]byte, *dto.DataSubjectRequestDTO, error)
+	// Erase deletes the user's data and revokes their access tokens.
+	Erase(ctx context.Context, userID int64, eraseDTO dto.EraseDataSubjectDTO) (*dto.DataSubjectRequestDTO, error)
+	ListRequests(ctx context.Context, userID int64) ([]*dto.DataSubjectRequestDTO, error)
+}
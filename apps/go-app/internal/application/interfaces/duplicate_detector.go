@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// DuplicateDetector finds reviews whose comment copies an earlier review.
+type DuplicateDetector interface {
+	// Inspect fingerprints the review's comment, storing the fingerprint on
+	// the review, and returns the recent reviews it nearly duplicates,
+	// closest first. Comments too short to fingerprint match nothing.
+	Inspect(ctx context.Context, review *entity.Review) ([]*entity.ReviewDuplicate, error)
+	// Link records the duplicates Inspect found for the stored review,
+	// replacing any recorded before.
+	Link(ctx context.Context, review *entity.Review, duplicates []*entity.ReviewDuplicate) error
+}
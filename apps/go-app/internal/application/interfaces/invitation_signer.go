@@ -0,0 +1,12 @@
+package interfaces
+
+// InvitationSigner issues and checks the tokens of review invitation links.
+// A token names one invitation of one tenant and cannot be forged without
+// the signing key.
+type InvitationSigner interface {
+	// Sign returns the token of the tenant's invitation.
+	Sign(tenantID, invitationID int64) string
+	// Verify returns the invitation the token names, or false when the token
+	// is malformed or was not signed for the tenant.
+	Verify(tenantID int64, token string) (int64, bool)
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+// LanguageDetector guesses the language a text is written in.
+type LanguageDetector interface {
+	// Detect returns the ISO 639-1 code of the language of text, or false
+	// when it cannot tell.
+	Detect(text string) (string, bool)
+}
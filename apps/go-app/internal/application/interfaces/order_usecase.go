@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// OrderUseCase ingests purchases used to mark reviews as verified.
+type OrderUseCase interface {
+	Ingest(ctx context.Context, orderDTO dto.OrderDTO) (*dto.OrderIngestResultDTO, error)
+	IngestBulk(ctx context.Context, bulkDTO dto.BulkOrdersDTO) (*dto.OrderIngestResultDTO, error)
+}
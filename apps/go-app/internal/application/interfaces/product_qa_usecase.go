@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ProductQAUseCase manages the questions shoppers ask about products and
+// their answers.
+type ProductQAUseCase interface {
+	// Ask records the caller's question about a live product
+	Ask(ctx context.Context, productID, userID int64, questionDTO dto.ProductQuestionInputDTO) (*dto.ProductQuestionDTO, error)
+	// ListQuestions returns the questions of a product and its variants,
+	// newest first, with their answers
+	ListQuestions(ctx context.Context, productID int64, query dto.ListProductQuestionsQuery) ([]*dto.ProductQuestionDTO, error)
+	GetQuestion(ctx context.Context, id int64) (*dto.ProductQuestionDTO, error)
+	// UpdateQuestion and DeleteQuestion are restricted to the author and admins
+	UpdateQuestion(ctx context.Context, id int64, questionDTO dto.ProductQuestionInputDTO) (*dto.ProductQuestionDTO, error)
+	DeleteQuestion(ctx context.Context, id int64) error
+	// ModerateQuestion requires the admin role
+	ModerateQuestion(ctx context.Context, id int64, moderateDTO dto.ModerateProductQADTO) (*dto.ProductQuestionDTO, error)
+
+	// Answer records the caller's answer to an approved question
+	Answer(ctx context.Context, questionID, userID int64, answerDTO dto.ProductAnswerInputDTO) (*dto.ProductAnswerDTO, error)
+	// UpdateAnswer and DeleteAnswer are restricted to the author and admins
+	UpdateAnswer(ctx context.Context, id int64, answerDTO dto.UpdateProductAnswerDTO) (*dto.ProductAnswerDTO, error)
+	DeleteAnswer(ctx context.Context, id int64) error
+	// ModerateAnswer requires the admin role
+	ModerateAnswer(ctx context.Context, id int64, moderateDTO dto.ModerateProductQADTO) (*dto.ProductAnswerDTO, error)
+	VoteAnswer(ctx context.Context, answerID, userID int64, voteDTO dto.ProductAnswerVoteDTO) error
+	RemoveAnswerVote(ctx context.Context, answerID, userID int64) error
+}
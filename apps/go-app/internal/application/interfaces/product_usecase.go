@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ProductUseCase manages the product catalog reviews are written for.
+type ProductUseCase interface {
+	Create(ctx context.Context, productDTO dto.CreateProductDTO) (*dto.ProductDTO, error)
+	Retrieve(ctx context.Context, id int64) (*dto.ProductDTO, error)
+	Update(ctx context.Context, id int64, productDTO dto.UpdateProductDTO) (*dto.ProductDTO, error)
+	Archive(ctx context.Context, id int64) error
+	List(ctx context.Context, query dto.ListProductsQuery) ([]*dto.ProductDTO, error)
+	// Summary aggregates the approved reviews of a product and its variants
+	Summary(ctx context.Context, id int64) (*dto.ProductSummaryDTO, error)
+
+	// Sync upserts a catalog feed keyed by SKU
+	Sync(ctx context.Context, syncDTO dto.ProductSyncDTO) (*dto.ProductSyncResultDTO, error)
+
+	// Owners may reply to the product's reviews and answer its questions on
+	// behalf of its brand
+	ListOwners(ctx context.Context, id int64) ([]*dto.ProductOwnerDTO, error)
+	AddOwner(ctx context.Context, id int64, ownerDTO dto.ProductOwnerInputDTO) error
+	RemoveOwner(ctx context.Context, id, userID int64) error
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// RatingAlertUseCase lets moderators review the rating anomalies raised on
+// products. Requires the admin role.
+type RatingAlertUseCase interface {
+	List(ctx context.Context, query dto.ListRatingAlertsQuery) ([]*dto.RatingAlertDTO, error)
+	Retrieve(ctx context.Context, id int64) (*dto.RatingAlertDTO, error)
+	UpdateStatus(ctx context.Context, id int64, statusDTO dto.UpdateRatingAlertStatusDTO) (*dto.RatingAlertDTO, error)
+}
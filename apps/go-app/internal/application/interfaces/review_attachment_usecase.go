@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewAttachmentUseCase manages images attached to a review by its author.
+type ReviewAttachmentUseCase interface {
+	Upload(ctx context.Context, reviewID int64, file io.Reader) (*dto.ReviewAttachmentDTO, error)
+	Delete(ctx context.Context, reviewID, attachmentID int64) error
+}
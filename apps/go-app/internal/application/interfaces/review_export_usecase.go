@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewExportUseCase exports the reviews a caller may list, either streamed
+// straight to the caller or produced in the background for later download.
+type ReviewExportUseCase interface {
+	// Export writes the matching reviews to the writer open returns. open is
+	// only called once the request was authorized, so nothing is written
+	// for rejected requests.
+	Export(ctx context.Context, query dto.ExportReviewsQuery, open func() io.Writer) error
+
+	CreateJob(ctx context.Context, jobDTO dto.CreateExportJobDTO) (*dto.ExportJobDTO, error)
+	RetrieveJob(ctx context.Context, id int64) (*dto.ExportJobDTO, error)
+	// Download opens the file of a finished job; the caller closes it
+	Download(ctx context.Context, id int64) (*dto.ExportJobDTO, io.ReadCloser, error)
+}
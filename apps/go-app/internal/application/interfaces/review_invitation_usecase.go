@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewInvitationUseCase invites customers to review their purchases through
+// signed links that work without logging in.
+type ReviewInvitationUseCase interface {
+	// Issue creates the invitation for an order line, or returns the one
+	// issued before. Requires the admin role.
+	Issue(ctx context.Context, issueDTO dto.IssueReviewInvitationDTO) (*dto.ReviewInvitationDTO, error)
+	// Open returns the invitation the token names and records that its link
+	// was followed
+	Open(ctx context.Context, token string) (*dto.ReviewInvitationDTO, error)
+	// Redeem writes the invited customer's review, verified against the
+	// invitation's order, and uses the invitation up
+	Redeem(ctx context.Context, token string, reviewDTO dto.InvitedReviewDTO) (*dto.ReviewDTO, error)
+	// Stats measures the conversion of issued invitations. Requires the admin
+	// role.
+	Stats(ctx context.Context, query dto.InvitationStatsQuery) (*dto.InvitationStatsDTO, error)
+}
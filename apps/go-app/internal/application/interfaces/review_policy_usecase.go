@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewPolicyUseCase manages the review policies of products and categories.
+type ReviewPolicyUseCase interface {
+	PutForProduct(ctx context.Context, productID int64, policyDTO dto.ReviewPolicyInputDTO) (*dto.ReviewPolicyDTO, error)
+	GetForProduct(ctx context.Context, productID int64) (*dto.ReviewPolicyDTO, error)
+	DeleteForProduct(ctx context.Context, productID int64) error
+	PutForCategory(ctx context.Context, categoryID int64, policyDTO dto.ReviewPolicyInputDTO) (*dto.ReviewPolicyDTO, error)
+	GetForCategory(ctx context.Context, categoryID int64) (*dto.ReviewPolicyDTO, error)
+	DeleteForCategory(ctx context.Context, categoryID int64) error
+	// ProductRules returns the rules reviews of a product are held to.
+	ProductRules(ctx context.Context, productID int64) (*dto.ReviewRulesDTO, error)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewReplyUseCase manages the brand's public reply to a review. Callers
+// must own the reviewed product or be administrators.
+type ReviewReplyUseCase interface {
+	Create(ctx context.Context, reviewID int64, replyDTO dto.ReviewReplyInputDTO) (*dto.ReviewReplyDTO, error)
+	Update(ctx context.Context, reviewID int64, replyDTO dto.ReviewReplyInputDTO) (*dto.ReviewReplyDTO, error)
+	Delete(ctx context.Context, reviewID int64) error
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewReportUseCase lets shoppers report abusive reviews and moderators
+// work through the reports.
+type ReviewReportUseCase interface {
+	// Report records the caller's report of a review, once per caller
+	Report(ctx context.Context, reviewID, reporterID int64, reportDTO dto.ReviewReportInputDTO) (*dto.ReviewReportDTO, error)
+	// ListByReview returns every report of a review. Requires the admin role.
+	ListByReview(ctx context.Context, reviewID int64) ([]*dto.ReviewReportDTO, error)
+	// ListReported returns reported reviews with their report counts.
+	// Requires the admin role.
+	ListReported(ctx context.Context, query dto.ListReportedReviewsQuery) ([]*dto.ReportedReviewDTO, error)
+	// Resolve upholds or dismisses the open reports of a review. Requires the
+	// admin role.
+	Resolve(ctx context.Context, reviewID int64, resolveDTO dto.ResolveReviewReportsDTO) ([]*dto.ReviewReportDTO, error)
+}
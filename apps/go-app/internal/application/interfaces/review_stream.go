@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewStream pushes review changes of the caller's tenant as they commit.
+type ReviewStream interface {
+	// Subscribe returns the matching events after query.LastEventID, if set,
+	// followed by live ones. The channel is closed when ctx is done, when the
+	// subscriber falls too far behind, or on shutdown; clients then resume
+	// from the last event they received.
+	Subscribe(ctx context.Context, query dto.ReviewStreamQuery) (<-chan *dto.ReviewEventDTO, error)
+}
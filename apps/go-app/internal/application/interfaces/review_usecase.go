@@ -11,5 +11,16 @@ type ReviewUseCase interface {
 	Retrieve(ctx context.Context, id int64) (*dto.ReviewDTO, error)
 	Update(ctx context.Context, id int64, reviewDTO dto.UpdateReviewDTO) error
 	Delete(ctx context.Context, id int64) error
-	List(ctx context.Context, offset, limit int) ([]*dto.ReviewDTO, error)
-}
\ No newline at end of file
+	List(ctx context.Context, query dto.ListReviewsQuery) ([]*dto.ReviewDTO, error)
+	// Withdraw deletes the caller's review while it is still cooling off
+	Withdraw(ctx context.Context, id int64) error
+
+	// Moderate sets the moderation status of a review
+	Moderate(ctx context.Context, id int64, moderateDTO dto.ModerateReviewDTO) (*dto.ReviewDTO, error)
+	// ListDuplicates returns the earlier reviews the review is suspected to copy
+	ListDuplicates(ctx context.Context, id int64) ([]*dto.ReviewDuplicateDTO, error)
+
+	// Helpfulness voting
+	Vote(ctx context.Context, reviewID, userID int64, voteDTO dto.ReviewVoteDTO) error
+	RemoveVote(ctx context.Context, reviewID, userID int64) error
+}
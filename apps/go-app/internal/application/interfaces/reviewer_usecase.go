@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// ReviewerUseCase exposes the reputation of review authors.
+type ReviewerUseCase interface {
+	GetProfile(ctx context.Context, userID int64) (*dto.ReviewerProfileDTO, error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "user-review-ingest/internal/domain/valueobject"
+
+// SentimentAnalyzer scores how positive a text reads. Implementations run
+// locally, without calling external services.
+type SentimentAnalyzer interface {
+	// Analyze returns the sentiment of text, or false when the text carries
+	// no sentiment it recognizes.
+	Analyze(text string) (valueobject.Sentiment, bool)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// TenantResolver determines which tenant a request acts for.
+type TenantResolver interface {
+	// Resolve picks the tenant named by the request header, else the one owning
+	// the hostname, and checks it against the tenant the caller's token was
+	// issued for. principal is nil for anonymous requests.
+	Resolve(ctx context.Context, hostname, slug string, principal *entity.Principal) (*entity.Tenant, error)
+}
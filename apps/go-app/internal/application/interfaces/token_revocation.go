@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// TokenRevocationChecker tells whether an access token has been revoked since
+// it was issued, as happens when its user's data is erased.
+type TokenRevocationChecker interface {
+	// Check returns ErrTokenRevoked when the principal's token is no longer valid.
+	Check(ctx context.Context, principal *entity.Principal) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// VelocityLimiter enforces how fast reviews may be written.
+type VelocityLimiter interface {
+	// Check counts the new review against every policy and returns the
+	// exceeded policy with the strongest action, or nil when the review is
+	// within limits. Call it in the transaction creating the review, so that
+	// only stored reviews are counted.
+	Check(ctx context.Context, review *entity.Review) (*entity.VelocityPolicy, error)
+}
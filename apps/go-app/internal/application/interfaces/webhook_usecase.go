@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+)
+
+// WebhookUseCase manages a tenant's webhook subscriptions and their delivery log.
+type WebhookUseCase interface {
+	Create(ctx context.Context, webhookDTO dto.CreateWebhookDTO) (*dto.WebhookDTO, error)
+	Retrieve(ctx context.Context, id int64) (*dto.WebhookDTO, error)
+	Update(ctx context.Context, id int64, webhookDTO dto.UpdateWebhookDTO) (*dto.WebhookDTO, error)
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context) ([]*dto.WebhookDTO, error)
+
+	ListDeliveries(ctx context.Context, id int64, query dto.ListWebhookDeliveriesQuery) ([]*dto.WebhookDeliveryDTO, error)
+	// Redeliver queues the payload of a past delivery again as a new delivery
+	Redeliver(ctx context.Context, id, deliveryID int64) (*dto.WebhookDeliveryDTO, error)
+}
@@ -0,0 +1,33 @@
+package modules
+
+import (
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterCategoryModule sets up the dependencies for product categories and registers their admin routes.
+func RegisterCategoryModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+	// Dependencies for Category module
+	categoryRepo := persistence.NewCategoryRepositoryImpl(db)
+	auditRepo := persistence.NewAuditRepositoryImpl(db)
+	txManager := persistence.NewTxManagerImpl(db)
+
+	categoryUseCase := usecase.NewCategoryUseCaseImpl(categoryRepo, auditRepo, txManager)
+	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
+
+	// Category routes
+	categories := router.Group("/categories", middleware.RequireRole(entity.RoleAdmin))
+	{
+		categories.POST("", categoryHandler.CreateCategory)
+		categories.GET("", categoryHandler.ListCategories)
+		categories.GET("/:id", categoryHandler.GetCategory)
+		categories.PUT("/:id", categoryHandler.UpdateCategory)
+		categories.DELETE("/:id", categoryHandler.DeleteCategory)
+	}
+}
@@ -0,0 +1,37 @@
+package modules
+
+import (
+	"user-review-ingest/internal/application/interfaces"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/messaging/pgnotify"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// reviewChangesChannel is the channel the capture_review_change trigger announces changes on.
+const reviewChangesChannel = "review_changes"
+
+// RegisterChangeFeedModule registers the routes of the review change feed.
+func RegisterChangeFeedModule(router *gin.RouterGroup, changeFeed interfaces.ChangeFeed) {
+	changeFeedHandler := handler.NewChangeFeedHandler(changeFeed)
+
+	// Change feed routes
+	router.GET("/changes", middleware.RequireAuth(), changeFeedHandler.ListChanges)
+}
+
+// NewChangeFeed sets up the dependencies of the review change feed. It
+// listens on its own connection, next to the pool.
+func NewChangeFeed(db *pgxpool.Pool, logger *zerolog.Logger, cfg *config.Config) *usecase.ChangeFeedImpl {
+	changeRepo := persistence.NewReviewChangeRepositoryImpl(db)
+	reviewRepo := persistence.NewReviewRepositoryImpl(db)
+	txManager := persistence.NewTxManagerImpl(db)
+	listener := pgnotify.NewListener(cfg.DatabaseURL, reviewChangesChannel, logger)
+
+	return usecase.NewChangeFeedImpl(changeRepo, reviewRepo, txManager, listener, logger)
+}
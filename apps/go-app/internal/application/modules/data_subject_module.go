@@ -0,0 +1,46 @@
+package modules
+
+import (
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+	"user-review-ingest/internal/infrastructure/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterDataSubjectModule sets up the dependencies for data subject requests and registers their routes.
+func RegisterDataSubjectModule(router *gin.RouterGroup, db *pgxpool.Pool, blobStore storage.BlobStore) {
+	// Dependencies for Data Subject module
+	dataSubjectRepo := persistence.NewDataSubjectRepositoryImpl(db)
+	requestRepo := persistence.NewDataSubjectRequestRepositoryImpl(db)
+	revocationRepo := persistence.NewTokenRevocationRepositoryImpl(db)
+	outboxRepo := persistence.NewOutboxRepositoryImpl(db)
+	txManager := persistence.NewTxManagerImpl(db)
+
+	dataSubjectUseCase := usecase.NewDataSubjectUseCaseImpl(dataSubjectRepo, requestRepo, revocationRepo, outboxRepo, txManager, blobStore)
+	dataSubjectHandler := handler.NewDataSubjectHandler(dataSubjectUseCase)
+
+	// Self-service export
+	router.GET("/me/data-export", middleware.RequireAuth(), dataSubjectHandler.ExportSelf)
+
+	// Admin data subject routes
+	dataSubjects := router.Group("/data-subjects", middleware.RequireRole(entity.RoleAdmin))
+	{
+		dataSubjects.GET("/:userId/export", dataSubjectHandler.Export)
+		dataSubjects.POST("/:userId/erasure", dataSubjectHandler.Erase)
+		dataSubjects.GET("/:userId/requests", dataSubjectHandler.ListRequests)
+	}
+}
+
+// NewRevocationMiddleware sets up token revocation checks and returns the
+// middleware rejecting revoked tokens.
+func NewRevocationMiddleware(db *pgxpool.Pool) gin.HandlerFunc {
+	revocationRepo := persistence.NewTokenRevocationRepositoryImpl(db)
+	revocationChecker := usecase.NewTokenRevocationCheckerImpl(revocationRepo)
+
+	return middleware.RevocationMiddleware(revocationChecker)
+}
@@ -14,7 +14,7 @@ import (
 )
 
 // RegisterOAuthModule sets up the dependencies for the OAuth module and registers its routes.
-func RegisterOAuthModule(router *gin.Engine, db *pgxpool.Pool, logger *zerolog.Logger) {
+func RegisterOAuthModule(router *gin.RouterGroup, db *pgxpool.Pool, logger *zerolog.Logger) {
 	// Dependencies for OAuth module
 	oauthRepo := persistence.NewOAuthRepositoryImpl(db)
 
@@ -0,0 +1,29 @@
+package modules
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+)
+
+// RegisterOrderModule sets up the dependencies for order ingestion and registers its routes.
+func RegisterOrderModule(router *gin.RouterGroup, db *pgxpool.Pool, cfg *config.Config) {
+	// Dependencies for Order module
+	txManager := persistence.NewTxManagerImpl(db)
+	orderRepo := persistence.NewOrderRepositoryImpl(db)
+
+	orderUseCase := usecase.NewOrderUseCaseImpl(orderRepo, txManager)
+	orderHandler := handler.NewOrderHandler(orderUseCase)
+
+	// Order routes
+	orders := router.Group("/orders")
+	{
+		orders.POST("/webhook", middleware.RequireSignature(cfg.OrderWebhookSecret), orderHandler.ReceiveWebhook)
+		orders.POST("/bulk", middleware.RequireRole(entity.RoleAdmin), orderHandler.ImportOrders)
+	}
+}
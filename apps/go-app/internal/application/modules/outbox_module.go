@@ -0,0 +1,30 @@
+package modules
+
+import (
+	"time"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/messaging"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// NewOutboxRelay sets up the dependencies of the relay publishing outbox events.
+func NewOutboxRelay(db *pgxpool.Pool, publisher messaging.Publisher, logger *zerolog.Logger, cfg *config.Config) *usecase.OutboxRelayImpl {
+	txManager := persistence.NewTxManagerImpl(db)
+	outboxRepo := persistence.NewOutboxRepositoryImpl(db)
+	tenantRepo := persistence.NewTenantRepositoryImpl(db)
+
+	return usecase.NewOutboxRelayImpl(
+		outboxRepo,
+		tenantRepo,
+		txManager,
+		publisher,
+		logger,
+		time.Duration(cfg.OutboxPollIntervalMs)*time.Millisecond,
+		cfg.OutboxBatchSize,
+		cfg.OutboxMaxAttempts,
+	)
+}
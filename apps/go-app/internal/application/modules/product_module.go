@@ -0,0 +1,42 @@
+package modules
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+)
+
+// RegisterProductModule sets up the dependencies for the product catalog and registers its routes.
+func RegisterProductModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+	// Dependencies for Product module
+	txManager := persistence.NewTxManagerImpl(db)
+	productRepo := persistence.NewProductRepositoryImpl(db)
+	categoryRepo := persistence.NewCategoryRepositoryImpl(db)
+	summaryRepo := persistence.NewProductSummaryRepositoryImpl(db)
+	ownerRepo := persistence.NewProductOwnerRepositoryImpl(db)
+	auditRepo := persistence.NewAuditRepositoryImpl(db)
+
+	productUseCase := usecase.NewProductUseCaseImpl(productRepo, categoryRepo, summaryRepo, ownerRepo, auditRepo, txManager)
+	productHandler := handler.NewProductHandler(productUseCase)
+
+	// Review summaries are public
+	router.GET("/products/:id/summary", productHandler.GetProductSummary)
+
+	// Product routes
+	products := router.Group("/products", middleware.RequireRole(entity.RoleAdmin))
+	{
+		products.POST("", productHandler.CreateProduct)
+		products.GET("", productHandler.ListProducts)
+		products.POST("/sync", productHandler.SyncProducts)
+		products.GET("/:id", productHandler.GetProduct)
+		products.PUT("/:id", productHandler.UpdateProduct)
+		products.DELETE("/:id", productHandler.ArchiveProduct)
+		products.GET("/:id/owners", productHandler.ListProductOwners)
+		products.POST("/:id/owners", productHandler.AddProductOwner)
+		products.DELETE("/:id/owners/:userId", productHandler.RemoveProductOwner)
+	}
+}
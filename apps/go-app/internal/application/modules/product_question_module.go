@@ -0,0 +1,49 @@
+package modules
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+)
+
+// RegisterProductQuestionModule sets up the dependencies for product questions and answers and registers their routes.
+func RegisterProductQuestionModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+	// Dependencies for Product Q&A module
+	txManager := persistence.NewTxManagerImpl(db)
+	productRepo := persistence.NewProductRepositoryImpl(db)
+	ownerRepo := persistence.NewProductOwnerRepositoryImpl(db)
+	questionRepo := persistence.NewProductQuestionRepositoryImpl(db)
+	answerRepo := persistence.NewProductAnswerRepositoryImpl(db)
+	auditRepo := persistence.NewAuditRepositoryImpl(db)
+
+	qaUseCase := usecase.NewProductQAUseCaseImpl(productRepo, ownerRepo, questionRepo, answerRepo, auditRepo, txManager)
+	questionHandler := handler.NewProductQuestionHandler(qaUseCase)
+
+	// Questions of a product are public; asking requires an account
+	router.GET("/products/:id/questions", questionHandler.ListQuestions)
+	router.POST("/products/:id/questions", middleware.RequireAuth(), questionHandler.AskQuestion)
+
+	// Question routes
+	questions := router.Group("/questions")
+	{
+		questions.GET("/:id", questionHandler.GetQuestion)
+		questions.PUT("/:id", middleware.RequireAuth(), questionHandler.UpdateQuestion)
+		questions.DELETE("/:id", middleware.RequireAuth(), questionHandler.DeleteQuestion)
+		questions.PUT("/:id/status", middleware.RequireRole(entity.RoleAdmin), questionHandler.ModerateQuestion)
+		questions.POST("/:id/answers", middleware.RequireAuth(), questionHandler.AnswerQuestion)
+	}
+
+	// Answer routes
+	answers := router.Group("/answers")
+	{
+		answers.PUT("/:id", middleware.RequireAuth(), questionHandler.UpdateAnswer)
+		answers.DELETE("/:id", middleware.RequireAuth(), questionHandler.DeleteAnswer)
+		answers.PUT("/:id/status", middleware.RequireRole(entity.RoleAdmin), questionHandler.ModerateAnswer)
+		answers.POST("/:id/votes", middleware.RequireAuth(), questionHandler.VoteAnswer)
+		answers.DELETE("/:id/votes", middleware.RequireAuth(), questionHandler.RemoveAnswerVote)
+	}
+}
@@ -0,0 +1,53 @@
+package modules
+
+import (
+	"time"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// RegisterRatingAlertModule sets up the dependencies for rating alerts and registers their routes.
+func RegisterRatingAlertModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+	// Dependencies for Rating Alert module
+	alertRepo := persistence.NewRatingAlertRepositoryImpl(db)
+	auditRepo := persistence.NewAuditRepositoryImpl(db)
+	txManager := persistence.NewTxManagerImpl(db)
+
+	alertUseCase := usecase.NewRatingAlertUseCaseImpl(alertRepo, auditRepo, txManager)
+	alertHandler := handler.NewRatingAlertHandler(alertUseCase)
+
+	// Rating alert routes
+	alerts := router.Group("/rating-alerts", middleware.RequireRole(entity.RoleAdmin))
+	{
+		alerts.GET("", alertHandler.ListRatingAlerts)
+		alerts.GET("/:id", alertHandler.GetRatingAlert)
+		alerts.PUT("/:id/status", alertHandler.UpdateRatingAlertStatus)
+	}
+}
+
+// NewRatingAnomalyDetector sets up the dependencies of the background
+// detector of rating anomalies.
+func NewRatingAnomalyDetector(db *pgxpool.Pool, logger *zerolog.Logger, cfg *config.Config) *usecase.RatingAnomalyDetectorImpl {
+	return usecase.NewRatingAnomalyDetectorImpl(
+		persistence.NewRatingAlertRepositoryImpl(db),
+		persistence.NewProductRepositoryImpl(db),
+		persistence.NewOutboxRepositoryImpl(db),
+		persistence.NewTenantRepositoryImpl(db),
+		persistence.NewTxManagerImpl(db),
+		logger,
+		time.Duration(cfg.RatingAnomalyIntervalSeconds)*time.Second,
+		time.Duration(cfg.RatingAnomalyWindowSeconds)*time.Second,
+		time.Duration(cfg.RatingAnomalyBaselineDays)*24*time.Hour,
+		cfg.RatingAnomalyMinReviews,
+		float64(cfg.RatingAnomalyThreshold),
+		cfg.RatingAnomalyHoldProducts,
+	)
+}
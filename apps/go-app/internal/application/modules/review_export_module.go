@@ -0,0 +1,47 @@
+package modules
+
+import (
+	"time"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+	"user-review-ingest/internal/infrastructure/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// RegisterReviewExportModule sets up the dependencies for review exports and registers their routes.
+func RegisterReviewExportModule(router *gin.RouterGroup, db *pgxpool.Pool, blobStore storage.BlobStore) {
+	// Dependencies for Review Export module
+	reviewRepo := persistence.NewReviewRepositoryImpl(db)
+	exportJobRepo := persistence.NewExportJobRepositoryImpl(db)
+
+	exportUseCase := usecase.NewReviewExportUseCaseImpl(reviewRepo, exportJobRepo, blobStore)
+	exportHandler := handler.NewReviewExportHandler(exportUseCase)
+
+	// Review export routes
+	reviews := router.Group("/reviews", middleware.RequireAuth())
+	{
+		reviews.GET("/export", exportHandler.ExportReviews)
+		reviews.POST("/exports", exportHandler.CreateExportJob)
+		reviews.GET("/exports/:id", exportHandler.GetExportJob)
+		reviews.GET("/exports/:id/download", exportHandler.DownloadExport)
+	}
+}
+
+// NewReviewExportWorker sets up the dependencies of the worker producing
+// queued review exports.
+func NewReviewExportWorker(db *pgxpool.Pool, blobStore storage.BlobStore, logger *zerolog.Logger, cfg *config.Config) *usecase.ReviewExportWorkerImpl {
+	return usecase.NewReviewExportWorkerImpl(
+		persistence.NewReviewRepositoryImpl(db),
+		persistence.NewExportJobRepositoryImpl(db),
+		persistence.NewTenantRepositoryImpl(db),
+		blobStore,
+		logger,
+		time.Duration(cfg.ExportPollIntervalMs)*time.Millisecond,
+	)
+}
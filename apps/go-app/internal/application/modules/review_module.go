@@ -1,27 +1,131 @@
 package modules
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"user-review-ingest/internal/application/interfaces"
 	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/config"
 	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/invitation"
+	"user-review-ingest/internal/infrastructure/langdetect"
+	"user-review-ingest/internal/infrastructure/media"
 	"user-review-ingest/internal/infrastructure/persistence"
+	"user-review-ingest/internal/infrastructure/sentiment"
+	"user-review-ingest/internal/infrastructure/storage"
 )
 
+const thumbnailSize = 320
+
 // RegisterReviewModule sets up the dependencies for the review module and registers its routes.
-func RegisterReviewModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+func RegisterReviewModule(router *gin.RouterGroup, db *pgxpool.Pool, blobStore storage.BlobStore, reviewStream interfaces.ReviewStream, velocityLimiter interfaces.VelocityLimiter, cfg *config.Config) {
 	// Dependencies for Review module
+	txManager := persistence.NewTxManagerImpl(db)
 	reviewRepo := persistence.NewReviewRepositoryImpl(db)
-	reviewUseCase := usecase.NewReviewUseCaseImpl(reviewRepo)
+	voteRepo := persistence.NewReviewVoteRepositoryImpl(db)
+	replyRepo := persistence.NewReviewReplyRepositoryImpl(db)
+	attachmentRepo := persistence.NewReviewAttachmentRepositoryImpl(db)
+	ownerRepo := persistence.NewProductOwnerRepositoryImpl(db)
+	orderRepo := persistence.NewOrderRepositoryImpl(db)
+	productRepo := persistence.NewProductRepositoryImpl(db)
+	categoryRepo := persistence.NewCategoryRepositoryImpl(db)
+	aspectRepo := persistence.NewReviewAspectRepositoryImpl(db)
+	auditRepo := persistence.NewAuditRepositoryImpl(db)
+	outboxRepo := persistence.NewOutboxRepositoryImpl(db)
+	duplicateRepo := persistence.NewReviewDuplicateRepositoryImpl(db)
+	reportRepo := persistence.NewReviewReportRepositoryImpl(db)
+	invitationRepo := persistence.NewReviewInvitationRepositoryImpl(db)
+	reviewerRepo := persistence.NewReviewerRepositoryImpl(db)
+	policyRepo := persistence.NewReviewPolicyRepositoryImpl(db)
+	maxUploadBytes := int64(cfg.MediaMaxUploadBytes)
+
+	duplicateDetector := usecase.NewDuplicateDetectorImpl(
+		duplicateRepo,
+		cfg.DuplicateMinWords,
+		cfg.DuplicateMaxDistance,
+		time.Duration(cfg.DuplicateLookbackDays)*24*time.Hour,
+	)
+
+	reviewerTrust := entity.ReviewerTrustPolicy{
+		HoldBelow:  float64(cfg.ReviewerHoldBelowReputation),
+		MinReviews: cfg.ReviewerHoldMinReviews,
+	}
+
+	reviewUseCase := usecase.NewReviewUseCaseImpl(reviewRepo, voteRepo, replyRepo, attachmentRepo, orderRepo, productRepo, categoryRepo, aspectRepo, auditRepo, outboxRepo, txManager, blobStore, velocityLimiter, duplicateDetector, duplicateRepo, reviewerRepo, reviewerTrust, policyRepo, sentiment.NewLexiconAnalyzer(), langdetect.NewDetector())
+	replyUseCase := usecase.NewReviewReplyUseCaseImpl(reviewRepo, replyRepo, ownerRepo, auditRepo, txManager)
+	var invitationSigner interfaces.InvitationSigner
+	if cfg.ReviewInvitationSecret != "" {
+		invitationSigner = invitation.NewHMACSigner(cfg.ReviewInvitationSecret)
+	}
+	invitationUseCase := usecase.NewReviewInvitationUseCaseImpl(invitationRepo, orderRepo, productRepo, reviewUseCase, invitationSigner, time.Duration(cfg.ReviewInvitationTTLHours)*time.Hour)
+	reportUseCase := usecase.NewReviewReportUseCaseImpl(reviewRepo, reportRepo, auditRepo, outboxRepo, txManager, cfg.ReviewReportThreshold)
+	reviewerUseCase := usecase.NewReviewerUseCaseImpl(reviewerRepo)
+	attachmentUseCase := usecase.NewReviewAttachmentUseCaseImpl(reviewRepo, attachmentRepo, blobStore, media.NewImageProcessor(thumbnailSize), maxUploadBytes)
+
 	reviewHandler := handler.NewReviewHandler(reviewUseCase)
+	streamHandler := handler.NewReviewStreamHandler(reviewStream)
+	replyHandler := handler.NewReviewReplyHandler(replyUseCase)
+	attachmentHandler := handler.NewReviewAttachmentHandler(attachmentUseCase, maxUploadBytes)
+	reportHandler := handler.NewReviewReportHandler(reportUseCase)
+	invitationHandler := handler.NewReviewInvitationHandler(invitationUseCase)
+	reviewerHandler := handler.NewReviewerHandler(reviewerUseCase)
 
 	// Review routes
 	reviews := router.Group("/reviews")
 	{
-		reviews.POST("", reviewHandler.CreateReview)
+		reviews.POST("", middleware.RequireAuth(), reviewHandler.CreateReview)
 		reviews.GET("/:id", reviewHandler.GetReview)
-		reviews.PUT("/:id", reviewHandler.UpdateReview)
-		reviews.DELETE("/:id", reviewHandler.DeleteReview)
+		reviews.PUT("/:id", middleware.RequireAuth(), reviewHandler.UpdateReview)
+		reviews.DELETE("/:id", middleware.RequireAuth(), reviewHandler.DeleteReview)
+		reviews.POST("/:id/withdraw", middleware.RequireAuth(), reviewHandler.WithdrawReview)
 		reviews.GET("", reviewHandler.ListReviews)
+		reviews.GET("/stream", middleware.RequireRole(entity.RoleAdmin), streamHandler.StreamReviews)
+		reviews.PUT("/:id/status", middleware.RequireRole(entity.RoleAdmin), reviewHandler.ModerateReview)
+		reviews.GET("/:id/duplicates", middleware.RequireRole(entity.RoleAdmin), reviewHandler.ListReviewDuplicates)
+		reviews.GET("/reported", middleware.RequireRole(entity.RoleAdmin), reportHandler.ListReportedReviews)
+
+		reviews.POST("/:id/votes", middleware.RequireAuth(), reviewHandler.VoteReview)
+		reviews.DELETE("/:id/votes", middleware.RequireAuth(), reviewHandler.RemoveReviewVote)
+
+		reviews.POST("/:id/reply", middleware.RequireAuth(), replyHandler.CreateReply)
+		reviews.PUT("/:id/reply", middleware.RequireAuth(), replyHandler.UpdateReply)
+		reviews.DELETE("/:id/reply", middleware.RequireAuth(), replyHandler.DeleteReply)
+
+		reviews.POST("/:id/reports", middleware.RequireAuth(), reportHandler.ReportReview)
+		reviews.GET("/:id/reports", middleware.RequireRole(entity.RoleAdmin), reportHandler.ListReviewReports)
+		reviews.PUT("/:id/reports", middleware.RequireRole(entity.RoleAdmin), reportHandler.ResolveReviewReports)
+
+		reviews.POST("/:id/attachments", middleware.RequireAuth(), attachmentHandler.UploadAttachment)
+		reviews.DELETE("/:id/attachments/:attachmentId", middleware.RequireAuth(), attachmentHandler.DeleteAttachment)
 	}
+
+	// Invitation links work without logging in; the token is the credential
+	invitations := router.Group("/review-invitations")
+	{
+		invitations.POST("", middleware.RequireRole(entity.RoleAdmin), invitationHandler.IssueInvitation)
+		invitations.GET("/stats", middleware.RequireRole(entity.RoleAdmin), invitationHandler.InvitationStats)
+		invitations.GET("/:token", invitationHandler.OpenInvitation)
+		invitations.POST("/:token/review", invitationHandler.RedeemInvitation)
+	}
+
+	// Reviewer profiles are public
+	router.GET("/reviewers/:id", reviewerHandler.GetReviewer)
+}
+
+// NewReviewPublisher sets up the dependencies of the background publisher of
+// reviews whose cooling-off period is over.
+func NewReviewPublisher(db *pgxpool.Pool, logger *zerolog.Logger, cfg *config.Config) *usecase.ReviewPublisherImpl {
+	return usecase.NewReviewPublisherImpl(
+		persistence.NewReviewRepositoryImpl(db),
+		persistence.NewOutboxRepositoryImpl(db),
+		persistence.NewTenantRepositoryImpl(db),
+		persistence.NewTxManagerImpl(db),
+		logger,
+		time.Duration(cfg.ReviewPublishIntervalSeconds)*time.Second,
+	)
 }
@@ -0,0 +1,38 @@
+package modules
+
+import (
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterReviewPolicyModule sets up the dependencies for review policies and registers their routes.
+func RegisterReviewPolicyModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+	// Dependencies for Review Policy module
+	policyRepo := persistence.NewReviewPolicyRepositoryImpl(db)
+	productRepo := persistence.NewProductRepositoryImpl(db)
+	categoryRepo := persistence.NewCategoryRepositoryImpl(db)
+	auditRepo := persistence.NewAuditRepositoryImpl(db)
+	txManager := persistence.NewTxManagerImpl(db)
+
+	policyUseCase := usecase.NewReviewPolicyUseCaseImpl(policyRepo, productRepo, categoryRepo, auditRepo, txManager)
+	policyHandler := handler.NewReviewPolicyHandler(policyUseCase)
+
+	admin := middleware.RequireRole(entity.RoleAdmin)
+
+	// Review policy routes
+	router.GET("/products/:id/review-policy", admin, policyHandler.GetProductPolicy)
+	router.PUT("/products/:id/review-policy", admin, policyHandler.PutProductPolicy)
+	router.DELETE("/products/:id/review-policy", admin, policyHandler.DeleteProductPolicy)
+	router.GET("/categories/:id/review-policy", admin, policyHandler.GetCategoryPolicy)
+	router.PUT("/categories/:id/review-policy", admin, policyHandler.PutCategoryPolicy)
+	router.DELETE("/categories/:id/review-policy", admin, policyHandler.DeleteCategoryPolicy)
+
+	// The rules in effect are public, so storefronts can show them to reviewers
+	router.GET("/products/:id/review-rules", policyHandler.GetProductRules)
+}
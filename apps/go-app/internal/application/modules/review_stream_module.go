@@ -0,0 +1,25 @@
+package modules
+
+import (
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/messaging/pgnotify"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// outboxChannel is the channel the outbox_notify trigger announces new events on.
+const outboxChannel = "outbox_events"
+
+// NewReviewStream sets up the dependencies of the review event stream. It
+// listens on its own connection, next to the pool.
+func NewReviewStream(db *pgxpool.Pool, logger *zerolog.Logger, cfg *config.Config) *usecase.ReviewStreamImpl {
+	outboxRepo := persistence.NewOutboxRepositoryImpl(db)
+	productRepo := persistence.NewProductRepositoryImpl(db)
+	txManager := persistence.NewTxManagerImpl(db)
+	listener := pgnotify.NewListener(cfg.DatabaseURL, outboxChannel, logger)
+
+	return usecase.NewReviewStreamImpl(outboxRepo, productRepo, txManager, listener, logger)
+}
@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+)
+
+// NewTenantMiddleware sets up tenant resolution and returns the middleware
+// scoping requests to their tenant.
+func NewTenantMiddleware(db *pgxpool.Pool, cfg *config.Config) gin.HandlerFunc {
+	tenantRepo := persistence.NewTenantRepositoryImpl(db)
+	tenantResolver := usecase.NewTenantResolverImpl(tenantRepo, cfg.DefaultTenant)
+
+	return middleware.TenantMiddleware(tenantResolver)
+}
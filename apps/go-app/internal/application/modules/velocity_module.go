@@ -0,0 +1,38 @@
+package modules
+
+import (
+	"time"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/persistence"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// NewVelocityLimiter sets up the dependencies of the review velocity limits
+// configured in cfg.
+func NewVelocityLimiter(db *pgxpool.Pool, logger *zerolog.Logger, cfg *config.Config) *usecase.VelocityLimiterImpl {
+	policies := []entity.VelocityPolicy{
+		{
+			Scope:  entity.VelocityScopeUser,
+			Limit:  cfg.ReviewUserLimit,
+			Window: time.Duration(cfg.ReviewUserWindowSeconds) * time.Second,
+			Action: cfg.ReviewUserLimitAction,
+		},
+		{
+			Scope:  entity.VelocityScopeProduct,
+			Limit:  cfg.ReviewProductLimit,
+			Window: time.Duration(cfg.ReviewProductWindowSeconds) * time.Second,
+			Action: cfg.ReviewProductLimitAction,
+		},
+	}
+
+	return usecase.NewVelocityLimiterImpl(
+		persistence.NewVelocityCounterRepositoryImpl(db),
+		persistence.NewTenantRepositoryImpl(db),
+		policies,
+		logger,
+	)
+}
@@ -0,0 +1,66 @@
+package modules
+
+import (
+	"time"
+	"user-review-ingest/internal/application/usecase"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/config"
+	"user-review-ingest/internal/infrastructure/http/handler"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/persistence"
+	"user-review-ingest/internal/infrastructure/webhook"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// RegisterWebhookModule sets up the dependencies for webhook subscriptions and registers their admin routes.
+func RegisterWebhookModule(router *gin.RouterGroup, db *pgxpool.Pool) {
+	// Dependencies for Webhook module
+	subscriptionRepo := persistence.NewWebhookSubscriptionRepositoryImpl(db)
+	deliveryRepo := persistence.NewWebhookDeliveryRepositoryImpl(db)
+
+	webhookUseCase := usecase.NewWebhookUseCaseImpl(subscriptionRepo, deliveryRepo)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+
+	// Webhook routes
+	webhooks := router.Group("/webhooks", middleware.RequireRole(entity.RoleAdmin))
+	{
+		webhooks.POST("", webhookHandler.CreateWebhook)
+		webhooks.GET("", webhookHandler.ListWebhooks)
+		webhooks.GET("/:id", webhookHandler.GetWebhook)
+		webhooks.PUT("/:id", webhookHandler.UpdateWebhook)
+		webhooks.DELETE("/:id", webhookHandler.DeleteWebhook)
+		webhooks.GET("/:id/deliveries", webhookHandler.ListDeliveries)
+		webhooks.POST("/:id/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+	}
+}
+
+// NewWebhookDispatcher sets up the dependencies of the dispatcher delivering
+// events to webhook subscriptions. sender may be nil to call endpoints over
+// HTTP as configured; tests pass their own.
+func NewWebhookDispatcher(db *pgxpool.Pool, sender webhook.Sender, logger *zerolog.Logger, cfg *config.Config) *usecase.WebhookDispatcherImpl {
+	txManager := persistence.NewTxManagerImpl(db)
+	subscriptionRepo := persistence.NewWebhookSubscriptionRepositoryImpl(db)
+	deliveryRepo := persistence.NewWebhookDeliveryRepositoryImpl(db)
+	tenantRepo := persistence.NewTenantRepositoryImpl(db)
+
+	if sender == nil {
+		timeout := time.Duration(cfg.WebhookTimeoutMs) * time.Millisecond
+		sender = webhook.NewHTTPSender(webhook.NewHTTPClient(timeout, cfg.WebhookAllowPrivateNetworks))
+	}
+
+	return usecase.NewWebhookDispatcherImpl(
+		subscriptionRepo,
+		deliveryRepo,
+		tenantRepo,
+		txManager,
+		sender,
+		logger,
+		time.Duration(cfg.WebhookPollIntervalMs)*time.Millisecond,
+		cfg.WebhookBatchSize,
+		cfg.WebhookMaxAttempts,
+		cfg.WebhookDisableAfter,
+	)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+)
+
+// recordAudit stores a snapshot of an entity before and after a change made by
+// the caller on ctx. A nil snapshot is stored as NULL.
+func recordAudit(ctx context.Context, auditRepo repository.AuditRepository, entityType string, entityID int64, action string, before, after interface{}) error {
+	entry := &entity.AuditEntry{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Actor:      entity.ActorFromContext(ctx),
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return err
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return err
+		}
+	}
+
+	return auditRepo.Record(ctx, entry)
+}
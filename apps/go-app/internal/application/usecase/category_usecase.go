@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/domain/valueobject"
+)
+
+type CategoryUseCaseImpl struct {
+	categoryRepo repository.CategoryRepository
+	auditRepo    repository.AuditRepository
+	txManager    repository.TxManager
+}
+
+func NewCategoryUseCaseImpl(
+	categoryRepo repository.CategoryRepository,
+	auditRepo repository.AuditRepository,
+	txManager repository.TxManager,
+) *CategoryUseCaseImpl {
+	return &CategoryUseCaseImpl{
+		categoryRepo: categoryRepo,
+		auditRepo:    auditRepo,
+		txManager:    txManager,
+	}
+}
+
+func (u *CategoryUseCaseImpl) Create(ctx context.Context, categoryDTO dto.CategoryInputDTO) (*dto.CategoryDTO, error) {
+	category := &entity.Category{}
+	if err := applyCategoryInput(category, categoryDTO); err != nil {
+		return nil, err
+	}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.categoryRepo.Create(ctx, category); err != nil {
+			return err
+		}
+		if err := u.categoryRepo.ReplaceAspects(ctx, category); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityCategory, category.ID, entity.AuditActionCreate, nil, toCategoryDTO(category))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryDTO(category), nil
+}
+
+func (u *CategoryUseCaseImpl) Retrieve(ctx context.Context, id int64) (*dto.CategoryDTO, error) {
+	category, err := u.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryDTO(category), nil
+}
+
+func (u *CategoryUseCaseImpl) Update(ctx context.Context, id int64, categoryDTO dto.CategoryInputDTO) (*dto.CategoryDTO, error) {
+	var category *entity.Category
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		category, err = u.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := toCategoryDTO(category)
+
+		if err := applyCategoryInput(category, categoryDTO); err != nil {
+			return err
+		}
+		if err := u.categoryRepo.Update(ctx, category); err != nil {
+			return err
+		}
+		if err := u.categoryRepo.ReplaceAspects(ctx, category); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityCategory, id, entity.AuditActionUpdate, before, toCategoryDTO(category))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryDTO(category), nil
+}
+
+// Delete removes a category with its aspects and their ratings. Its products
+// are left without a category.
+func (u *CategoryUseCaseImpl) Delete(ctx context.Context, id int64) error {
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		category, err := u.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.categoryRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityCategory, id, entity.AuditActionDelete, toCategoryDTO(category), nil)
+	})
+}
+
+func (u *CategoryUseCaseImpl) List(ctx context.Context, query dto.ListCategoriesQuery) ([]*dto.CategoryDTO, error) {
+	categories, err := u.categoryRepo.List(ctx, query.Offset, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*dto.CategoryDTO, 0, len(categories))
+	for _, category := range categories {
+		dtos = append(dtos, toCategoryDTO(category))
+	}
+	return dtos, nil
+}
+
+func applyCategoryInput(category *entity.Category, categoryDTO dto.CategoryInputDTO) error {
+	category.Slug = categoryDTO.Slug
+	category.Name = categoryDTO.Name
+	category.RatingScale = nil
+	if categoryDTO.RatingScale != nil {
+		scale, err := valueobject.NewRatingScale(categoryDTO.RatingScale.Min, categoryDTO.RatingScale.Max, categoryDTO.RatingScale.Step)
+		if err != nil {
+			return fmt.Errorf("%w: %v", domainerrors.ErrInvalidRatingScale, err)
+		}
+		category.RatingScale = &scale
+	}
+	category.Aspects = make([]*entity.CategoryAspect, 0, len(categoryDTO.Aspects))
+	for _, aspect := range categoryDTO.Aspects {
+		category.Aspects = append(category.Aspects, &entity.CategoryAspect{
+			CategoryID: category.ID,
+			Key:        aspect.Key,
+			Name:       aspect.Name,
+			Required:   aspect.Required,
+		})
+	}
+	return nil
+}
+
+func toCategoryDTO(category *entity.Category) *dto.CategoryDTO {
+	categoryDTO := &dto.CategoryDTO{
+		ID:        category.ID,
+		Slug:      category.Slug,
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: category.UpdatedAt.Format(time.RFC3339),
+	}
+	if category.RatingScale != nil {
+		scaleDTO := toRatingScaleDTO(*category.RatingScale)
+		categoryDTO.RatingScale = &scaleDTO
+	}
+	for _, aspect := range category.Aspects {
+		categoryDTO.Aspects = append(categoryDTO.Aspects, &dto.CategoryAspectDTO{
+			Key:      aspect.Key,
+			Name:     aspect.Name,
+			Required: aspect.Required,
+		})
+	}
+	return categoryDTO
+}
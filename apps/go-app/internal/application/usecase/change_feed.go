@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/messaging/pgnotify"
+
+	"github.com/rs/zerolog"
+)
+
+// changeFeedRecheck bounds how long a long-poll waits without reading the feed
+// again, in case a notification was lost while the listener reconnected.
+const changeFeedRecheck = 5 * time.Second
+
+// ChangeFeedImpl serves review changes in the order they were committed.
+// Changes are captured by a trigger on reviews and numbered from a sequence
+// only once committed, by one transaction per tenant at a time, so a change
+// never appears behind a token a reader has already passed. Reading the feed
+// numbers pending changes first; long-polls wake up on the notification the
+// trigger sends.
+type ChangeFeedImpl struct {
+	changeRepo repository.ReviewChangeRepository
+	reviewRepo repository.ReviewRepository
+	txManager  repository.TxManager
+	listener   *pgnotify.Listener
+	logger     *zerolog.Logger
+
+	mu sync.Mutex
+	// changed holds a channel per tenant with waiting readers, closed on
+	// the tenant's next change
+	changed map[int64]chan struct{}
+}
+
+func NewChangeFeedImpl(
+	changeRepo repository.ReviewChangeRepository,
+	reviewRepo repository.ReviewRepository,
+	txManager repository.TxManager,
+	listener *pgnotify.Listener,
+	logger *zerolog.Logger,
+) *ChangeFeedImpl {
+	return &ChangeFeedImpl{
+		changeRepo: changeRepo,
+		reviewRepo: reviewRepo,
+		txManager:  txManager,
+		listener:   listener,
+		logger:     logger,
+		changed:    make(map[int64]chan struct{}),
+	}
+}
+
+// Run listens for review changes until ctx is cancelled, waking the readers
+// waiting on them.
+func (f *ChangeFeedImpl) Run(ctx context.Context) {
+	f.listener.Listen(ctx, func(payload string) {
+		tenantID, err := strconv.ParseInt(payload, 10, 64)
+		if err != nil {
+			f.logger.Error().Err(err).Str("payload", payload).Msg("Ignoring malformed review change notification")
+			return
+		}
+		f.wake(tenantID)
+	}, f.wakeAll)
+}
+
+// List returns the changes following query.Since. When there are none and
+// query.Wait is set, it waits up to that many seconds for one to arrive.
+func (f *ChangeFeedImpl) List(ctx context.Context, query dto.ListChangesQuery) (*dto.ChangesPageDTO, error) {
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrTenantRequired
+	}
+	afterSeq, err := parseChangeToken(query.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(time.Duration(query.Wait) * time.Second)
+	for {
+		// Wait on the channel taken before reading, so that a change
+		// committed in between still wakes this reader
+		changed := f.waitFor(tenant.ID)
+
+		page, err := f.read(ctx, afterSeq, query.Limit)
+		if err != nil {
+			return nil, err
+		}
+		remaining := time.Until(deadline)
+		if len(page.Changes) > 0 || remaining <= 0 {
+			return page, nil
+		}
+
+		if remaining > changeFeedRecheck {
+			remaining = changeFeedRecheck
+		}
+		timer := time.NewTimer(remaining)
+		select {
+		case <-changed:
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		timer.Stop()
+	}
+}
+
+func (f *ChangeFeedImpl) read(ctx context.Context, afterSeq int64, limit int) (*dto.ChangesPageDTO, error) {
+	err := f.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := f.changeRepo.Sequence(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := f.changeRepo.ListAfter(ctx, afterSeq, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &dto.ChangesPageDTO{
+		Changes:   make([]*dto.ReviewChangeDTO, 0, len(changes)),
+		NextToken: formatChangeToken(afterSeq),
+	}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		page.HasMore = true
+	}
+
+	// Changes carry the current state of their review, not the state at the
+	// time of the change
+	var ids []int64
+	for _, change := range changes {
+		if change.Operation != entity.ReviewChangeDelete {
+			ids = append(ids, change.ReviewID)
+		}
+	}
+	reviews := make(map[int64]*entity.Review, len(ids))
+	if len(ids) > 0 {
+		current, err := f.reviewRepo.ListByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range current {
+			reviews[review.ID] = review
+		}
+	}
+
+	moderator := isModerator(ctx)
+	for _, change := range changes {
+		changeDTO := &dto.ReviewChangeDTO{
+			Seq:       change.Seq,
+			Operation: change.Operation,
+			ReviewID:  change.ReviewID,
+			ChangedAt: change.ChangedAt.Format(time.RFC3339),
+		}
+		review, ok := reviews[change.ReviewID]
+		if ok && (moderator || review.IsPublic()) {
+			changeDTO.Review = toReviewDTO(review)
+		} else {
+			// Deleted since, or not visible to the caller
+			changeDTO.Operation = entity.ReviewChangeDelete
+		}
+		page.Changes = append(page.Changes, changeDTO)
+		page.NextToken = formatChangeToken(change.Seq)
+	}
+
+	return page, nil
+}
+
+func (f *ChangeFeedImpl) waitFor(tenantID int64) <-chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	changed, ok := f.changed[tenantID]
+	if !ok {
+		changed = make(chan struct{})
+		f.changed[tenantID] = changed
+	}
+	return changed
+}
+
+func (f *ChangeFeedImpl) wake(tenantID int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if changed, ok := f.changed[tenantID]; ok {
+		close(changed)
+		delete(f.changed, tenantID)
+	}
+}
+
+// wakeAll wakes every waiting reader, after the listener (re)connected and
+// may have missed notifications.
+func (f *ChangeFeedImpl) wakeAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for tenantID, changed := range f.changed {
+		close(changed)
+		delete(f.changed, tenantID)
+	}
+}
+
+// Tokens are opaque to clients; they hold the sequence number of the last
+// change read.
+func formatChangeToken(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
+
+func parseChangeToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	seq, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, domainerrors.ErrInvalidChangeToken
+	}
+	return seq, nil
+}
@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/storage"
+)
+
+type DataSubjectUseCaseImpl struct {
+	dataSubjectRepo repository.DataSubjectRepository
+	requestRepo     repository.DataSubjectRequestRepository
+	revocationRepo  repository.TokenRevocationRepository
+	outboxRepo      repository.OutboxRepository
+	txManager       repository.TxManager
+	blobStore       storage.BlobStore
+}
+
+func NewDataSubjectUseCaseImpl(
+	dataSubjectRepo repository.DataSubjectRepository,
+	requestRepo repository.DataSubjectRequestRepository,
+	revocationRepo repository.TokenRevocationRepository,
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+	blobStore storage.BlobStore,
+) *DataSubjectUseCaseImpl {
+	return &DataSubjectUseCaseImpl{
+		dataSubjectRepo: dataSubjectRepo,
+		requestRepo:     requestRepo,
+		revocationRepo:  revocationRepo,
+		outboxRepo:      outboxRepo,
+		txManager:       txManager,
+		blobStore:       blobStore,
+	}
+}
+
+func (u *DataSubjectUseCaseImpl) Export(ctx context.Context, userID int64, query dto.DataSubjectExportQuery) ([]byte, *dto.DataSubjectRequestDTO, error) {
+	var archive []byte
+	request := &entity.DataSubjectRequest{
+		Kind:        entity.DataSubjectExport,
+		UserID:      userID,
+		AccountID:   query.AccountID,
+		RequestedBy: entity.ActorFromContext(ctx),
+	}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		data, err := u.dataSubjectRepo.Collect(ctx, userID, query.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if archive, err = json.MarshalIndent(u.toArchiveDTO(userID, data), "", "  "); err != nil {
+			return err
+		}
+		sum := sha256.Sum256(archive)
+		digest := hex.EncodeToString(sum[:])
+		request.ArchiveSHA256 = &digest
+		request.Summary = personalDataSummary(data)
+
+		return u.requestRepo.Create(ctx, request)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return archive, toDataSubjectRequestDTO(request), nil
+}
+
+func (u *DataSubjectUseCaseImpl) ExportSelf(ctx context.Context) ([]byte, *dto.DataSubjectRequestDTO, error) {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, nil, domainerrors.ErrAuthenticationRequired
+	}
+	return u.Export(ctx, principal.UserID, dto.DataSubjectExportQuery{})
+}
+
+// Erase removes the user's data in one transaction, so that the request is
+// only recorded as fulfilled once everything is gone. Each live review erased
+// emits review.deleted, or review.updated when anonymized. Stored files are
+// removed after the commit.
+func (u *DataSubjectUseCaseImpl) Erase(ctx context.Context, userID int64, eraseDTO dto.EraseDataSubjectDTO) (*dto.DataSubjectRequestDTO, error) {
+	policy := eraseDTO.ReviewPolicy
+	if policy == "" {
+		policy = entity.ReviewErasureAnonymize
+	}
+
+	var blobKeys []string
+	request := &entity.DataSubjectRequest{
+		Kind:         entity.DataSubjectErasure,
+		UserID:       userID,
+		AccountID:    eraseDTO.AccountID,
+		RequestedBy:  entity.ActorFromContext(ctx),
+		ReviewPolicy: &policy,
+	}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		data, err := u.dataSubjectRepo.Collect(ctx, userID, eraseDTO.AccountID)
+		if err != nil {
+			return err
+		}
+		// Attachments go with deleted reviews; anonymized reviews keep theirs
+		if policy == entity.ReviewErasureDelete {
+			for _, attachment := range data.Attachments {
+				blobKeys = append(blobKeys, attachment.BlobKey, attachment.ThumbnailKey)
+			}
+		}
+		for _, job := range data.ExportJobs {
+			if job.BlobKey != nil {
+				blobKeys = append(blobKeys, *job.BlobKey)
+			}
+		}
+
+		erasure, err := u.dataSubjectRepo.Erase(ctx, userID, eraseDTO.AccountID, policy)
+		if err != nil {
+			return err
+		}
+		request.Summary = erasure.Summary
+
+		eventType := entity.EventReviewUpdated
+		if policy == entity.ReviewErasureDelete {
+			eventType = entity.EventReviewDeleted
+		}
+		// Events name the reviews only, so the erased data does not travel
+		// on; subscribers never heard of reviews still cooling off
+		for _, review := range erasure.Reviews {
+			if !review.IsPublished() {
+				continue
+			}
+			tombstone := dto.ReviewTombstoneDTO{ID: review.ID, ProductID: review.ProductID}
+			if err := recordEvent(ctx, u.outboxRepo, entity.AggregateReview, review.ID, eventType, tombstone); err != nil {
+				return err
+			}
+		}
+
+		if err := u.revocationRepo.Revoke(ctx, userID, time.Now()); err != nil {
+			return err
+		}
+		return u.requestRepo.Create(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects left behind are unreachable once their rows are gone, so
+	// failures are ignored
+	for _, key := range blobKeys {
+		_ = u.blobStore.Delete(ctx, key)
+	}
+
+	return toDataSubjectRequestDTO(request), nil
+}
+
+func (u *DataSubjectUseCaseImpl) ListRequests(ctx context.Context, userID int64) ([]*dto.DataSubjectRequestDTO, error) {
+	requests, err := u.requestRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*dto.DataSubjectRequestDTO, 0, len(requests))
+	for _, request := range requests {
+		dtos = append(dtos, toDataSubjectRequestDTO(request))
+	}
+	return dtos, nil
+}
+
+func (u *DataSubjectUseCaseImpl) toArchiveDTO(userID int64, data *entity.PersonalData) *dto.DataSubjectArchiveDTO {
+	archive := &dto.DataSubjectArchiveDTO{
+		UserID:          userID,
+		GeneratedAt:     time.Now().UTC().Format(time.RFC3339),
+		Reviews:         make([]*dto.ArchivedReviewDTO, 0, len(data.Reviews)),
+		Votes:           make([]*dto.ArchivedVoteDTO, 0, len(data.Votes)),
+		Reports:         toReviewReportDTOs(data.Reports),
+		Replies:         make([]*dto.ArchivedReplyDTO, 0, len(data.Replies)),
+		Questions:       make([]*dto.ArchivedQuestionDTO, 0, len(data.Questions)),
+		Answers:         make([]*dto.ArchivedAnswerDTO, 0, len(data.Answers)),
+		AnswerVotes:     make([]*dto.ArchivedAnswerVoteDTO, 0, len(data.AnswerVotes)),
+		Attachments:     make([]*dto.ArchivedAttachmentDTO, 0, len(data.Attachments)),
+		Orders:          make([]*dto.ArchivedOrderDTO, 0, len(data.Orders)),
+		Invitations:     make([]*dto.ReviewInvitationDTO, 0, len(data.Invitations)),
+		OwnedProductIDs: data.OwnedProductIDs,
+		ExportJobs:      make([]*dto.ExportJobDTO, 0, len(data.ExportJobs)),
+	}
+	if archive.OwnedProductIDs == nil {
+		archive.OwnedProductIDs = []int64{}
+	}
+
+	for _, review := range data.Reviews {
+		archive.Reviews = append(archive.Reviews, &dto.ArchivedReviewDTO{
+			ReviewDTO: *toReviewDTO(review),
+			DeletedAt: formatOptionalTime(review.DeletedAt),
+		})
+	}
+	for _, vote := range data.Votes {
+		archive.Votes = append(archive.Votes, &dto.ArchivedVoteDTO{
+			ReviewID:  vote.ReviewID,
+			Helpful:   vote.Helpful,
+			CreatedAt: vote.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: vote.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	for _, reply := range data.Replies {
+		archive.Replies = append(archive.Replies, &dto.ArchivedReplyDTO{
+			ReviewReplyDTO: *toReviewReplyDTO(reply),
+			ReviewID:       reply.ReviewID,
+			DeletedAt:      formatOptionalTime(reply.DeletedAt),
+		})
+	}
+	for _, question := range data.Questions {
+		archive.Questions = append(archive.Questions, &dto.ArchivedQuestionDTO{
+			ID:        question.ID,
+			ProductID: question.ProductID,
+			Body:      question.Body,
+			Status:    question.Status,
+			CreatedAt: question.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: question.UpdatedAt.Format(time.RFC3339),
+			DeletedAt: formatOptionalTime(question.DeletedAt),
+		})
+	}
+	for _, answer := range data.Answers {
+		archive.Answers = append(archive.Answers, &dto.ArchivedAnswerDTO{
+			ProductAnswerDTO: *toProductAnswerDTO(answer),
+			DeletedAt:        formatOptionalTime(answer.DeletedAt),
+		})
+	}
+	for _, vote := range data.AnswerVotes {
+		archive.AnswerVotes = append(archive.AnswerVotes, &dto.ArchivedAnswerVoteDTO{
+			AnswerID:  vote.AnswerID,
+			Helpful:   vote.Helpful,
+			CreatedAt: vote.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: vote.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	for _, attachment := range data.Attachments {
+		archive.Attachments = append(archive.Attachments, &dto.ArchivedAttachmentDTO{
+			ReviewAttachmentDTO: *toReviewAttachmentDTO(attachment, u.blobStore),
+			ReviewID:            attachment.ReviewID,
+			DeletedAt:           formatOptionalTime(attachment.DeletedAt),
+		})
+	}
+	for _, order := range data.Orders {
+		archive.Orders = append(archive.Orders, &dto.ArchivedOrderDTO{
+			OrderID:     order.ExternalID,
+			ProductID:   order.ProductID,
+			PurchasedAt: order.PurchasedAt.Format(time.RFC3339),
+		})
+	}
+	for _, invitation := range data.Invitations {
+		archive.Invitations = append(archive.Invitations, toReviewInvitationDTO(invitation))
+	}
+	for _, job := range data.ExportJobs {
+		archive.ExportJobs = append(archive.ExportJobs, toExportJobDTO(job))
+	}
+	if data.Reviewer != nil {
+		archive.Reviewer = toReviewerProfileDTO(data.Reviewer)
+	}
+
+	if account := data.Account; account != nil {
+		archive.Account = &dto.ArchivedAccountDTO{
+			ID:          account.Auth.ID,
+			Email:       account.Auth.Email,
+			Status:      account.Auth.Status,
+			CreatedAt:   account.Auth.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   account.Auth.UpdatedAt.Format(time.RFC3339),
+			Profiles:    make([]*dto.ArchivedProfileDTO, 0, len(account.Profiles)),
+			Connections: make([]*dto.ArchivedConnectionDTO, 0, len(account.Connections)),
+		}
+		for _, profile := range account.Profiles {
+			archive.Account.Profiles = append(archive.Account.Profiles, &dto.ArchivedProfileDTO{
+				ID:        profile.ID,
+				Email:     profile.Email,
+				Name:      profile.Name,
+				CreatedAt: profile.CreatedAt.Format(time.RFC3339),
+				UpdatedAt: profile.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+		for _, connection := range account.Connections {
+			archive.Account.Connections = append(archive.Account.Connections, &dto.ArchivedConnectionDTO{
+				Provider:       connection.ProviderName,
+				ProviderUserID: connection.ProviderUserID,
+				Email:          connection.Email,
+				Name:           connection.Name,
+				Scopes:         connection.Scopes,
+				CreatedAt:      connection.CreatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+
+	return archive
+}
+
+// personalDataSummary counts the exported records per kind.
+func personalDataSummary(data *entity.PersonalData) map[string]int64 {
+	summary := map[string]int64{
+		"reviews":            int64(len(data.Reviews)),
+		"votes":              int64(len(data.Votes)),
+		"reports":            int64(len(data.Reports)),
+		"replies":            int64(len(data.Replies)),
+		"questions":          int64(len(data.Questions)),
+		"answers":            int64(len(data.Answers)),
+		"answer_votes":       int64(len(data.AnswerVotes)),
+		"attachments":        int64(len(data.Attachments)),
+		"orders":             int64(len(data.Orders)),
+		"review_invitations": int64(len(data.Invitations)),
+		"product_ownerships": int64(len(data.OwnedProductIDs)),
+		"export_jobs":        int64(len(data.ExportJobs)),
+	}
+	if data.Reviewer != nil {
+		summary["reviewer_stats"] = 1
+	}
+	if data.Account != nil {
+		summary["accounts"] = 1
+		summary["profiles"] = int64(len(data.Account.Profiles))
+		summary["oauth_connections"] = int64(len(data.Account.Connections))
+	}
+	return summary
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toDataSubjectRequestDTO(request *entity.DataSubjectRequest) *dto.DataSubjectRequestDTO {
+	return &dto.DataSubjectRequestDTO{
+		ID:            request.ID,
+		Kind:          request.Kind,
+		UserID:        request.UserID,
+		AccountID:     request.AccountID,
+		RequestedBy:   request.RequestedBy,
+		ReviewPolicy:  request.ReviewPolicy,
+		Summary:       request.Summary,
+		ArchiveSHA256: request.ArchiveSHA256,
+		FulfilledAt:   request.FulfilledAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/domain/valueobject"
+)
+
+// maxDuplicateLinks caps how many suspected originals are linked to a review.
+const maxDuplicateLinks = 10
+
+// DuplicateDetectorImpl finds near-duplicate reviews by comparing SimHash
+// fingerprints of their comments. Candidates are looked up by the bands of
+// the fingerprint, so only matches within valueobject.SimHashBands-1 bits are
+// guaranteed to be found; maxDistance is capped accordingly.
+type DuplicateDetectorImpl struct {
+	duplicateRepo repository.ReviewDuplicateRepository
+	minWords      int
+	maxDistance   int
+	lookback      time.Duration
+}
+
+func NewDuplicateDetectorImpl(
+	duplicateRepo repository.ReviewDuplicateRepository,
+	minWords int,
+	maxDistance int,
+	lookback time.Duration,
+) *DuplicateDetectorImpl {
+	if maxDistance > valueobject.SimHashBands-1 {
+		maxDistance = valueobject.SimHashBands - 1
+	}
+	return &DuplicateDetectorImpl{
+		duplicateRepo: duplicateRepo,
+		minWords:      minWords,
+		maxDistance:   maxDistance,
+		lookback:      lookback,
+	}
+}
+
+func (d *DuplicateDetectorImpl) Inspect(ctx context.Context, review *entity.Review) ([]*entity.ReviewDuplicate, error) {
+	fingerprint, ok := valueobject.NewSimHash(review.Comment, d.minWords)
+	if !ok {
+		review.Fingerprint = nil
+		return nil, nil
+	}
+	review.Fingerprint = &fingerprint
+	if d.maxDistance < 0 || d.lookback <= 0 {
+		return nil, nil
+	}
+
+	duplicates, err := d.duplicateRepo.FindNear(ctx, review.ID, fingerprint, d.maxDistance, time.Now().Add(-d.lookback))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Distance < duplicates[j].Distance
+	})
+	if len(duplicates) > maxDuplicateLinks {
+		duplicates = duplicates[:maxDuplicateLinks]
+	}
+	return duplicates, nil
+}
+
+func (d *DuplicateDetectorImpl) Link(ctx context.Context, review *entity.Review, duplicates []*entity.ReviewDuplicate) error {
+	for _, duplicate := range duplicates {
+		duplicate.ReviewID = review.ID
+	}
+	return d.duplicateRepo.Replace(ctx, review.ID, duplicates)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+)
+
+// recordEvent appends a domain event about an aggregate to the outbox. Call
+// it inside the transaction that makes the change.
+func recordEvent(ctx context.Context, outboxRepo repository.OutboxRepository, aggregateType string, aggregateID int64, eventType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return outboxRepo.Append(ctx, &entity.OutboxEvent{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       data,
+	})
+}
+
+// recordReviewEvent appends an event about the review to the outbox. Nothing
+// about a review within its cooling-off period may reach subscribers, so no
+// event is recorded for it until review.published announces it.
+func recordReviewEvent(ctx context.Context, outboxRepo repository.OutboxRepository, review *entity.Review, eventType string) error {
+	if !review.IsPublished() {
+		return nil
+	}
+	return recordEvent(ctx, outboxRepo, entity.AggregateReview, review.ID, eventType, toReviewDTO(review))
+}
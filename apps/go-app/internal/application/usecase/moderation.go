@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+)
+
+// isModerator reports whether the caller on ctx may see and moderate reviews
+// in every status.
+func isModerator(ctx context.Context) bool {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	return ok && principal.HasRole(entity.RoleAdmin)
+}
+
+// canSeeUnpublished reports whether the caller on ctx may see the review while
+// it is not public: moderators and the review's author can.
+func canSeeUnpublished(ctx context.Context, review *entity.Review) bool {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	return ok && (principal.HasRole(entity.RoleAdmin) || principal.UserID == review.UserID)
+}
+
+// authorizeReviewChange checks that the caller on ctx may change the review:
+// its author or a moderator. Reviews the caller may not see are not found.
+func authorizeReviewChange(ctx context.Context, review *entity.Review) error {
+	if _, ok := entity.PrincipalFromContext(ctx); !ok {
+		return domainerrors.ErrAuthenticationRequired
+	}
+	if !review.IsPublic() && !canSeeUnpublished(ctx, review) {
+		return domainerrors.ErrReviewNotFound
+	}
+	if !isAuthorOrModerator(ctx, review.UserID) {
+		return domainerrors.ErrNotReviewAuthor
+	}
+	return nil
+}
+
+// isAuthorOrModerator reports whether the caller on ctx is the user or a
+// moderator.
+func isAuthorOrModerator(ctx context.Context, userID int64) bool {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	return ok && (principal.HasRole(entity.RoleAdmin) || principal.UserID == userID)
+}
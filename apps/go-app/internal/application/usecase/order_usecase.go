@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type OrderUseCaseImpl struct {
+	orderRepo repository.OrderRepository
+	txManager repository.TxManager
+}
+
+func NewOrderUseCaseImpl(orderRepo repository.OrderRepository, txManager repository.TxManager) *OrderUseCaseImpl {
+	return &OrderUseCaseImpl{
+		orderRepo: orderRepo,
+		txManager: txManager,
+	}
+}
+
+func (u *OrderUseCaseImpl) Ingest(ctx context.Context, orderDTO dto.OrderDTO) (*dto.OrderIngestResultDTO, error) {
+	return u.IngestBulk(ctx, dto.BulkOrdersDTO{Orders: []dto.OrderDTO{orderDTO}})
+}
+
+// IngestBulk stores every order line atomically. Re-sending an order is safe:
+// lines are keyed by order ID and product. Reviews the customer already wrote
+// for a product after buying it are verified retroactively.
+func (u *OrderUseCaseImpl) IngestBulk(ctx context.Context, bulkDTO dto.BulkOrdersDTO) (*dto.OrderIngestResultDTO, error) {
+	result := &dto.OrderIngestResultDTO{}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, orderDTO := range bulkDTO.Orders {
+			for _, productID := range orderDTO.ProductIDs {
+				order := &entity.Order{
+					ExternalID:  orderDTO.OrderID,
+					CustomerID:  orderDTO.CustomerID,
+					ProductID:   productID,
+					PurchasedAt: orderDTO.PurchasedAt,
+				}
+				if err := u.orderRepo.Upsert(ctx, order); err != nil {
+					return err
+				}
+
+				verified, err := u.orderRepo.VerifyReviews(ctx, order)
+				if err != nil {
+					return err
+				}
+				result.ReviewsVerified += verified
+			}
+			result.OrdersIngested++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
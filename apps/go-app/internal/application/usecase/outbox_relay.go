@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/messaging"
+
+	"github.com/rs/zerolog"
+)
+
+// Retry delays grow exponentially from outboxBaseBackoff up to outboxMaxBackoff.
+const (
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 10 * time.Minute
+)
+
+// OutboxRelayImpl publishes the events stored in the outbox. An event is marked
+// published only after the publisher accepted it, so a crash in between
+// publishes it again: delivery is at-least-once. Events of one aggregate are
+// published in the order they were written; an event that keeps failing is
+// dead-lettered after maxAttempts and stops holding back the ones after it.
+type OutboxRelayImpl struct {
+	outboxRepo   repository.OutboxRepository
+	tenantRepo   repository.TenantRepository
+	txManager    repository.TxManager
+	publisher    messaging.Publisher
+	logger       *zerolog.Logger
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+}
+
+func NewOutboxRelayImpl(
+	outboxRepo repository.OutboxRepository,
+	tenantRepo repository.TenantRepository,
+	txManager repository.TxManager,
+	publisher messaging.Publisher,
+	logger *zerolog.Logger,
+	pollInterval time.Duration,
+	batchSize int,
+	maxAttempts int,
+) *OutboxRelayImpl {
+	return &OutboxRelayImpl{
+		outboxRepo:   outboxRepo,
+		tenantRepo:   tenantRepo,
+		txManager:    txManager,
+		publisher:    publisher,
+		logger:       logger,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+// Run relays events until ctx is cancelled. After a round that found events
+// the next one starts straight away; otherwise the relay waits for the poll
+// interval.
+func (r *OutboxRelayImpl) Run(ctx context.Context) {
+	for {
+		relayed, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Error().Err(err).Msg("Outbox relay failed")
+		}
+		if relayed > 0 && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// RelayOnce publishes one batch of due events for every tenant and returns
+// how many were handled.
+func (r *OutboxRelayImpl) RelayOnce(ctx context.Context) (int, error) {
+	tenants, err := r.tenantRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for _, tenant := range tenants {
+		// The outbox is tenant-scoped like every other table, so it is drained one tenant at a time
+		relayed, err := r.relayTenant(entity.ContextWithTenant(ctx, tenant))
+		total += relayed
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+func (r *OutboxRelayImpl) relayTenant(ctx context.Context) (int, error) {
+	var relayed int
+
+	err := r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		events, err := r.outboxRepo.ClaimPending(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := r.relay(ctx, event); err != nil {
+				return err
+			}
+		}
+		relayed = len(events)
+		return nil
+	})
+
+	return relayed, err
+}
+
+// relay publishes a claimed event and records the outcome. Only failures to
+// record the outcome are returned; publishing failures are retried later.
+func (r *OutboxRelayImpl) relay(ctx context.Context, event *entity.OutboxEvent) error {
+	publishErr := r.publisher.Publish(ctx, event)
+	if publishErr == nil {
+		return r.outboxRepo.MarkPublished(ctx, event.ID)
+	}
+
+	attempts := event.Attempts + 1
+	if attempts >= r.maxAttempts {
+		r.logger.Error().Err(publishErr).
+			Int64("event_id", event.ID).
+			Str("event_type", event.EventType).
+			Int("attempts", attempts).
+			Msg("Dead-lettering outbox event")
+		return r.outboxRepo.DeadLetter(ctx, event.ID, publishErr.Error())
+	}
+
+	r.logger.Warn().Err(publishErr).
+		Int64("event_id", event.ID).
+		Int("attempts", attempts).
+		Msg("Publishing outbox event failed, retrying later")
+	return r.outboxRepo.MarkFailed(ctx, event.ID, time.Now().Add(outboxBackoff(attempts)), publishErr.Error())
+}
+
+// outboxBackoff returns the delay before retrying an event that failed attempts times.
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxBaseBackoff
+	for i := 1; i < attempts && delay < outboxMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return delay
+}
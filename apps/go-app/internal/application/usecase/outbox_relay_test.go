@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/infrastructure/messaging/memory"
+
+	"github.com/rs/zerolog"
+)
+
+// fakeTenantRepo lists a fixed set of tenants.
+type fakeTenantRepo struct {
+	tenants []*entity.Tenant
+}
+
+func (r *fakeTenantRepo) List(ctx context.Context) ([]*entity.Tenant, error) {
+	return r.tenants, nil
+}
+
+func (r *fakeTenantRepo) GetBySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeTenantRepo) GetByHostname(ctx context.Context, hostname string) (*entity.Tenant, error) {
+	return nil, errors.New("not implemented")
+}
+
+// fakeTxManager runs the unit of work without a transaction.
+type fakeTxManager struct{}
+
+func (fakeTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeOutboxEvent struct {
+	event         *entity.OutboxEvent
+	published     bool
+	deadLettered  bool
+	nextAttemptAt time.Time
+}
+
+// fakeOutboxRepo claims events the way the outbox queries do: the oldest
+// event of each aggregate that is neither published nor dead-lettered, once
+// it is due.
+type fakeOutboxRepo struct {
+	mu     sync.Mutex
+	now    time.Time
+	events []*fakeOutboxEvent
+}
+
+func (r *fakeOutboxRepo) Append(ctx context.Context, event *entity.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event.ID = int64(len(r.events) + 1)
+	r.events = append(r.events, &fakeOutboxEvent{event: event})
+	return nil
+}
+
+func (r *fakeOutboxRepo) GetByID(ctx context.Context, id int64) (*entity.OutboxEvent, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeOutboxRepo) Sequence(ctx context.Context) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (r *fakeOutboxRepo) LatestSeq(ctx context.Context, aggregateType string) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (r *fakeOutboxRepo) ListAfter(ctx context.Context, aggregateType string, afterSeq int64, limit int) ([]*entity.OutboxEvent, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeOutboxRepo) ClaimPending(ctx context.Context, limit int) ([]*entity.OutboxEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seen := make(map[int64]bool)
+	var claimed []*entity.OutboxEvent
+	for _, stored := range r.events {
+		if stored.published || stored.deadLettered || seen[stored.event.AggregateID] {
+			continue
+		}
+		seen[stored.event.AggregateID] = true
+		if stored.nextAttemptAt.After(r.now) || len(claimed) == limit {
+			continue
+		}
+		claimed = append(claimed, stored.event)
+	}
+	return claimed, nil
+}
+
+func (r *fakeOutboxRepo) MarkPublished(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events[id-1].published = true
+	return nil
+}
+
+func (r *fakeOutboxRepo) MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events[id-1].event.Attempts++
+	r.events[id-1].nextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (r *fakeOutboxRepo) DeadLetter(ctx context.Context, id int64, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events[id-1].event.Attempts++
+	r.events[id-1].deadLettered = true
+	return nil
+}
+
+// advance moves the repository's clock past every pending retry.
+func (r *fakeOutboxRepo) advance() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.now = time.Now().Add(2 * outboxMaxBackoff)
+}
+
+// flakyPublisher fails the first failures[id] attempts to publish event id.
+type flakyPublisher struct {
+	*memory.Publisher
+	failures map[int64]int
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	if p.failures[event.ID] > 0 {
+		p.failures[event.ID]--
+		return errors.New("broker unavailable")
+	}
+	return p.Publisher.Publish(ctx, event)
+}
+
+func TestOutboxRelayPublishesEachAggregateInOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		// aggregates lists the aggregate of each event, in the order written
+		aggregates  []int64
+		failures    map[int64]int
+		maxAttempts int
+		batchSize   int
+		// want lists the published event IDs of each aggregate, in order
+		want map[int64][]int64
+	}{
+		{
+			name:        "interleaved aggregates",
+			aggregates:  []int64{1, 2, 1, 3, 2, 1},
+			maxAttempts: 5,
+			batchSize:   10,
+			want:        map[int64][]int64{1: {1, 3, 6}, 2: {2, 5}, 3: {4}},
+		},
+		{
+			name:        "small batches",
+			aggregates:  []int64{1, 1, 2, 2, 3, 3},
+			maxAttempts: 5,
+			batchSize:   1,
+			want:        map[int64][]int64{1: {1, 2}, 2: {3, 4}, 3: {5, 6}},
+		},
+		{
+			name:        "failed event holds back its aggregate until retried",
+			aggregates:  []int64{1, 1, 2},
+			failures:    map[int64]int{1: 2},
+			maxAttempts: 5,
+			batchSize:   10,
+			want:        map[int64][]int64{1: {1, 2}, 2: {3}},
+		},
+		{
+			name:        "dead-lettered event stops holding back its aggregate",
+			aggregates:  []int64{1, 1, 1},
+			failures:    map[int64]int{2: 10},
+			maxAttempts: 3,
+			batchSize:   10,
+			want:        map[int64][]int64{1: {1, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			outbox := &fakeOutboxRepo{now: time.Now()}
+			for _, aggregateID := range tt.aggregates {
+				if err := outbox.Append(ctx, &entity.OutboxEvent{
+					AggregateType: entity.AggregateReview,
+					AggregateID:   aggregateID,
+					EventType:     entity.EventReviewUpdated,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			failures := make(map[int64]int)
+			for id, count := range tt.failures {
+				failures[id] = count
+			}
+			publisher := &flakyPublisher{Publisher: memory.NewPublisher(), failures: failures}
+			logger := zerolog.Nop()
+			relay := NewOutboxRelayImpl(outbox, &fakeTenantRepo{tenants: []*entity.Tenant{{ID: 1}}}, fakeTxManager{}, publisher, &logger, time.Second, tt.batchSize, tt.maxAttempts)
+
+			for round := 0; round < 50; round++ {
+				if _, err := relay.RelayOnce(ctx); err != nil {
+					t.Fatalf("round %d: %v", round, err)
+				}
+				outbox.advance()
+			}
+
+			got := make(map[int64][]int64)
+			for _, event := range publisher.Events() {
+				got[event.AggregateID] = append(got[event.AggregateID], event.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("published aggregates = %v, want %v", got, tt.want)
+			}
+			for aggregateID, want := range tt.want {
+				if !slices.Equal(got[aggregateID], want) {
+					t.Errorf("aggregate %d published %v, want %v", aggregateID, got[aggregateID], want)
+				}
+			}
+		})
+	}
+}
+
+func TestOutboxRelayKeepsFailedEventsForRetry(t *testing.T) {
+	ctx := context.Background()
+	outbox := &fakeOutboxRepo{now: time.Now()}
+	if err := outbox.Append(ctx, &entity.OutboxEvent{AggregateType: entity.AggregateReview, AggregateID: 1, EventType: entity.EventReviewCreated}); err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := memory.NewPublisher()
+	publisher.FailWith(errors.New("broker unavailable"))
+	logger := zerolog.Nop()
+	relay := NewOutboxRelayImpl(outbox, &fakeTenantRepo{tenants: []*entity.Tenant{{ID: 1}}}, fakeTxManager{}, publisher, &logger, time.Second, 10, 5)
+
+	before := time.Now()
+	if _, err := relay.RelayOnce(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := outbox.events[0]
+	if stored.published || stored.deadLettered {
+		t.Fatalf("failed event was published or dead-lettered")
+	}
+	if stored.event.Attempts != 1 {
+		t.Errorf("attempts = %d, want 1", stored.event.Attempts)
+	}
+	if stored.nextAttemptAt.Before(before.Add(outboxBaseBackoff)) {
+		t.Errorf("next attempt at %v, want at least %v later", stored.nextAttemptAt, outboxBaseBackoff)
+	}
+
+	// Not due yet: the event is not claimed again
+	if relayed, err := relay.RelayOnce(ctx); err != nil || relayed != 0 {
+		t.Fatalf("relayed %d events before the retry was due (err %v)", relayed, err)
+	}
+
+	publisher.FailWith(nil)
+	outbox.advance()
+	if _, err := relay.RelayOnce(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !stored.published || len(publisher.Events()) != 1 {
+		t.Errorf("event was not published once the retry was due")
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 8, want: 128 * time.Second},
+		{attempts: 10, want: 512 * time.Second},
+		{attempts: 11, want: outboxMaxBackoff},
+		{attempts: 50, want: outboxMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := outboxBackoff(tt.attempts); got != tt.want {
+			t.Errorf("outboxBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,408 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ProductQAUseCaseImpl struct {
+	productRepo  repository.ProductRepository
+	ownerRepo    repository.ProductOwnerRepository
+	questionRepo repository.ProductQuestionRepository
+	answerRepo   repository.ProductAnswerRepository
+	auditRepo    repository.AuditRepository
+	txManager    repository.TxManager
+}
+
+func NewProductQAUseCaseImpl(
+	productRepo repository.ProductRepository,
+	ownerRepo repository.ProductOwnerRepository,
+	questionRepo repository.ProductQuestionRepository,
+	answerRepo repository.ProductAnswerRepository,
+	auditRepo repository.AuditRepository,
+	txManager repository.TxManager,
+) *ProductQAUseCaseImpl {
+	return &ProductQAUseCaseImpl{
+		productRepo:  productRepo,
+		ownerRepo:    ownerRepo,
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		auditRepo:    auditRepo,
+		txManager:    txManager,
+	}
+}
+
+// Ask records a question about a product. Like reviews, questions can only be
+// asked about live catalog products.
+func (u *ProductQAUseCaseImpl) Ask(ctx context.Context, productID, userID int64, questionDTO dto.ProductQuestionInputDTO) (*dto.ProductQuestionDTO, error) {
+	product, err := u.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsArchived() {
+		return nil, domainerrors.ErrProductArchived
+	}
+
+	question := &entity.ProductQuestion{
+		ProductID: productID,
+		UserID:    userID,
+		Body:      questionDTO.Body,
+		Status:    entity.ReviewStatusApproved,
+	}
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.questionRepo.Create(ctx, question); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityQuestion, question.ID, entity.AuditActionCreate, nil, toProductQuestionDTO(question))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductQuestionDTO(question), nil
+}
+
+func (u *ProductQAUseCaseImpl) ListQuestions(ctx context.Context, productID int64, query dto.ListProductQuestionsQuery) ([]*dto.ProductQuestionDTO, error) {
+	status := entity.ReviewStatusApproved
+	if query.Status != nil && *query.Status != status {
+		if !isModerator(ctx) {
+			return nil, domainerrors.ErrNotModerator
+		}
+		status = *query.Status
+	}
+
+	if _, err := u.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	questions, err := u.questionRepo.List(ctx, repository.ProductQuestionFilter{
+		ProductID: productID,
+		Status:    &status,
+		Offset:    query.Offset,
+		Limit:     query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := u.loadAnswers(ctx, questions); err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*dto.ProductQuestionDTO, 0, len(questions))
+	for _, question := range questions {
+		dtos = append(dtos, toProductQuestionDTO(question))
+	}
+	return dtos, nil
+}
+
+// GetQuestion returns a question with its answers. Questions awaiting or
+// failing moderation are visible to their author and moderators only.
+func (u *ProductQAUseCaseImpl) GetQuestion(ctx context.Context, id int64) (*dto.ProductQuestionDTO, error) {
+	question, err := u.questionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if question.Status != entity.ReviewStatusApproved && !isAuthorOrModerator(ctx, question.UserID) {
+		return nil, domainerrors.ErrQuestionNotFound
+	}
+	if err := u.loadAnswers(ctx, []*entity.ProductQuestion{question}); err != nil {
+		return nil, err
+	}
+
+	return toProductQuestionDTO(question), nil
+}
+
+func (u *ProductQAUseCaseImpl) UpdateQuestion(ctx context.Context, id int64, questionDTO dto.ProductQuestionInputDTO) (*dto.ProductQuestionDTO, error) {
+	question, err := u.questionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorOrModerator(ctx, question.UserID) {
+		return nil, domainerrors.ErrNotQuestionAuthor
+	}
+	before := toProductQuestionDTO(question)
+
+	question.Body = questionDTO.Body
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.questionRepo.Update(ctx, question); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityQuestion, id, entity.AuditActionUpdate, before, toProductQuestionDTO(question))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := u.loadAnswers(ctx, []*entity.ProductQuestion{question}); err != nil {
+		return nil, err
+	}
+
+	return toProductQuestionDTO(question), nil
+}
+
+// DeleteQuestion soft-deletes a question together with its answers.
+func (u *ProductQAUseCaseImpl) DeleteQuestion(ctx context.Context, id int64) error {
+	question, err := u.questionRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !isAuthorOrModerator(ctx, question.UserID) {
+		return domainerrors.ErrNotQuestionAuthor
+	}
+
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.questionRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityQuestion, id, entity.AuditActionDelete, toProductQuestionDTO(question), nil)
+	})
+}
+
+func (u *ProductQAUseCaseImpl) ModerateQuestion(ctx context.Context, id int64, moderateDTO dto.ModerateProductQADTO) (*dto.ProductQuestionDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	question, err := u.questionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if question.Status != moderateDTO.Status {
+		before := toProductQuestionDTO(question)
+		question.Status = moderateDTO.Status
+		err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := u.questionRepo.SetStatus(ctx, question); err != nil {
+				return err
+			}
+			return recordAudit(ctx, u.auditRepo, entity.AuditEntityQuestion, id, entity.AuditActionUpdate, before, toProductQuestionDTO(question))
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := u.loadAnswers(ctx, []*entity.ProductQuestion{question}); err != nil {
+		return nil, err
+	}
+
+	return toProductQuestionDTO(question), nil
+}
+
+// Answer records an answer to an approved question. Brand answers may only
+// be given by the product's owners and admins.
+func (u *ProductQAUseCaseImpl) Answer(ctx context.Context, questionID, userID int64, answerDTO dto.ProductAnswerInputDTO) (*dto.ProductAnswerDTO, error) {
+	question, err := u.questionRepo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if question.Status != entity.ReviewStatusApproved && !isModerator(ctx) {
+		return nil, domainerrors.ErrQuestionNotFound
+	}
+	if answerDTO.Brand {
+		if err := u.authorizeBrand(ctx, question.ProductID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	answer := &entity.ProductAnswer{
+		QuestionID: questionID,
+		UserID:     userID,
+		Body:       answerDTO.Body,
+		Brand:      answerDTO.Brand,
+		Status:     entity.ReviewStatusApproved,
+	}
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.answerRepo.Create(ctx, answer); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityAnswer, answer.ID, entity.AuditActionCreate, nil, toProductAnswerDTO(answer))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductAnswerDTO(answer), nil
+}
+
+func (u *ProductQAUseCaseImpl) UpdateAnswer(ctx context.Context, id int64, answerDTO dto.UpdateProductAnswerDTO) (*dto.ProductAnswerDTO, error) {
+	answer, err := u.answerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorOrModerator(ctx, answer.UserID) {
+		return nil, domainerrors.ErrNotAnswerAuthor
+	}
+	before := toProductAnswerDTO(answer)
+
+	answer.Body = answerDTO.Body
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.answerRepo.Update(ctx, answer); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityAnswer, id, entity.AuditActionUpdate, before, toProductAnswerDTO(answer))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductAnswerDTO(answer), nil
+}
+
+func (u *ProductQAUseCaseImpl) DeleteAnswer(ctx context.Context, id int64) error {
+	answer, err := u.answerRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !isAuthorOrModerator(ctx, answer.UserID) {
+		return domainerrors.ErrNotAnswerAuthor
+	}
+
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.answerRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityAnswer, id, entity.AuditActionDelete, toProductAnswerDTO(answer), nil)
+	})
+}
+
+func (u *ProductQAUseCaseImpl) ModerateAnswer(ctx context.Context, id int64, moderateDTO dto.ModerateProductQADTO) (*dto.ProductAnswerDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	answer, err := u.answerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if answer.Status == moderateDTO.Status {
+		return toProductAnswerDTO(answer), nil
+	}
+	before := toProductAnswerDTO(answer)
+
+	answer.Status = moderateDTO.Status
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.answerRepo.SetStatus(ctx, answer); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityAnswer, id, entity.AuditActionUpdate, before, toProductAnswerDTO(answer))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductAnswerDTO(answer), nil
+}
+
+func (u *ProductQAUseCaseImpl) VoteAnswer(ctx context.Context, answerID, userID int64, voteDTO dto.ProductAnswerVoteDTO) error {
+	answer, err := u.answerRepo.GetByID(ctx, answerID)
+	if err != nil {
+		return err
+	}
+	// Answers and questions awaiting moderation are hidden from everyone
+	// but moderators
+	if !isModerator(ctx) {
+		if answer.Status != entity.ReviewStatusApproved {
+			return domainerrors.ErrAnswerNotFound
+		}
+		question, err := u.questionRepo.GetByID(ctx, answer.QuestionID)
+		if err != nil {
+			return err
+		}
+		if question.Status != entity.ReviewStatusApproved {
+			return domainerrors.ErrAnswerNotFound
+		}
+	}
+
+	if answer.UserID == userID {
+		return domainerrors.ErrSelfAnswerVote
+	}
+
+	return u.answerRepo.UpsertVote(ctx, &entity.ProductAnswerVote{
+		AnswerID: answerID,
+		UserID:   userID,
+		Helpful:  *voteDTO.Helpful,
+	})
+}
+
+func (u *ProductQAUseCaseImpl) RemoveAnswerVote(ctx context.Context, answerID, userID int64) error {
+	return u.answerRepo.DeleteVote(ctx, answerID, userID)
+}
+
+// authorizeBrand checks that the user may answer on behalf of the product's
+// brand: admins and the product's owners can.
+func (u *ProductQAUseCaseImpl) authorizeBrand(ctx context.Context, productID, userID int64) error {
+	if isModerator(ctx) {
+		return nil
+	}
+
+	owner, err := u.ownerRepo.IsOwner(ctx, productID, userID)
+	if err != nil {
+		return err
+	}
+	if !owner {
+		return domainerrors.ErrNotProductOwner
+	}
+	return nil
+}
+
+// loadAnswers attaches the answers of each question. Only approved answers
+// are loaded unless the caller is a moderator.
+func (u *ProductQAUseCaseImpl) loadAnswers(ctx context.Context, questions []*entity.ProductQuestion) error {
+	if len(questions) == 0 {
+		return nil
+	}
+
+	var status *string
+	if !isModerator(ctx) {
+		approved := entity.ReviewStatusApproved
+		status = &approved
+	}
+
+	ids := make([]int64, 0, len(questions))
+	for _, question := range questions {
+		ids = append(ids, question.ID)
+	}
+	answers, err := u.answerRepo.ListByQuestionIDs(ctx, ids, status)
+	if err != nil {
+		return err
+	}
+	for _, question := range questions {
+		question.Answers = answers[question.ID]
+	}
+	return nil
+}
+
+func toProductQuestionDTO(question *entity.ProductQuestion) *dto.ProductQuestionDTO {
+	questionDTO := &dto.ProductQuestionDTO{
+		ID:          question.ID,
+		ProductID:   question.ProductID,
+		UserID:      question.UserID,
+		Body:        question.Body,
+		Status:      question.Status,
+		CreatedAt:   question.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   question.UpdatedAt.Format(time.RFC3339),
+		Answers:     make([]*dto.ProductAnswerDTO, 0, len(question.Answers)),
+		AnswerCount: len(question.Answers),
+	}
+	for _, answer := range question.Answers {
+		questionDTO.Answers = append(questionDTO.Answers, toProductAnswerDTO(answer))
+	}
+	return questionDTO
+}
+
+func toProductAnswerDTO(answer *entity.ProductAnswer) *dto.ProductAnswerDTO {
+	return &dto.ProductAnswerDTO{
+		ID:             answer.ID,
+		QuestionID:     answer.QuestionID,
+		UserID:         answer.UserID,
+		Body:           answer.Body,
+		Brand:          answer.Brand,
+		Status:         answer.Status,
+		HelpfulCount:   answer.HelpfulCount,
+		UnhelpfulCount: answer.UnhelpfulCount,
+		HelpfulScore:   answer.HelpfulScore,
+		CreatedAt:      answer.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      answer.UpdatedAt.Format(time.RFC3339),
+	}
+}
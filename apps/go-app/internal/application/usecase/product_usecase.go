@@ -0,0 +1,372 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ProductUseCaseImpl struct {
+	productRepo  repository.ProductRepository
+	categoryRepo repository.CategoryRepository
+	summaryRepo  repository.ProductSummaryRepository
+	ownerRepo    repository.ProductOwnerRepository
+	auditRepo    repository.AuditRepository
+	txManager    repository.TxManager
+}
+
+func NewProductUseCaseImpl(
+	productRepo repository.ProductRepository,
+	categoryRepo repository.CategoryRepository,
+	summaryRepo repository.ProductSummaryRepository,
+	ownerRepo repository.ProductOwnerRepository,
+	auditRepo repository.AuditRepository,
+	txManager repository.TxManager,
+) *ProductUseCaseImpl {
+	return &ProductUseCaseImpl{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		summaryRepo:  summaryRepo,
+		ownerRepo:    ownerRepo,
+		auditRepo:    auditRepo,
+		txManager:    txManager,
+	}
+}
+
+func (u *ProductUseCaseImpl) Create(ctx context.Context, productDTO dto.CreateProductDTO) (*dto.ProductDTO, error) {
+	product := &entity.Product{
+		SKU:        productDTO.SKU,
+		ExternalID: productDTO.ExternalID,
+		Name:       productDTO.Name,
+	}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.assignCategory(ctx, product, productDTO.CategoryID); err != nil {
+			return err
+		}
+		if err := u.assignGroup(ctx, product, productDTO.GroupID); err != nil {
+			return err
+		}
+
+		return u.productRepo.Create(ctx, product)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductDTO(product), nil
+}
+
+func (u *ProductUseCaseImpl) Retrieve(ctx context.Context, id int64) (*dto.ProductDTO, error) {
+	product, err := u.productRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductDTO(product), nil
+}
+
+func (u *ProductUseCaseImpl) Update(ctx context.Context, id int64, productDTO dto.UpdateProductDTO) (*dto.ProductDTO, error) {
+	var product *entity.Product
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = u.productRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := toProductDTO(product)
+
+		product.SKU = productDTO.SKU
+		product.ExternalID = productDTO.ExternalID
+		product.Name = productDTO.Name
+		product.HoldNewReviews = productDTO.HoldNewReviews
+		switch {
+		case productDTO.Archived && !product.IsArchived():
+			now := time.Now()
+			product.ArchivedAt = &now
+		case !productDTO.Archived:
+			product.ArchivedAt = nil
+		}
+
+		if err := u.assignCategory(ctx, product, productDTO.CategoryID); err != nil {
+			return err
+		}
+		if err := u.assignGroup(ctx, product, productDTO.GroupID); err != nil {
+			return err
+		}
+		if err := u.productRepo.Update(ctx, product); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityProduct, id, entity.AuditActionUpdate, before, toProductDTO(product))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductDTO(product), nil
+}
+
+// Archive withdraws a product from the catalog. Its reviews stay visible but
+// no new reviews are accepted for it.
+func (u *ProductUseCaseImpl) Archive(ctx context.Context, id int64) error {
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		product, err := u.productRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if product.IsArchived() {
+			return nil
+		}
+		before := toProductDTO(product)
+
+		now := time.Now()
+		product.ArchivedAt = &now
+		if err := u.productRepo.Update(ctx, product); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityProduct, id, entity.AuditActionDelete, before, toProductDTO(product))
+	})
+}
+
+func (u *ProductUseCaseImpl) ListOwners(ctx context.Context, id int64) ([]*dto.ProductOwnerDTO, error) {
+	if _, err := u.productRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	owners, err := u.ownerRepo.List(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerDTOs := make([]*dto.ProductOwnerDTO, 0, len(owners))
+	for _, owner := range owners {
+		ownerDTOs = append(ownerDTOs, &dto.ProductOwnerDTO{
+			UserID:    owner.UserID,
+			CreatedAt: owner.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return ownerDTOs, nil
+}
+
+// AddOwner lets the user act on behalf of the product's brand. Adding an
+// owner twice changes nothing.
+func (u *ProductUseCaseImpl) AddOwner(ctx context.Context, id int64, ownerDTO dto.ProductOwnerInputDTO) error {
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := u.productRepo.GetByID(ctx, id); err != nil {
+			return err
+		}
+
+		added, err := u.ownerRepo.Add(ctx, id, ownerDTO.UserID)
+		if err != nil || !added {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityProduct, id, entity.AuditActionGrantOwner, nil, map[string]interface{}{"user_id": ownerDTO.UserID})
+	})
+}
+
+func (u *ProductUseCaseImpl) RemoveOwner(ctx context.Context, id, userID int64) error {
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.ownerRepo.Remove(ctx, id, userID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityProduct, id, entity.AuditActionRevokeOwner, map[string]interface{}{"user_id": userID}, nil)
+	})
+}
+
+func (u *ProductUseCaseImpl) List(ctx context.Context, query dto.ListProductsQuery) ([]*dto.ProductDTO, error) {
+	products, err := u.productRepo.List(ctx, repository.ProductListOptions{
+		Offset:          query.Offset,
+		Limit:           query.Limit,
+		IncludeArchived: query.IncludeArchived,
+		GroupID:         query.GroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dtos []*dto.ProductDTO
+	for _, product := range products {
+		dtos = append(dtos, toProductDTO(product))
+	}
+
+	return dtos, nil
+}
+
+// Summary aggregates the approved reviews of a product and its variants,
+// with the average rating of each aspect of its category and the counts of
+// its questions and answers.
+func (u *ProductUseCaseImpl) Summary(ctx context.Context, id int64) (*dto.ProductSummaryDTO, error) {
+	product, err := u.productRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := u.summaryRepo.Summarize(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	summaryDTO := &dto.ProductSummaryDTO{
+		ProductID:             summary.ProductID,
+		ReviewCount:           summary.ReviewCount,
+		AverageRating:         summary.AverageRating,
+		RatingDistribution:    make(map[string]int64),
+		QuestionCount:         summary.QuestionCount,
+		AnsweredQuestionCount: summary.AnsweredQuestionCount,
+		AnswerCount:           summary.AnswerCount,
+	}
+	for rating := 1; rating <= 5; rating++ {
+		summaryDTO.RatingDistribution[strconv.Itoa(rating)] = summary.Distribution[rating]
+	}
+	for _, aspect := range summary.Aspects {
+		summaryDTO.Aspects = append(summaryDTO.Aspects, &dto.AspectSummaryDTO{
+			Key:           aspect.Key,
+			Name:          aspect.Name,
+			RatingCount:   aspect.RatingCount,
+			AverageRating: aspect.AverageRating,
+		})
+	}
+	return summaryDTO, nil
+}
+
+// Sync applies a catalog feed atomically. Products are matched by SKU; groups
+// are resolved once every product of the feed exists, so a variant may be
+// listed before its primary product.
+func (u *ProductUseCaseImpl) Sync(ctx context.Context, syncDTO dto.ProductSyncDTO) (*dto.ProductSyncResultDTO, error) {
+	result := &dto.ProductSyncResultDTO{}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		synced := make(map[string]*entity.Product, len(syncDTO.Products))
+		skus := make([]string, 0, len(syncDTO.Products))
+
+		for _, item := range syncDTO.Products {
+			product := &entity.Product{
+				SKU:        item.SKU,
+				ExternalID: item.ExternalID,
+				Name:       item.Name,
+			}
+			if err := u.productRepo.UpsertBySKU(ctx, product); err != nil {
+				return err
+			}
+			synced[item.SKU] = product
+			skus = append(skus, item.SKU)
+		}
+
+		for _, item := range syncDTO.Products {
+			var groupID *int64
+			if item.GroupSKU != nil {
+				group, err := u.productRepo.GetBySKU(ctx, *item.GroupSKU)
+				if err != nil {
+					if errors.Is(err, domainerrors.ErrProductNotFound) {
+						return domainerrors.ErrInvalidProductGroup
+					}
+					return err
+				}
+				groupID = &group.ID
+			}
+
+			// Re-read the product: regrouping an earlier item may have moved it
+			product, err := u.productRepo.GetByID(ctx, synced[item.SKU].ID)
+			if err != nil {
+				return err
+			}
+			if err := u.assignGroup(ctx, product, groupID); err != nil {
+				return err
+			}
+			if err := u.productRepo.Update(ctx, product); err != nil {
+				return err
+			}
+		}
+		result.Upserted = len(synced)
+
+		if syncDTO.ArchiveMissing {
+			archived, err := u.productRepo.ArchiveMissing(ctx, skus)
+			if err != nil {
+				return err
+			}
+			result.Archived = archived
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// assignCategory puts the product in the category of categoryID, or in none
+// when categoryID is nil.
+func (u *ProductUseCaseImpl) assignCategory(ctx context.Context, product *entity.Product, categoryID *int64) error {
+	if categoryID != nil {
+		if _, err := u.categoryRepo.GetByID(ctx, *categoryID); err != nil {
+			if errors.Is(err, domainerrors.ErrCategoryNotFound) {
+				return domainerrors.ErrInvalidCategory
+			}
+			return err
+		}
+	}
+	product.CategoryID = categoryID
+	return nil
+}
+
+// assignGroup moves the product into the group of groupID, or makes it a
+// primary product when groupID is nil or names the product itself. Groups stay
+// one level deep: variants of the product follow it into its new group, and
+// joining one of its own variants makes that variant the primary product.
+func (u *ProductUseCaseImpl) assignGroup(ctx context.Context, product *entity.Product, groupID *int64) error {
+	if groupID == nil || *groupID == product.ID {
+		product.GroupID = nil
+		return nil
+	}
+
+	group, err := u.productRepo.GetByID(ctx, *groupID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrProductNotFound) {
+			return domainerrors.ErrInvalidProductGroup
+		}
+		return err
+	}
+
+	if product.ID != 0 && group.GroupRootID() == product.ID {
+		group.GroupID = nil
+		if err := u.productRepo.Update(ctx, group); err != nil {
+			return err
+		}
+	}
+
+	rootID := group.GroupRootID()
+	product.GroupID = &rootID
+	if product.ID == 0 {
+		return nil
+	}
+
+	return u.productRepo.Regroup(ctx, product.ID, rootID)
+}
+
+func toProductDTO(product *entity.Product) *dto.ProductDTO {
+	productDTO := &dto.ProductDTO{
+		ID:             product.ID,
+		SKU:            product.SKU,
+		ExternalID:     product.ExternalID,
+		Name:           product.Name,
+		GroupID:        product.GroupID,
+		CategoryID:     product.CategoryID,
+		Archived:       product.IsArchived(),
+		HoldNewReviews: product.HoldNewReviews,
+		CreatedAt:      product.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      product.UpdatedAt.Format(time.RFC3339),
+	}
+	if product.ArchivedAt != nil {
+		productDTO.ArchivedAt = product.ArchivedAt.Format(time.RFC3339)
+	}
+
+	return productDTO
+}
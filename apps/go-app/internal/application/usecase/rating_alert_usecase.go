@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type RatingAlertUseCaseImpl struct {
+	alertRepo repository.RatingAlertRepository
+	auditRepo repository.AuditRepository
+	txManager repository.TxManager
+}
+
+func NewRatingAlertUseCaseImpl(
+	alertRepo repository.RatingAlertRepository,
+	auditRepo repository.AuditRepository,
+	txManager repository.TxManager,
+) *RatingAlertUseCaseImpl {
+	return &RatingAlertUseCaseImpl{
+		alertRepo: alertRepo,
+		auditRepo: auditRepo,
+		txManager: txManager,
+	}
+}
+
+func (u *RatingAlertUseCaseImpl) List(ctx context.Context, query dto.ListRatingAlertsQuery) ([]*dto.RatingAlertDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	alerts, err := u.alertRepo.List(ctx, repository.RatingAlertListOptions{
+		Offset:    query.Offset,
+		Limit:     query.Limit,
+		Status:    query.Status,
+		ProductID: query.ProductID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*dto.RatingAlertDTO, 0, len(alerts))
+	for _, alert := range alerts {
+		dtos = append(dtos, toRatingAlertDTO(alert))
+	}
+	return dtos, nil
+}
+
+func (u *RatingAlertUseCaseImpl) Retrieve(ctx context.Context, id int64) (*dto.RatingAlertDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	alert, err := u.alertRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toRatingAlertDTO(alert), nil
+}
+
+func (u *RatingAlertUseCaseImpl) UpdateStatus(ctx context.Context, id int64, statusDTO dto.UpdateRatingAlertStatusDTO) (*dto.RatingAlertDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	var alert *entity.RatingAlert
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		alert, err = u.alertRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if alert.Status == statusDTO.Status {
+			return nil
+		}
+		before := toRatingAlertDTO(alert)
+
+		alert.Status = statusDTO.Status
+		if err := u.alertRepo.SetStatus(ctx, alert); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityRatingAlert, id, entity.AuditActionUpdate, before, toRatingAlertDTO(alert))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toRatingAlertDTO(alert), nil
+}
+
+func toRatingAlertDTO(alert *entity.RatingAlert) *dto.RatingAlertDTO {
+	return &dto.RatingAlertDTO{
+		ID:             alert.ID,
+		ProductID:      alert.ProductID,
+		Kind:           alert.Kind,
+		Status:         alert.Status,
+		WindowStart:    alert.WindowStart.Format(time.RFC3339),
+		WindowEnd:      alert.WindowEnd.Format(time.RFC3339),
+		ReviewCount:    alert.ReviewCount,
+		MeanRating:     alert.MeanRating,
+		ExpectedCount:  alert.ExpectedCount,
+		BaselineMean:   alert.BaselineMean,
+		BaselineStddev: alert.BaselineStddev,
+		Score:          alert.Score,
+		ProductHeld:    alert.ProductHeld,
+		CreatedAt:      alert.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      alert.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+
+	"github.com/rs/zerolog"
+)
+
+// minRatingShift is the smallest change of mean rating, in stars, that is
+// alerted on however significant it is.
+const minRatingShift = 1.0
+
+// minRatingStddev stands in for the spread of ratings of products whose
+// history is all the same rating, so that any change is not infinitely
+// significant.
+const minRatingStddev = 0.5
+
+// RatingAnomalyDetectorImpl periodically compares every product's recent
+// reviews with its own history and raises an alert on abnormal volume or mean
+// rating. Volume is scored against a Poisson model of the baseline rate and
+// the mean rating against the baseline's standard error.
+type RatingAnomalyDetectorImpl struct {
+	alertRepo   repository.RatingAlertRepository
+	productRepo repository.ProductRepository
+	outboxRepo  repository.OutboxRepository
+	tenantRepo  repository.TenantRepository
+	txManager   repository.TxManager
+	logger      *zerolog.Logger
+
+	interval time.Duration
+	// window is the span of recent reviews compared with the baseline
+	window time.Duration
+	// baseline is how far back the history compared with goes
+	baseline time.Duration
+	// minReviews is how many reviews both the window and the baseline need
+	// for a product to be analyzed
+	minReviews int
+	// threshold is the score, in standard errors, from which activity is abnormal
+	threshold float64
+	// holdProducts switches alerted products to holding new reviews
+	holdProducts bool
+}
+
+func NewRatingAnomalyDetectorImpl(
+	alertRepo repository.RatingAlertRepository,
+	productRepo repository.ProductRepository,
+	outboxRepo repository.OutboxRepository,
+	tenantRepo repository.TenantRepository,
+	txManager repository.TxManager,
+	logger *zerolog.Logger,
+	interval, window, baseline time.Duration,
+	minReviews int,
+	threshold float64,
+	holdProducts bool,
+) *RatingAnomalyDetectorImpl {
+	return &RatingAnomalyDetectorImpl{
+		alertRepo:    alertRepo,
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		tenantRepo:   tenantRepo,
+		txManager:    txManager,
+		logger:       logger,
+		interval:     interval,
+		window:       window,
+		baseline:     baseline,
+		minReviews:   minReviews,
+		threshold:    threshold,
+		holdProducts: holdProducts,
+	}
+}
+
+// Run analyzes products every interval until ctx is cancelled. A zero
+// interval disables the detector.
+func (d *RatingAnomalyDetectorImpl) Run(ctx context.Context) {
+	if d.interval <= 0 || d.window <= 0 || d.baseline <= d.window {
+		return
+	}
+
+	for {
+		if _, err := d.AnalyzeOnce(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error().Err(err).Msg("Rating anomaly analysis failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.interval):
+		}
+	}
+}
+
+// AnalyzeOnce analyzes the products of every tenant and returns how many
+// alerts it raised.
+func (d *RatingAnomalyDetectorImpl) AnalyzeOnce(ctx context.Context) (int, error) {
+	tenants, err := d.tenantRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var raised int
+	for _, tenant := range tenants {
+		n, err := d.analyzeTenant(entity.ContextWithTenant(ctx, tenant))
+		raised += n
+		if err != nil {
+			return raised, err
+		}
+	}
+	return raised, nil
+}
+
+func (d *RatingAnomalyDetectorImpl) analyzeTenant(ctx context.Context) (int, error) {
+	windowEnd := time.Now()
+	windowStart := windowEnd.Add(-d.window)
+	stats, err := d.alertRepo.WindowStats(ctx, windowStart, windowEnd.Add(-d.baseline), d.minReviews)
+	if err != nil {
+		return 0, err
+	}
+
+	var raised int
+	for _, productStats := range stats {
+		for _, alert := range d.detect(productStats) {
+			alert.WindowStart = windowStart
+			alert.WindowEnd = windowEnd
+			ok, err := d.raise(ctx, alert)
+			if err != nil {
+				return raised, err
+			}
+			if ok {
+				raised++
+			}
+		}
+	}
+	return raised, nil
+}
+
+// detect returns the alerts the product's stats call for. Products without
+// enough history are left alone, as their baseline says little.
+func (d *RatingAnomalyDetectorImpl) detect(stats *entity.RatingWindowStats) []*entity.RatingAlert {
+	if stats.BaselineCount < int64(d.minReviews) {
+		return nil
+	}
+
+	windows := float64(d.baseline-d.window) / float64(d.window)
+	expected := float64(stats.BaselineCount) / windows
+	newAlert := func(kind string, score float64) *entity.RatingAlert {
+		return &entity.RatingAlert{
+			ProductID:      stats.ProductID,
+			Kind:           kind,
+			ReviewCount:    int(stats.WindowCount),
+			MeanRating:     stats.WindowMean,
+			ExpectedCount:  expected,
+			BaselineMean:   stats.BaselineMean,
+			BaselineStddev: stats.BaselineStddev,
+			Score:          score,
+		}
+	}
+
+	var alerts []*entity.RatingAlert
+	volumeScore := (float64(stats.WindowCount) - expected) / math.Sqrt(math.Max(expected, 1))
+	if volumeScore >= d.threshold {
+		alerts = append(alerts, newAlert(entity.RatingAlertVolumeSpike, volumeScore))
+	}
+
+	shift := stats.WindowMean - stats.BaselineMean
+	standardError := math.Max(stats.BaselineStddev, minRatingStddev) / math.Sqrt(float64(stats.WindowCount))
+	if shiftScore := math.Abs(shift) / standardError; math.Abs(shift) >= minRatingShift && shiftScore >= d.threshold {
+		alerts = append(alerts, newAlert(entity.RatingAlertRatingShift, shiftScore))
+	}
+	return alerts
+}
+
+// raise stores the alert, holds the product's new reviews if configured and
+// publishes the alert, all at once. It reports false when the product already
+// has an unresolved alert of the kind.
+func (d *RatingAnomalyDetectorImpl) raise(ctx context.Context, alert *entity.RatingAlert) (bool, error) {
+	alert.ProductHeld = d.holdProducts
+
+	var created bool
+	err := d.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if created, err = d.alertRepo.Create(ctx, alert); err != nil || !created {
+			return err
+		}
+		if alert.ProductHeld {
+			if err := d.productRepo.HoldReviews(ctx, alert.ProductID); err != nil {
+				return err
+			}
+		}
+		return recordEvent(ctx, d.outboxRepo, entity.AggregateRatingAlert, alert.ID, entity.EventRatingAlertRaised, toRatingAlertDTO(alert))
+	})
+	if err != nil || !created {
+		return false, err
+	}
+
+	d.logger.Warn().
+		Int64("product_id", alert.ProductID).
+		Str("kind", alert.Kind).
+		Float64("score", alert.Score).
+		Msg("Rating anomaly detected")
+	return true, nil
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/media"
+	"user-review-ingest/internal/infrastructure/storage"
+
+	"github.com/google/uuid"
+)
+
+const maxAttachmentsPerReview = 10
+
+type ReviewAttachmentUseCaseImpl struct {
+	reviewRepo     repository.ReviewRepository
+	attachmentRepo repository.ReviewAttachmentRepository
+	blobStore      storage.BlobStore
+	processor      *media.ImageProcessor
+	maxUploadBytes int64
+}
+
+func NewReviewAttachmentUseCaseImpl(
+	reviewRepo repository.ReviewRepository,
+	attachmentRepo repository.ReviewAttachmentRepository,
+	blobStore storage.BlobStore,
+	processor *media.ImageProcessor,
+	maxUploadBytes int64,
+) *ReviewAttachmentUseCaseImpl {
+	return &ReviewAttachmentUseCaseImpl{
+		reviewRepo:     reviewRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		processor:      processor,
+		maxUploadBytes: maxUploadBytes,
+	}
+}
+
+// Upload validates and sanitizes an image, stores it with a thumbnail and
+// attaches it to the review.
+func (u *ReviewAttachmentUseCaseImpl) Upload(ctx context.Context, reviewID int64, file io.Reader) (*dto.ReviewAttachmentDTO, error) {
+	if err := u.authorize(ctx, reviewID); err != nil {
+		return nil, err
+	}
+
+	count, err := u.attachmentRepo.CountByReviewID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxAttachmentsPerReview {
+		return nil, domainerrors.ErrTooManyAttachments
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, u.maxUploadBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > u.maxUploadBytes {
+		return nil, domainerrors.ErrAttachmentTooLarge
+	}
+
+	img, err := u.processor.Process(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys are namespaced by tenant so stored media never collides across storefronts
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrTenantRequired
+	}
+	prefix := fmt.Sprintf("tenants/%d/reviews/%d/%s", tenant.ID, reviewID, uuid.New().String())
+	attachment := &entity.ReviewAttachment{
+		ReviewID:     reviewID,
+		BlobKey:      fmt.Sprintf("%s.%s", prefix, img.Extension),
+		ThumbnailKey: fmt.Sprintf("%s_thumb.%s", prefix, img.ThumbnailExtension),
+		ContentType:  img.ContentType,
+		SizeBytes:    int64(len(img.Data)),
+		Width:        img.Width,
+		Height:       img.Height,
+	}
+
+	if err := u.blobStore.Put(ctx, attachment.BlobKey, bytes.NewReader(img.Data), int64(len(img.Data)), img.ContentType); err != nil {
+		return nil, err
+	}
+	if err := u.blobStore.Put(ctx, attachment.ThumbnailKey, bytes.NewReader(img.Thumbnail), int64(len(img.Thumbnail)), img.ThumbnailContentType); err != nil {
+		u.removeBlobs(ctx, attachment)
+		return nil, err
+	}
+
+	if err := u.attachmentRepo.Create(ctx, attachment); err != nil {
+		u.removeBlobs(ctx, attachment)
+		return nil, err
+	}
+
+	return toReviewAttachmentDTO(attachment, u.blobStore), nil
+}
+
+func (u *ReviewAttachmentUseCaseImpl) Delete(ctx context.Context, reviewID, attachmentID int64) error {
+	if err := u.authorize(ctx, reviewID); err != nil {
+		return err
+	}
+
+	attachment, err := u.attachmentRepo.GetByID(ctx, reviewID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		return err
+	}
+
+	u.removeBlobs(ctx, attachment)
+	return nil
+}
+
+// authorize checks that the caller wrote the review or is an administrator.
+func (u *ReviewAttachmentUseCaseImpl) authorize(ctx context.Context, reviewID int64) error {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return domainerrors.ErrNotReviewAuthor
+	}
+
+	review, err := u.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+
+	if review.UserID != principal.UserID && !principal.HasRole(entity.RoleAdmin) {
+		return domainerrors.ErrNotReviewAuthor
+	}
+	return nil
+}
+
+// removeBlobs deletes an attachment's objects on a best-effort basis. Objects
+// left behind are unreachable once their row is gone, so failures are ignored.
+func (u *ReviewAttachmentUseCaseImpl) removeBlobs(ctx context.Context, attachment *entity.ReviewAttachment) {
+	_ = u.blobStore.Delete(ctx, attachment.BlobKey)
+	_ = u.blobStore.Delete(ctx, attachment.ThumbnailKey)
+}
+
+func toReviewAttachmentDTO(attachment *entity.ReviewAttachment, blobStore storage.BlobStore) *dto.ReviewAttachmentDTO {
+	return &dto.ReviewAttachmentDTO{
+		ID:           attachment.ID,
+		URL:          blobStore.URL(attachment.BlobKey),
+		ThumbnailURL: blobStore.URL(attachment.ThumbnailKey),
+		ContentType:  attachment.ContentType,
+		SizeBytes:    attachment.SizeBytes,
+		Width:        attachment.Width,
+		Height:       attachment.Height,
+		CreatedAt:    attachment.CreatedAt.Format(time.RFC3339),
+	}
+}
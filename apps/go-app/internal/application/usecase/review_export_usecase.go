@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/export"
+	"user-review-ingest/internal/infrastructure/storage"
+)
+
+type ReviewExportUseCaseImpl struct {
+	reviewRepo    repository.ReviewRepository
+	exportJobRepo repository.ExportJobRepository
+	blobStore     storage.BlobStore
+}
+
+func NewReviewExportUseCaseImpl(
+	reviewRepo repository.ReviewRepository,
+	exportJobRepo repository.ExportJobRepository,
+	blobStore storage.BlobStore,
+) *ReviewExportUseCaseImpl {
+	return &ReviewExportUseCaseImpl{
+		reviewRepo:    reviewRepo,
+		exportJobRepo: exportJobRepo,
+		blobStore:     blobStore,
+	}
+}
+
+func (u *ReviewExportUseCaseImpl) Export(ctx context.Context, query dto.ExportReviewsQuery, open func() io.Writer) error {
+	filters, err := resolveExportFilters(ctx, reviewFilters{
+		Verified:          query.Verified,
+		ProductID:         query.ProductID,
+		Status:            query.Status,
+		Sentiment:         query.Sentiment,
+		SentimentMismatch: query.SentimentMismatch,
+		Lang:              query.Lang,
+		Published:         query.Published,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = writeReviewExport(ctx, u.reviewRepo, query.Format, query.Gzip, filters, open())
+	return err
+}
+
+// CreateJob queues an export. The filters are resolved against the caller's
+// permissions now, so the export never contains more than they could list.
+func (u *ReviewExportUseCaseImpl) CreateJob(ctx context.Context, jobDTO dto.CreateExportJobDTO) (*dto.ExportJobDTO, error) {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrAuthenticationRequired
+	}
+	if !export.ValidFormat(jobDTO.Format) {
+		return nil, domainerrors.ErrUnknownExportFormat
+	}
+
+	filters, err := resolveExportFilters(ctx, reviewFilters{
+		Verified:          jobDTO.Verified,
+		ProductID:         jobDTO.ProductID,
+		Status:            jobDTO.Status,
+		Sentiment:         jobDTO.Sentiment,
+		SentimentMismatch: jobDTO.SentimentMismatch,
+		Lang:              jobDTO.Lang,
+		Published:         jobDTO.Published,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	job := &entity.ExportJob{
+		RequestedBy: principal.UserID,
+		Format:      jobDTO.Format,
+		Gzip:        jobDTO.Gzip,
+		Filters:     filters,
+	}
+	if err := u.exportJobRepo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return toExportJobDTO(job), nil
+}
+
+func (u *ReviewExportUseCaseImpl) RetrieveJob(ctx context.Context, id int64) (*dto.ExportJobDTO, error) {
+	job, err := u.authorizedJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toExportJobDTO(job), nil
+}
+
+func (u *ReviewExportUseCaseImpl) Download(ctx context.Context, id int64) (*dto.ExportJobDTO, io.ReadCloser, error) {
+	job, err := u.authorizedJob(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Status != entity.ExportJobSucceeded || job.BlobKey == nil {
+		return nil, nil, domainerrors.ErrExportNotReady
+	}
+
+	file, err := u.blobStore.Get(ctx, *job.BlobKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toExportJobDTO(job), file, nil
+}
+
+// authorizedJob returns the job if the caller requested it or is an admin.
+// Other callers are told it does not exist.
+func (u *ReviewExportUseCaseImpl) authorizedJob(ctx context.Context, id int64) (*entity.ExportJob, error) {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrAuthenticationRequired
+	}
+
+	job, err := u.exportJobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.RequestedBy != principal.UserID && !principal.HasRole(entity.RoleAdmin) {
+		return nil, domainerrors.ErrExportJobNotFound
+	}
+	return job, nil
+}
+
+// resolveExportFilters resolves the filters the way the review list does,
+// so an export holds exactly the reviews the caller could list.
+func resolveExportFilters(ctx context.Context, filters reviewFilters) (entity.ReviewExportFilters, error) {
+	opts, err := resolveReviewFilters(ctx, filters)
+	if err != nil {
+		return entity.ReviewExportFilters{}, err
+	}
+
+	return entity.ReviewExportFilters{
+		VerifiedPurchase:  opts.VerifiedPurchase,
+		ProductID:         opts.ProductID,
+		Status:            opts.Status,
+		SentimentLabel:    opts.SentimentLabel,
+		SentimentMismatch: opts.SentimentMismatch,
+		Language:          opts.Language,
+		Published:         opts.Published,
+	}, nil
+}
+
+// writeReviewExport encodes the reviews matching filters onto w and returns
+// how many it wrote.
+func writeReviewExport(ctx context.Context, reviewRepo repository.ReviewRepository, format string, compress bool, filters entity.ReviewExportFilters, w io.Writer) (int64, error) {
+	writer, err := export.NewReviewWriter(format, w, compress)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = reviewRepo.Export(ctx, repository.ReviewListOptions{
+		VerifiedPurchase:  filters.VerifiedPurchase,
+		ProductID:         filters.ProductID,
+		Status:            filters.Status,
+		SentimentLabel:    filters.SentimentLabel,
+		SentimentMismatch: filters.SentimentMismatch,
+		Language:          filters.Language,
+		Published:         filters.Published,
+	}, func(review *entity.Review) error {
+		count++
+		return writer.Write(toReviewRow(review))
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, writer.Close()
+}
+
+func toReviewRow(review *entity.Review) *export.ReviewRow {
+	return &export.ReviewRow{
+		ID:               review.ID,
+		UserID:           review.UserID,
+		ProductID:        review.ProductID,
+		Rating:           review.Rating.Value(),
+		RatingScaleMin:   review.Rating.Scale().Min,
+		RatingScaleMax:   review.Rating.Scale().Max,
+		NormalizedRating: review.Rating.Normalized(),
+		Comment:          review.Comment,
+		Status:           review.Status,
+		HelpfulCount:     review.HelpfulCount,
+		UnhelpfulCount:   review.UnhelpfulCount,
+		VerifiedPurchase: review.VerifiedPurchase,
+		OrderID:          review.OrderID,
+		CreatedAt:        review.CreatedAt,
+		UpdatedAt:        review.UpdatedAt,
+	}
+}
+
+func toExportJobDTO(job *entity.ExportJob) *dto.ExportJobDTO {
+	jobDTO := &dto.ExportJobDTO{
+		ID:     job.ID,
+		Format: job.Format,
+		Gzip:   job.Gzip,
+		Filters: dto.ExportFiltersDTO{
+			Verified:          job.Filters.VerifiedPurchase,
+			ProductID:         job.Filters.ProductID,
+			Status:            job.Filters.Status,
+			Sentiment:         job.Filters.SentimentLabel,
+			SentimentMismatch: job.Filters.SentimentMismatch,
+			Lang:              job.Filters.Language,
+			Published:         job.Filters.Published,
+		},
+		Status:    job.Status,
+		RowCount:  job.RowCount,
+		SizeBytes: job.SizeBytes,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+		FileName:  export.FileName(exportBaseName(job), job.Format, job.Gzip),
+	}
+	if job.StartedAt != nil {
+		jobDTO.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+	if job.FinishedAt != nil {
+		jobDTO.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+	return jobDTO
+}
+
+// exportBaseName names the file of an export job, without its extension.
+func exportBaseName(job *entity.ExportJob) string {
+	return fmt.Sprintf("reviews-export-%d", job.ID)
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/export"
+	"user-review-ingest/internal/infrastructure/storage"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// exportLease is how long a running export stays with the worker that claimed
+// it. A worker that dies mid-export leaves the job to be taken over once the
+// lease expires, so it must outlast the largest export.
+const exportLease = time.Hour
+
+// ReviewExportWorkerImpl produces queued review exports. Each export is
+// written to a temporary file first, since blob stores need the size up
+// front, and then stored under the tenant's exports prefix.
+type ReviewExportWorkerImpl struct {
+	reviewRepo    repository.ReviewRepository
+	exportJobRepo repository.ExportJobRepository
+	tenantRepo    repository.TenantRepository
+	blobStore     storage.BlobStore
+	logger        *zerolog.Logger
+	pollInterval  time.Duration
+}
+
+func NewReviewExportWorkerImpl(
+	reviewRepo repository.ReviewRepository,
+	exportJobRepo repository.ExportJobRepository,
+	tenantRepo repository.TenantRepository,
+	blobStore storage.BlobStore,
+	logger *zerolog.Logger,
+	pollInterval time.Duration,
+) *ReviewExportWorkerImpl {
+	return &ReviewExportWorkerImpl{
+		reviewRepo:    reviewRepo,
+		exportJobRepo: exportJobRepo,
+		tenantRepo:    tenantRepo,
+		blobStore:     blobStore,
+		logger:        logger,
+		pollInterval:  pollInterval,
+	}
+}
+
+// Run produces exports until ctx is cancelled. After a round that found work
+// the next one starts straight away; otherwise the worker waits for the poll
+// interval.
+func (w *ReviewExportWorkerImpl) Run(ctx context.Context) {
+	for {
+		processed, err := w.ProcessOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			w.logger.Error().Err(err).Msg("Review export failed")
+		}
+		if processed > 0 && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// ProcessOnce produces at most one queued export per tenant and returns how
+// many it processed. Failed exports are recorded on their job; only errors
+// claiming or recording jobs are returned.
+func (w *ReviewExportWorkerImpl) ProcessOnce(ctx context.Context) (int, error) {
+	tenants, err := w.tenantRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var processed int
+	for _, tenant := range tenants {
+		tenantCtx := entity.ContextWithTenant(ctx, tenant)
+		job, err := w.exportJobRepo.Claim(tenantCtx, exportLease)
+		if err != nil {
+			return processed, err
+		}
+		if job == nil {
+			continue
+		}
+
+		processed++
+		if err := w.process(tenantCtx, tenant, job); err != nil {
+			if ctx.Err() != nil {
+				// Shutting down; the job is taken over once its lease expires
+				return processed, ctx.Err()
+			}
+			w.logger.Warn().Err(err).Int64("job_id", job.ID).Msg("Review export job failed")
+			if err := w.exportJobRepo.Fail(tenantCtx, job.ID, err.Error()); err != nil {
+				return processed, err
+			}
+		}
+	}
+
+	return processed, nil
+}
+
+func (w *ReviewExportWorkerImpl) process(ctx context.Context, tenant *entity.Tenant, job *entity.ExportJob) error {
+	file, err := os.CreateTemp("", "review-export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	rowCount, err := writeReviewExport(ctx, w.reviewRepo, job.Format, job.Gzip, job.Filters, file)
+	if err != nil {
+		return err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// Exports live under their own top-level prefix, so that a bucket policy
+	// making review media public can leave them out; the random part keeps
+	// keys unguessable all the same
+	key := fmt.Sprintf("exports/tenants/%d/%s-%s", tenant.ID, uuid.New().String(), export.FileName(exportBaseName(job), job.Format, job.Gzip))
+	if err := w.blobStore.Put(ctx, key, file, size, export.ContentType(job.Format, job.Gzip)); err != nil {
+		return err
+	}
+
+	return w.exportJobRepo.Complete(ctx, job.ID, key, rowCount, size)
+}
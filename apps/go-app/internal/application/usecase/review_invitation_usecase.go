@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ReviewInvitationUseCaseImpl struct {
+	invitationRepo repository.ReviewInvitationRepository
+	orderRepo      repository.OrderRepository
+	productRepo    repository.ProductRepository
+	reviews        *ReviewUseCaseImpl
+	// signer is nil when invitations are not configured
+	signer interfaces.InvitationSigner
+	ttl    time.Duration
+}
+
+func NewReviewInvitationUseCaseImpl(
+	invitationRepo repository.ReviewInvitationRepository,
+	orderRepo repository.OrderRepository,
+	productRepo repository.ProductRepository,
+	reviews *ReviewUseCaseImpl,
+	signer interfaces.InvitationSigner,
+	ttl time.Duration,
+) *ReviewInvitationUseCaseImpl {
+	return &ReviewInvitationUseCaseImpl{
+		invitationRepo: invitationRepo,
+		orderRepo:      orderRepo,
+		productRepo:    productRepo,
+		reviews:        reviews,
+		signer:         signer,
+		ttl:            ttl,
+	}
+}
+
+func (u *ReviewInvitationUseCaseImpl) Issue(ctx context.Context, issueDTO dto.IssueReviewInvitationDTO) (*dto.ReviewInvitationDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+	if u.signer == nil {
+		return nil, domainerrors.ErrInvitationsDisabled
+	}
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrTenantRequired
+	}
+
+	order, err := u.orderRepo.GetByExternalID(ctx, issueDTO.OrderID, issueDTO.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	product, err := u.productRepo.GetByID(ctx, order.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsArchived() {
+		return nil, domainerrors.ErrProductArchived
+	}
+
+	invitation := &entity.ReviewInvitation{
+		OrderID:    order.ID,
+		CustomerID: order.CustomerID,
+		ProductID:  order.ProductID,
+		ExpiresAt:  time.Now().Add(u.ttl),
+	}
+	if err := u.invitationRepo.Issue(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	invitationDTO := toReviewInvitationDTO(invitation)
+	invitationDTO.Token = u.signer.Sign(tenant.ID, invitation.ID)
+	return invitationDTO, nil
+}
+
+// Open records the first visit of an invitation link. Expired invitations
+// are not counted as opened.
+func (u *ReviewInvitationUseCaseImpl) Open(ctx context.Context, token string) (*dto.ReviewInvitationDTO, error) {
+	invitation, err := u.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.Status(time.Now()) == entity.InvitationStatusExpired {
+		return nil, domainerrors.ErrInvitationExpired
+	}
+
+	if invitation.OpenedAt == nil {
+		if invitation, err = u.invitationRepo.Open(ctx, invitation.ID); err != nil {
+			return nil, err
+		}
+	}
+	return toReviewInvitationDTO(invitation), nil
+}
+
+// Redeem writes the review as the invited customer. The review goes through
+// the same checks and moderation as any other; the invitation is only used up
+// if it is stored.
+func (u *ReviewInvitationUseCaseImpl) Redeem(ctx context.Context, token string, reviewDTO dto.InvitedReviewDTO) (*dto.ReviewDTO, error) {
+	invitation, err := u.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	switch invitation.Status(time.Now()) {
+	case entity.InvitationStatusCompleted:
+		return nil, domainerrors.ErrInvitationRedeemed
+	case entity.InvitationStatusExpired:
+		return nil, domainerrors.ErrInvitationExpired
+	}
+
+	review, err := u.reviews.create(ctx, dto.CreateReviewDTO{
+		ProductID: invitation.ProductID,
+		Rating:    reviewDTO.Rating,
+		Comment:   reviewDTO.Comment,
+		Locale:    reviewDTO.Locale,
+		Aspects:   reviewDTO.Aspects,
+	}, &purchaseProof{
+		customerID: invitation.CustomerID,
+		orderID:    invitation.OrderID,
+		redeem: func(ctx context.Context, review *entity.Review) error {
+			_, err := u.invitationRepo.Complete(ctx, invitation.ID, review.ID)
+			return err
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewDTO(review), nil
+}
+
+func (u *ReviewInvitationUseCaseImpl) Stats(ctx context.Context, query dto.InvitationStatsQuery) (*dto.InvitationStatsDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	stats, err := u.invitationRepo.Stats(ctx, repository.InvitationStatsFilter{
+		ProductID:  query.ProductID,
+		IssuedFrom: query.From,
+		IssuedTo:   query.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statsDTO := &dto.InvitationStatsDTO{
+		Issued:    stats.Issued,
+		Opened:    stats.Opened,
+		Completed: stats.Completed,
+		Expired:   stats.Expired,
+	}
+	if stats.Issued > 0 {
+		statsDTO.OpenRate = float64(stats.Opened) / float64(stats.Issued)
+		statsDTO.ConversionRate = float64(stats.Completed) / float64(stats.Issued)
+	}
+	return statsDTO, nil
+}
+
+// resolve returns the invitation named by a token signed for the request's
+// tenant.
+func (u *ReviewInvitationUseCaseImpl) resolve(ctx context.Context, token string) (*entity.ReviewInvitation, error) {
+	if u.signer == nil {
+		return nil, domainerrors.ErrInvitationsDisabled
+	}
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrTenantRequired
+	}
+
+	id, ok := u.signer.Verify(tenant.ID, token)
+	if !ok {
+		return nil, domainerrors.ErrInvitationNotFound
+	}
+	return u.invitationRepo.GetByID(ctx, id)
+}
+
+func toReviewInvitationDTO(invitation *entity.ReviewInvitation) *dto.ReviewInvitationDTO {
+	return &dto.ReviewInvitationDTO{
+		ID:          invitation.ID,
+		OrderID:     invitation.OrderID,
+		CustomerID:  invitation.CustomerID,
+		ProductID:   invitation.ProductID,
+		Status:      invitation.Status(time.Now()),
+		ExpiresAt:   invitation.ExpiresAt.Format(time.RFC3339),
+		OpenedAt:    formatTimePtr(invitation.OpenedAt),
+		CompletedAt: formatTimePtr(invitation.CompletedAt),
+		ReviewID:    invitation.ReviewID,
+		CreatedAt:   invitation.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
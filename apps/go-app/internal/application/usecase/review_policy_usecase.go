@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ReviewPolicyUseCaseImpl struct {
+	policyRepo   repository.ReviewPolicyRepository
+	productRepo  repository.ProductRepository
+	categoryRepo repository.CategoryRepository
+	auditRepo    repository.AuditRepository
+	txManager    repository.TxManager
+}
+
+func NewReviewPolicyUseCaseImpl(
+	policyRepo repository.ReviewPolicyRepository,
+	productRepo repository.ProductRepository,
+	categoryRepo repository.CategoryRepository,
+	auditRepo repository.AuditRepository,
+	txManager repository.TxManager,
+) *ReviewPolicyUseCaseImpl {
+	return &ReviewPolicyUseCaseImpl{
+		policyRepo:   policyRepo,
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		auditRepo:    auditRepo,
+		txManager:    txManager,
+	}
+}
+
+func (u *ReviewPolicyUseCaseImpl) PutForProduct(ctx context.Context, productID int64, policyDTO dto.ReviewPolicyInputDTO) (*dto.ReviewPolicyDTO, error) {
+	if _, err := u.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	policy := &entity.ReviewPolicy{ProductID: &productID}
+	return u.put(ctx, policy, policyDTO, func(ctx context.Context) (*entity.ReviewPolicy, error) {
+		return u.policyRepo.GetForProduct(ctx, productID)
+	})
+}
+
+func (u *ReviewPolicyUseCaseImpl) GetForProduct(ctx context.Context, productID int64) (*dto.ReviewPolicyDTO, error) {
+	policy, err := u.policyRepo.GetForProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewPolicyDTO(policy), nil
+}
+
+func (u *ReviewPolicyUseCaseImpl) DeleteForProduct(ctx context.Context, productID int64) error {
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		policy, err := u.policyRepo.GetForProduct(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if err := u.policyRepo.DeleteForProduct(ctx, productID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityPolicy, policy.ID, entity.AuditActionDelete, toReviewPolicyDTO(policy), nil)
+	})
+}
+
+func (u *ReviewPolicyUseCaseImpl) PutForCategory(ctx context.Context, categoryID int64, policyDTO dto.ReviewPolicyInputDTO) (*dto.ReviewPolicyDTO, error) {
+	if _, err := u.categoryRepo.GetByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	policy := &entity.ReviewPolicy{CategoryID: &categoryID}
+	return u.put(ctx, policy, policyDTO, func(ctx context.Context) (*entity.ReviewPolicy, error) {
+		return u.policyRepo.GetForCategory(ctx, categoryID)
+	})
+}
+
+func (u *ReviewPolicyUseCaseImpl) GetForCategory(ctx context.Context, categoryID int64) (*dto.ReviewPolicyDTO, error) {
+	policy, err := u.policyRepo.GetForCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewPolicyDTO(policy), nil
+}
+
+func (u *ReviewPolicyUseCaseImpl) DeleteForCategory(ctx context.Context, categoryID int64) error {
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		policy, err := u.policyRepo.GetForCategory(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+		if err := u.policyRepo.DeleteForCategory(ctx, categoryID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityPolicy, policy.ID, entity.AuditActionDelete, toReviewPolicyDTO(policy), nil)
+	})
+}
+
+func (u *ReviewPolicyUseCaseImpl) ProductRules(ctx context.Context, productID int64) (*dto.ReviewRulesDTO, error) {
+	product, err := u.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := u.policyRepo.ListApplicable(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := entity.ResolveReviewRules(policies)
+	return &dto.ReviewRulesDTO{
+		MinCommentLength: rules.MinCommentLength,
+		CommentRequired:  rules.CommentRequired,
+		VerifiedOnly:     rules.VerifiedOnly,
+		Moderation:       rules.Moderation,
+		Closed:           rules.Closed,
+	}, nil
+}
+
+// put stores policy with the rules of policyDTO, replacing the one current
+// returns if any.
+func (u *ReviewPolicyUseCaseImpl) put(ctx context.Context, policy *entity.ReviewPolicy, policyDTO dto.ReviewPolicyInputDTO, current func(ctx context.Context) (*entity.ReviewPolicy, error)) (*dto.ReviewPolicyDTO, error) {
+	if err := applyReviewPolicyInput(policy, policyDTO); err != nil {
+		return nil, err
+	}
+
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var before *dto.ReviewPolicyDTO
+		existing, err := current(ctx)
+		switch {
+		case err == nil:
+			before = toReviewPolicyDTO(existing)
+		case !errors.Is(err, domainerrors.ErrReviewPolicyNotFound):
+			return err
+		}
+
+		if err := u.policyRepo.Put(ctx, policy); err != nil {
+			return err
+		}
+		if before == nil {
+			return recordAudit(ctx, u.auditRepo, entity.AuditEntityPolicy, policy.ID, entity.AuditActionCreate, nil, toReviewPolicyDTO(policy))
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityPolicy, policy.ID, entity.AuditActionUpdate, before, toReviewPolicyDTO(policy))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewPolicyDTO(policy), nil
+}
+
+// applyReviewPolicyInput sets the rules of policyDTO on policy. A policy
+// setting no rule at all is refused; deleting it has the same effect.
+func applyReviewPolicyInput(policy *entity.ReviewPolicy, policyDTO dto.ReviewPolicyInputDTO) error {
+	if policyDTO.MinCommentLength == nil && policyDTO.CommentRequired == nil && policyDTO.VerifiedOnly == nil &&
+		policyDTO.Moderation == nil && policyDTO.Closed == nil {
+		return fmt.Errorf("%w: no rule is set", domainerrors.ErrInvalidReviewPolicy)
+	}
+	if policyDTO.Moderation != nil && *policyDTO.Moderation != entity.ReviewModerationAutoApprove &&
+		*policyDTO.Moderation != entity.ReviewModerationPreModerate {
+		return fmt.Errorf("%w: unknown moderation %q", domainerrors.ErrInvalidReviewPolicy, *policyDTO.Moderation)
+	}
+
+	policy.MinCommentLength = policyDTO.MinCommentLength
+	policy.CommentRequired = policyDTO.CommentRequired
+	policy.VerifiedOnly = policyDTO.VerifiedOnly
+	policy.Moderation = policyDTO.Moderation
+	policy.Closed = policyDTO.Closed
+	return nil
+}
+
+func toReviewPolicyDTO(policy *entity.ReviewPolicy) *dto.ReviewPolicyDTO {
+	return &dto.ReviewPolicyDTO{
+		ID:               policy.ID,
+		ProductID:        policy.ProductID,
+		CategoryID:       policy.CategoryID,
+		MinCommentLength: policy.MinCommentLength,
+		CommentRequired:  policy.CommentRequired,
+		VerifiedOnly:     policy.VerifiedOnly,
+		Moderation:       policy.Moderation,
+		Closed:           policy.Closed,
+		CreatedAt:        policy.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        policy.UpdatedAt.Format(time.RFC3339),
+	}
+}
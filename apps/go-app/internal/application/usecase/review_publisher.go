@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+
+	"github.com/rs/zerolog"
+)
+
+// publishBatchSize is how many reviews are published per transaction.
+const publishBatchSize = 100
+
+// ReviewPublisherImpl publishes reviews whose cooling-off period is over and
+// announces each with a review.published event.
+type ReviewPublisherImpl struct {
+	reviewRepo repository.ReviewRepository
+	outboxRepo repository.OutboxRepository
+	tenantRepo repository.TenantRepository
+	txManager  repository.TxManager
+	logger     *zerolog.Logger
+	interval   time.Duration
+}
+
+func NewReviewPublisherImpl(
+	reviewRepo repository.ReviewRepository,
+	outboxRepo repository.OutboxRepository,
+	tenantRepo repository.TenantRepository,
+	txManager repository.TxManager,
+	logger *zerolog.Logger,
+	interval time.Duration,
+) *ReviewPublisherImpl {
+	return &ReviewPublisherImpl{
+		reviewRepo: reviewRepo,
+		outboxRepo: outboxRepo,
+		tenantRepo: tenantRepo,
+		txManager:  txManager,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+// Run publishes due reviews every interval until ctx is cancelled. A zero
+// interval disables the publisher.
+func (p *ReviewPublisherImpl) Run(ctx context.Context) {
+	if p.interval <= 0 {
+		return
+	}
+
+	for {
+		if _, err := p.PublishOnce(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error().Err(err).Msg("Review publication failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.interval):
+		}
+	}
+}
+
+// PublishOnce publishes the due reviews of every tenant and returns how many
+// it published.
+func (p *ReviewPublisherImpl) PublishOnce(ctx context.Context) (int, error) {
+	tenants, err := p.tenantRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var published int
+	for _, tenant := range tenants {
+		n, err := p.publishTenant(entity.ContextWithTenant(ctx, tenant))
+		published += n
+		if err != nil {
+			return published, err
+		}
+	}
+	return published, nil
+}
+
+func (p *ReviewPublisherImpl) publishTenant(ctx context.Context) (int, error) {
+	var published int
+	for {
+		var batch []*entity.Review
+		err := p.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			if batch, err = p.reviewRepo.PublishDue(ctx, publishBatchSize); err != nil {
+				return err
+			}
+			for _, review := range batch {
+				if err := recordEvent(ctx, p.outboxRepo, entity.AggregateReview, review.ID, entity.EventReviewPublished, toReviewDTO(review)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return published, err
+		}
+		published += len(batch)
+		if len(batch) < publishBatchSize {
+			return published, nil
+		}
+	}
+}
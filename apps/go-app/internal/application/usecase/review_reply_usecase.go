@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ReviewReplyUseCaseImpl struct {
+	reviewRepo repository.ReviewRepository
+	replyRepo  repository.ReviewReplyRepository
+	ownerRepo  repository.ProductOwnerRepository
+	auditRepo  repository.AuditRepository
+	txManager  repository.TxManager
+}
+
+func NewReviewReplyUseCaseImpl(
+	reviewRepo repository.ReviewRepository,
+	replyRepo repository.ReviewReplyRepository,
+	ownerRepo repository.ProductOwnerRepository,
+	auditRepo repository.AuditRepository,
+	txManager repository.TxManager,
+) *ReviewReplyUseCaseImpl {
+	return &ReviewReplyUseCaseImpl{
+		reviewRepo: reviewRepo,
+		replyRepo:  replyRepo,
+		ownerRepo:  ownerRepo,
+		auditRepo:  auditRepo,
+		txManager:  txManager,
+	}
+}
+
+func (u *ReviewReplyUseCaseImpl) Create(ctx context.Context, reviewID int64, replyDTO dto.ReviewReplyInputDTO) (*dto.ReviewReplyDTO, error) {
+	principal, err := u.authorize(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &entity.ReviewReply{
+		ReviewID: reviewID,
+		AuthorID: principal.UserID,
+		Body:     replyDTO.Body,
+	}
+	if err := u.replyRepo.Create(ctx, reply); err != nil {
+		return nil, err
+	}
+
+	return toReviewReplyDTO(reply), nil
+}
+
+func (u *ReviewReplyUseCaseImpl) Update(ctx context.Context, reviewID int64, replyDTO dto.ReviewReplyInputDTO) (*dto.ReviewReplyDTO, error) {
+	if _, err := u.authorize(ctx, reviewID); err != nil {
+		return nil, err
+	}
+
+	reply, err := u.replyRepo.GetByReviewID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	before := toReviewReplyDTO(reply)
+
+	reply.Body = replyDTO.Body
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.replyRepo.Update(ctx, reply); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityReviewReply, reply.ID, entity.AuditActionUpdate, before, toReviewReplyDTO(reply))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewReplyDTO(reply), nil
+}
+
+func (u *ReviewReplyUseCaseImpl) Delete(ctx context.Context, reviewID int64) error {
+	if _, err := u.authorize(ctx, reviewID); err != nil {
+		return err
+	}
+
+	reply, err := u.replyRepo.GetByReviewID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+
+	return u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.replyRepo.Delete(ctx, reply.ID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityReviewReply, reply.ID, entity.AuditActionDelete, toReviewReplyDTO(reply), nil)
+	})
+}
+
+// authorize checks that the caller may reply on behalf of the reviewed product's brand.
+func (u *ReviewReplyUseCaseImpl) authorize(ctx context.Context, reviewID int64) (*entity.Principal, error) {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrNotProductOwner
+	}
+
+	review, err := u.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if principal.HasRole(entity.RoleAdmin) {
+		return principal, nil
+	}
+
+	owner, err := u.ownerRepo.IsOwner(ctx, review.ProductID, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner {
+		return nil, domainerrors.ErrNotProductOwner
+	}
+
+	return principal, nil
+}
+
+func toReviewReplyDTO(reply *entity.ReviewReply) *dto.ReviewReplyDTO {
+	return &dto.ReviewReplyDTO{
+		ID:        reply.ID,
+		AuthorID:  reply.AuthorID,
+		Body:      reply.Body,
+		CreatedAt: reply.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: reply.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+// fakeProductRepo serves a fixed set of products; other methods are not
+// implemented.
+type fakeProductRepo struct {
+	repository.ProductRepository
+	products map[int64]*entity.Product
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id int64) (*entity.Product, error) {
+	product, ok := r.products[id]
+	if !ok {
+		return nil, domainerrors.ErrProductNotFound
+	}
+	return product, nil
+}
+
+// fakeReviewRepo serves a fixed set of reviews; other methods are not
+// implemented.
+type fakeReviewRepo struct {
+	repository.ReviewRepository
+	reviews map[int64]*entity.Review
+}
+
+func (r *fakeReviewRepo) GetByID(ctx context.Context, id int64) (*entity.Review, error) {
+	review, ok := r.reviews[id]
+	if !ok {
+		return nil, domainerrors.ErrReviewNotFound
+	}
+	return review, nil
+}
+
+type fakeProductOwner struct {
+	productID int64
+	userID    int64
+}
+
+// fakeProductOwnerRepo keeps owners in memory.
+type fakeProductOwnerRepo struct {
+	owners map[fakeProductOwner]bool
+}
+
+func (r *fakeProductOwnerRepo) IsOwner(ctx context.Context, productID, userID int64) (bool, error) {
+	return r.owners[fakeProductOwner{productID, userID}], nil
+}
+
+func (r *fakeProductOwnerRepo) List(ctx context.Context, productID int64) ([]*entity.ProductOwner, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeProductOwnerRepo) Add(ctx context.Context, productID, userID int64) (bool, error) {
+	key := fakeProductOwner{productID, userID}
+	if r.owners[key] {
+		return false, nil
+	}
+	r.owners[key] = true
+	return true, nil
+}
+
+func (r *fakeProductOwnerRepo) Remove(ctx context.Context, productID, userID int64) error {
+	key := fakeProductOwner{productID, userID}
+	if !r.owners[key] {
+		return domainerrors.ErrProductOwnerNotFound
+	}
+	delete(r.owners, key)
+	return nil
+}
+
+// fakeReviewReplyRepo stores created replies; other methods are not
+// implemented.
+type fakeReviewReplyRepo struct {
+	repository.ReviewReplyRepository
+	replies []*entity.ReviewReply
+}
+
+func (r *fakeReviewReplyRepo) Create(ctx context.Context, reply *entity.ReviewReply) error {
+	reply.ID = int64(len(r.replies) + 1)
+	r.replies = append(r.replies, reply)
+	return nil
+}
+
+// fakeAuditRepo keeps recorded entries in memory.
+type fakeAuditRepo struct {
+	entries []*entity.AuditEntry
+}
+
+func (r *fakeAuditRepo) Record(ctx context.Context, entry *entity.AuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestProductOwnerReplies(t *testing.T) {
+	const productID, reviewID, ownerID = 7, 11, 42
+
+	productRepo := &fakeProductRepo{products: map[int64]*entity.Product{productID: {ID: productID}}}
+	reviewRepo := &fakeReviewRepo{reviews: map[int64]*entity.Review{reviewID: {ID: reviewID, ProductID: productID, UserID: 3}}}
+	ownerRepo := &fakeProductOwnerRepo{owners: make(map[fakeProductOwner]bool)}
+	replyRepo := &fakeReviewReplyRepo{}
+	auditRepo := &fakeAuditRepo{}
+
+	products := NewProductUseCaseImpl(productRepo, nil, nil, ownerRepo, auditRepo, fakeTxManager{})
+	replies := NewReviewReplyUseCaseImpl(reviewRepo, replyRepo, ownerRepo, auditRepo, fakeTxManager{})
+
+	admin := entity.ContextWithPrincipal(context.Background(), &entity.Principal{UserID: 1, Roles: []string{entity.RoleAdmin}})
+	owner := entity.ContextWithPrincipal(context.Background(), &entity.Principal{UserID: ownerID})
+	reply := dto.ReviewReplyInputDTO{Body: "Thanks for your feedback!"}
+
+	if _, err := replies.Create(owner, reviewID, reply); !errors.Is(err, domainerrors.ErrNotProductOwner) {
+		t.Fatalf("reply before ownership was granted: err = %v, want %v", err, domainerrors.ErrNotProductOwner)
+	}
+
+	if err := products.AddOwner(admin, productID, dto.ProductOwnerInputDTO{UserID: ownerID}); err != nil {
+		t.Fatalf("AddOwner() error = %v", err)
+	}
+	created, err := replies.Create(owner, reviewID, reply)
+	if err != nil {
+		t.Fatalf("reply by owner: err = %v", err)
+	}
+	if created.AuthorID != ownerID {
+		t.Errorf("reply author = %d, want %d", created.AuthorID, ownerID)
+	}
+
+	if err := products.RemoveOwner(admin, productID, ownerID); err != nil {
+		t.Fatalf("RemoveOwner() error = %v", err)
+	}
+	if _, err := replies.Create(owner, reviewID, reply); !errors.Is(err, domainerrors.ErrNotProductOwner) {
+		t.Errorf("reply after ownership was revoked: err = %v, want %v", err, domainerrors.ErrNotProductOwner)
+	}
+
+	var actions []string
+	for _, entry := range auditRepo.entries {
+		actions = append(actions, entry.Action)
+	}
+	if len(actions) != 2 || actions[0] != entity.AuditActionGrantOwner || actions[1] != entity.AuditActionRevokeOwner {
+		t.Errorf("audited actions = %v, want [%s %s]", actions, entity.AuditActionGrantOwner, entity.AuditActionRevokeOwner)
+	}
+}
+
+func TestAddProductOwnerUnknownProduct(t *testing.T) {
+	products := NewProductUseCaseImpl(&fakeProductRepo{}, nil, nil, &fakeProductOwnerRepo{owners: make(map[fakeProductOwner]bool)}, &fakeAuditRepo{}, fakeTxManager{})
+
+	err := products.AddOwner(context.Background(), 7, dto.ProductOwnerInputDTO{UserID: 42})
+	if !errors.Is(err, domainerrors.ErrProductNotFound) {
+		t.Errorf("err = %v, want %v", err, domainerrors.ErrProductNotFound)
+	}
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ReviewReportUseCaseImpl struct {
+	reviewRepo repository.ReviewRepository
+	reportRepo repository.ReviewReportRepository
+	auditRepo  repository.AuditRepository
+	outboxRepo repository.OutboxRepository
+	txManager  repository.TxManager
+	// threshold is the number of open reports that sends an approved review
+	// back to moderation; 0 disables it
+	threshold int
+}
+
+func NewReviewReportUseCaseImpl(
+	reviewRepo repository.ReviewRepository,
+	reportRepo repository.ReviewReportRepository,
+	auditRepo repository.AuditRepository,
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+	threshold int,
+) *ReviewReportUseCaseImpl {
+	return &ReviewReportUseCaseImpl{
+		reviewRepo: reviewRepo,
+		reportRepo: reportRepo,
+		auditRepo:  auditRepo,
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		threshold:  threshold,
+	}
+}
+
+// Report records the caller's report of a review. Each caller may report a
+// review once, and authors may not report their own. An approved review
+// reaching the threshold of open reports is held for moderation.
+func (u *ReviewReportUseCaseImpl) Report(ctx context.Context, reviewID, reporterID int64, reportDTO dto.ReviewReportInputDTO) (*dto.ReviewReportDTO, error) {
+	review, err := u.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !review.IsPublic() && !canSeeUnpublished(ctx, review) {
+		return nil, domainerrors.ErrReviewNotFound
+	}
+	if review.UserID == reporterID {
+		return nil, domainerrors.ErrSelfReport
+	}
+
+	report := &entity.ReviewReport{
+		ReviewID:   reviewID,
+		ReporterID: reporterID,
+		Reason:     reportDTO.Reason,
+		Details:    reportDTO.Details,
+	}
+	err = u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.reportRepo.Create(ctx, report); err != nil {
+			return err
+		}
+		if u.threshold == 0 || review.Status != entity.ReviewStatusApproved {
+			return nil
+		}
+
+		open, err := u.reportRepo.CountOpen(ctx, reviewID)
+		if err != nil {
+			return err
+		}
+		if open < int64(u.threshold) {
+			return nil
+		}
+
+		review.Status = entity.ReviewStatusPending
+		if err := u.reviewRepo.SetStatus(ctx, review); err != nil {
+			return err
+		}
+		hold := map[string]interface{}{
+			"reason":       "reports",
+			"open_reports": open,
+			"status":       review.Status,
+		}
+		if err := recordAudit(ctx, u.auditRepo, entity.AuditEntityReview, reviewID, entity.AuditActionHold, nil, hold); err != nil {
+			return err
+		}
+		return recordReviewEvent(ctx, u.outboxRepo, review, entity.EventReviewUpdated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewReportDTO(report), nil
+}
+
+func (u *ReviewReportUseCaseImpl) ListByReview(ctx context.Context, reviewID int64) ([]*dto.ReviewReportDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	if _, err := u.reviewRepo.GetByID(ctx, reviewID); err != nil {
+		return nil, err
+	}
+	reports, err := u.reportRepo.ListByReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewReportDTOs(reports), nil
+}
+
+func (u *ReviewReportUseCaseImpl) ListReported(ctx context.Context, query dto.ListReportedReviewsQuery) ([]*dto.ReportedReviewDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	reported, err := u.reportRepo.ListReported(ctx, query.Status == "open", query.Offset, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewIDs := make([]int64, 0, len(reported))
+	for _, counts := range reported {
+		reviewIDs = append(reviewIDs, counts.ReviewID)
+	}
+	reviews, err := u.reviewRepo.ListByIDs(ctx, reviewIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*entity.Review, len(reviews))
+	for _, review := range reviews {
+		byID[review.ID] = review
+	}
+
+	result := make([]*dto.ReportedReviewDTO, 0, len(reported))
+	for _, counts := range reported {
+		review, ok := byID[counts.ReviewID]
+		if !ok {
+			continue
+		}
+		result = append(result, &dto.ReportedReviewDTO{
+			Review:         toReviewDTO(review),
+			OpenCount:      counts.OpenCount,
+			UpheldCount:    counts.UpheldCount,
+			DismissedCount: counts.DismissedCount,
+			Reasons:        counts.ReasonCounts,
+			LastReportedAt: counts.LastReportedAt.Format(time.RFC3339),
+		})
+	}
+	return result, nil
+}
+
+// Resolve upholds or dismisses every open report of a review. The review's
+// status is left alone; moderators set it separately.
+func (u *ReviewReportUseCaseImpl) Resolve(ctx context.Context, reviewID int64, resolveDTO dto.ResolveReviewReportsDTO) ([]*dto.ReviewReportDTO, error) {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok || !principal.HasRole(entity.RoleAdmin) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	if _, err := u.reviewRepo.GetByID(ctx, reviewID); err != nil {
+		return nil, err
+	}
+
+	var resolved []*entity.ReviewReport
+	err := u.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resolved, err = u.reportRepo.Resolve(ctx, reviewID, resolveDTO.Status, &principal.UserID)
+		if err != nil {
+			return err
+		}
+		if len(resolved) == 0 {
+			return domainerrors.ErrNoOpenReports
+		}
+
+		reportIDs := make([]int64, 0, len(resolved))
+		for _, report := range resolved {
+			reportIDs = append(reportIDs, report.ID)
+		}
+		resolution := map[string]interface{}{
+			"status":  resolveDTO.Status,
+			"reports": reportIDs,
+		}
+		return recordAudit(ctx, u.auditRepo, entity.AuditEntityReview, reviewID, entity.AuditActionResolveReports, nil, resolution)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewReportDTOs(resolved), nil
+}
+
+func toReviewReportDTOs(reports []*entity.ReviewReport) []*dto.ReviewReportDTO {
+	dtos := make([]*dto.ReviewReportDTO, 0, len(reports))
+	for _, report := range reports {
+		dtos = append(dtos, toReviewReportDTO(report))
+	}
+	return dtos
+}
+
+func toReviewReportDTO(report *entity.ReviewReport) *dto.ReviewReportDTO {
+	reportDTO := &dto.ReviewReportDTO{
+		ID:         report.ID,
+		ReviewID:   report.ReviewID,
+		ReporterID: report.ReporterID,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     report.Status,
+		ResolvedBy: report.ResolvedBy,
+		CreatedAt:  report.CreatedAt.Format(time.RFC3339),
+	}
+	if report.ResolvedAt != nil {
+		resolvedAt := report.ResolvedAt.Format(time.RFC3339)
+		reportDTO.ResolvedAt = &resolvedAt
+	}
+	return reportDTO
+}
@@ -0,0 +1,381 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/messaging/pgnotify"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// reviewStreamBuffer is how many live events a subscriber may lag behind
+	// before it is dropped and has to resume.
+	reviewStreamBuffer = 256
+	// reviewStreamPageSize is how many past events are read at a time when a
+	// subscriber resumes.
+	reviewStreamPageSize = 500
+	// reviewStreamMaxGroupSize bounds the variants a product filter expands to.
+	reviewStreamMaxGroupSize = 1000
+)
+
+// outboxNotification is the payload of the outbox_events notification.
+type outboxNotification struct {
+	TenantID      int64  `json:"tenant_id"`
+	AggregateType string `json:"aggregate_type"`
+}
+
+type reviewSubscriber struct {
+	tenantID int64
+	events   chan *entity.OutboxEvent
+}
+
+// ReviewStreamImpl fans review events out to stream subscribers. Every
+// instance listens for the notification Postgres sends when an outbox event is
+// inserted, reads the tenant's events committed since the last one it
+// broadcast and hands them to its local subscribers, so a change made through
+// any instance reaches subscribers on all of them. Events are read and
+// identified in the order of the outbox sequence, which numbers them only once
+// committed, so resuming subscribers replay from their last event without
+// skipping any that committed late.
+type ReviewStreamImpl struct {
+	outboxRepo  repository.OutboxRepository
+	productRepo repository.ProductRepository
+	txManager   repository.TxManager
+	listener    *pgnotify.Listener
+	logger      *zerolog.Logger
+
+	mu          sync.Mutex
+	subscribers map[*reviewSubscriber]struct{}
+	// lastSeqs holds the newest event broadcast per tenant with subscribers,
+	// to read on from
+	lastSeqs map[int64]int64
+	closed   bool
+}
+
+func NewReviewStreamImpl(
+	outboxRepo repository.OutboxRepository,
+	productRepo repository.ProductRepository,
+	txManager repository.TxManager,
+	listener *pgnotify.Listener,
+	logger *zerolog.Logger,
+) *ReviewStreamImpl {
+	return &ReviewStreamImpl{
+		outboxRepo:  outboxRepo,
+		productRepo: productRepo,
+		txManager:   txManager,
+		listener:    listener,
+		logger:      logger,
+		subscribers: make(map[*reviewSubscriber]struct{}),
+		lastSeqs:    make(map[int64]int64),
+	}
+}
+
+// Run listens for new events until ctx is cancelled, then ends every
+// subscription so that open streams finish.
+func (s *ReviewStreamImpl) Run(ctx context.Context) {
+	s.listener.Listen(ctx, func(payload string) {
+		s.notify(ctx, payload)
+	}, func() {
+		s.catchUp(ctx)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for subscriber := range s.subscribers {
+		s.remove(subscriber)
+	}
+}
+
+func (s *ReviewStreamImpl) Subscribe(ctx context.Context, query dto.ReviewStreamQuery) (<-chan *dto.ReviewEventDTO, error) {
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, domainerrors.ErrTenantRequired
+	}
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	var productIDs map[int64]bool
+	if query.ProductID != nil {
+		var err error
+		if productIDs, err = s.productGroup(ctx, *query.ProductID); err != nil {
+			return nil, err
+		}
+	}
+	matches := func(review *dto.ReviewDTO) bool {
+		return (productIDs == nil || productIDs[review.ProductID]) &&
+			(query.Status == nil || review.Status == *query.Status)
+	}
+
+	// Number what has committed so far, then subscribe before replaying so
+	// that nothing committed in between is missed
+	if err := s.sequence(ctx); err != nil {
+		return nil, err
+	}
+	latestSeq, err := s.outboxRepo.LatestSeq(ctx, entity.AggregateReview)
+	if err != nil {
+		return nil, err
+	}
+	subscriber, err := s.subscribe(tenant.ID, latestSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *dto.ReviewEventDTO)
+	go func() {
+		defer close(out)
+		defer s.unsubscribe(subscriber)
+
+		emit := func(event *entity.OutboxEvent) bool {
+			reviewEvent, err := toReviewEventDTO(event)
+			if err != nil {
+				s.logger.Error().Err(err).Int64("event_id", event.ID).Msg("Skipping undecodable review event")
+				return true
+			}
+			if !matches(reviewEvent.Review) {
+				return true
+			}
+			select {
+			case out <- reviewEvent:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// Live events up to afterSeq were replayed, or happened before the
+		// subscription
+		afterSeq := latestSeq
+		if query.LastEventID != nil {
+			afterSeq = *query.LastEventID
+			for {
+				events, err := s.outboxRepo.ListAfter(ctx, entity.AggregateReview, afterSeq, reviewStreamPageSize)
+				if err != nil {
+					if ctx.Err() == nil {
+						s.logger.Error().Err(err).Msg("Replaying review events failed")
+					}
+					return
+				}
+				for _, event := range events {
+					if !emit(event) {
+						return
+					}
+					afterSeq = event.Seq
+				}
+				if len(events) < reviewStreamPageSize {
+					break
+				}
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-subscriber.events:
+				if !ok {
+					return
+				}
+				if event.Seq <= afterSeq {
+					continue
+				}
+				if !emit(event) {
+					return
+				}
+				afterSeq = event.Seq
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// productGroup returns the IDs of the product's group, as review listings
+// treat variants as one product.
+func (s *ReviewStreamImpl) productGroup(ctx context.Context, productID int64) (map[int64]bool, error) {
+	product, err := s.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	rootID := product.GroupRootID()
+	variants, err := s.productRepo.List(ctx, repository.ProductListOptions{
+		Limit:           reviewStreamMaxGroupSize,
+		IncludeArchived: true,
+		GroupID:         &rootID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[int64]bool{productID: true}
+	for _, variant := range variants {
+		ids[variant.ID] = true
+	}
+	return ids, nil
+}
+
+// subscribe adds a subscriber to the tenant's events. The first subscriber
+// of a tenant has events broadcast from latestSeq on.
+func (s *ReviewStreamImpl) subscribe(tenantID, latestSeq int64) (*reviewSubscriber, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, domainerrors.ErrStreamClosed
+	}
+	if _, ok := s.lastSeqs[tenantID]; !ok {
+		s.lastSeqs[tenantID] = latestSeq
+	}
+	subscriber := &reviewSubscriber{tenantID: tenantID, events: make(chan *entity.OutboxEvent, reviewStreamBuffer)}
+	s.subscribers[subscriber] = struct{}{}
+	return subscriber, nil
+}
+
+func (s *ReviewStreamImpl) unsubscribe(subscriber *reviewSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribers[subscriber]; ok {
+		s.remove(subscriber)
+	}
+}
+
+// remove ends a subscription, and stops following the tenant once it was the
+// last one. Must be called with s.mu held.
+func (s *ReviewStreamImpl) remove(subscriber *reviewSubscriber) {
+	close(subscriber.events)
+	delete(s.subscribers, subscriber)
+
+	for other := range s.subscribers {
+		if other.tenantID == subscriber.tenantID {
+			return
+		}
+	}
+	delete(s.lastSeqs, subscriber.tenantID)
+}
+
+// sequence numbers the events of the tenant on ctx committed so far.
+func (s *ReviewStreamImpl) sequence(ctx context.Context) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.outboxRepo.Sequence(ctx)
+		return err
+	})
+}
+
+// notify broadcasts the events committed since the last one, unless nobody on
+// this instance streams the tenant's reviews.
+func (s *ReviewStreamImpl) notify(ctx context.Context, payload string) {
+	var notification outboxNotification
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+		s.logger.Error().Err(err).Str("payload", payload).Msg("Ignoring malformed outbox notification")
+		return
+	}
+	if notification.AggregateType != entity.AggregateReview {
+		return
+	}
+	s.advance(ctx, notification.TenantID)
+}
+
+// catchUp broadcasts the events committed while the listener was
+// disconnected, for tenants with subscribers on this instance.
+func (s *ReviewStreamImpl) catchUp(ctx context.Context) {
+	s.mu.Lock()
+	tenantIDs := make([]int64, 0, len(s.lastSeqs))
+	for tenantID := range s.lastSeqs {
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	s.mu.Unlock()
+
+	for _, tenantID := range tenantIDs {
+		s.advance(ctx, tenantID)
+	}
+}
+
+// advance numbers the tenant's committed events and broadcasts those after
+// the last one broadcast, if the tenant has subscribers here.
+func (s *ReviewStreamImpl) advance(ctx context.Context, tenantID int64) {
+	if _, ok := s.lastSeq(tenantID); !ok {
+		return
+	}
+
+	tenantCtx := entity.ContextWithTenant(ctx, &entity.Tenant{ID: tenantID})
+	if err := s.sequence(tenantCtx); err != nil {
+		s.logger.Error().Err(err).Int64("tenant_id", tenantID).Msg("Sequencing review events failed")
+		return
+	}
+
+	// Read again: the tenant's subscribers may have left, or the first new
+	// one started after what was just numbered
+	lastSeq, ok := s.lastSeq(tenantID)
+	if !ok {
+		return
+	}
+	for {
+		events, err := s.outboxRepo.ListAfter(tenantCtx, entity.AggregateReview, lastSeq, reviewStreamPageSize)
+		if err != nil {
+			s.logger.Error().Err(err).Int64("tenant_id", tenantID).Msg("Reading review events failed")
+			return
+		}
+		for _, event := range events {
+			s.broadcast(event)
+			lastSeq = event.Seq
+		}
+		if len(events) < reviewStreamPageSize {
+			return
+		}
+	}
+}
+
+// lastSeq returns the newest event broadcast to the tenant, and whether anyone
+// here subscribes to the tenant's reviews.
+func (s *ReviewStreamImpl) lastSeq(tenantID int64) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lastSeq, ok := s.lastSeqs[tenantID]
+	return lastSeq, ok
+}
+
+// broadcast hands the event to the tenant's subscribers without waiting on
+// any of them; a subscriber whose buffer is full is dropped.
+func (s *ReviewStreamImpl) broadcast(event *entity.OutboxEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lastSeq, ok := s.lastSeqs[event.TenantID]
+	if !ok || event.Seq <= lastSeq {
+		return
+	}
+	s.lastSeqs[event.TenantID] = event.Seq
+	for subscriber := range s.subscribers {
+		if subscriber.tenantID != event.TenantID {
+			continue
+		}
+		select {
+		case subscriber.events <- event:
+		default:
+			s.remove(subscriber)
+		}
+	}
+}
+
+func toReviewEventDTO(event *entity.OutboxEvent) (*dto.ReviewEventDTO, error) {
+	var review dto.ReviewDTO
+	if err := json.Unmarshal(event.Payload, &review); err != nil {
+		return nil, err
+	}
+
+	return &dto.ReviewEventDTO{
+		ID:     event.Seq,
+		Type:   event.EventType,
+		Review: &review,
+	}, nil
+}
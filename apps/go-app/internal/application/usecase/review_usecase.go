@@ -2,38 +2,268 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
 	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
 	"user-review-ingest/internal/domain/repository"
 	"user-review-ingest/internal/domain/valueobject"
+	"user-review-ingest/internal/infrastructure/storage"
 )
 
 type ReviewUseCaseImpl struct {
-	reviewRepo repository.ReviewRepository
+	reviewRepo        repository.ReviewRepository
+	voteRepo          repository.ReviewVoteRepository
+	replyRepo         repository.ReviewReplyRepository
+	attachmentRepo    repository.ReviewAttachmentRepository
+	orderRepo         repository.OrderRepository
+	productRepo       repository.ProductRepository
+	categoryRepo      repository.CategoryRepository
+	aspectRepo        repository.ReviewAspectRepository
+	auditRepo         repository.AuditRepository
+	outboxRepo        repository.OutboxRepository
+	txManager         repository.TxManager
+	blobStore         storage.BlobStore
+	velocityLimiter   interfaces.VelocityLimiter
+	duplicateDetector interfaces.DuplicateDetector
+	duplicateRepo     repository.ReviewDuplicateRepository
+	reviewerRepo      repository.ReviewerRepository
+	reviewerTrust     entity.ReviewerTrustPolicy
+	policyRepo        repository.ReviewPolicyRepository
+	sentimentAnalyzer interfaces.SentimentAnalyzer
+	languageDetector  interfaces.LanguageDetector
 }
 
-func NewReviewUseCaseImpl(reviewRepo repository.ReviewRepository) *ReviewUseCaseImpl {
-	return &ReviewUseCaseImpl{reviewRepo: reviewRepo}
+func NewReviewUseCaseImpl(
+	reviewRepo repository.ReviewRepository,
+	voteRepo repository.ReviewVoteRepository,
+	replyRepo repository.ReviewReplyRepository,
+	attachmentRepo repository.ReviewAttachmentRepository,
+	orderRepo repository.OrderRepository,
+	productRepo repository.ProductRepository,
+	categoryRepo repository.CategoryRepository,
+	aspectRepo repository.ReviewAspectRepository,
+	auditRepo repository.AuditRepository,
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+	blobStore storage.BlobStore,
+	velocityLimiter interfaces.VelocityLimiter,
+	duplicateDetector interfaces.DuplicateDetector,
+	duplicateRepo repository.ReviewDuplicateRepository,
+	reviewerRepo repository.ReviewerRepository,
+	reviewerTrust entity.ReviewerTrustPolicy,
+	policyRepo repository.ReviewPolicyRepository,
+	sentimentAnalyzer interfaces.SentimentAnalyzer,
+	languageDetector interfaces.LanguageDetector,
+) *ReviewUseCaseImpl {
+	return &ReviewUseCaseImpl{
+		reviewRepo:        reviewRepo,
+		voteRepo:          voteRepo,
+		replyRepo:         replyRepo,
+		attachmentRepo:    attachmentRepo,
+		orderRepo:         orderRepo,
+		productRepo:       productRepo,
+		categoryRepo:      categoryRepo,
+		aspectRepo:        aspectRepo,
+		auditRepo:         auditRepo,
+		outboxRepo:        outboxRepo,
+		txManager:         txManager,
+		blobStore:         blobStore,
+		velocityLimiter:   velocityLimiter,
+		duplicateDetector: duplicateDetector,
+		duplicateRepo:     duplicateRepo,
+		reviewerRepo:      reviewerRepo,
+		reviewerTrust:     reviewerTrust,
+		policyRepo:        policyRepo,
+		sentimentAnalyzer: sentimentAnalyzer,
+		languageDetector:  languageDetector,
+	}
 }
 
 func (r *ReviewUseCaseImpl) Create(ctx context.Context, reviewDTO dto.CreateReviewDTO) error {
-	rating, err := valueobject.NewRating(reviewDTO.Rating)
+	_, err := r.create(ctx, reviewDTO, nil)
+	return err
+}
+
+// purchaseProof vouches for a new review's purchase, as a redeemed invitation
+// does, instead of looking for one among the author's orders. The review is
+// written as the customer who made the purchase.
+type purchaseProof struct {
+	customerID int64
+	orderID    int64
+	// redeem runs in the review's transaction once the review is stored
+	redeem func(ctx context.Context, review *entity.Review) error
+}
+
+func (r *ReviewUseCaseImpl) create(ctx context.Context, reviewDTO dto.CreateReviewDTO, proof *purchaseProof) (*entity.Review, error) {
+	// The author is the authenticated caller, or the customer a proof of
+	// purchase was issued to
+	var userID int64
+	if proof != nil {
+		userID = proof.customerID
+	} else if principal, ok := entity.PrincipalFromContext(ctx); ok {
+		userID = principal.UserID
+	} else {
+		return nil, domainerrors.ErrAuthenticationRequired
+	}
+
+	// Reviews can only be written for live catalog products
+	product, err := r.productRepo.GetByID(ctx, reviewDTO.ProductID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if product.IsArchived() {
+		return nil, domainerrors.ErrProductArchived
+	}
+	scale, err := r.ratingScale(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	rating, err := scale.Rate(*reviewDTO.Rating)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainerrors.ErrInvalidRating, err)
+	}
+	aspects, err := r.resolveAspects(ctx, product, scale, reviewDTO.Aspects)
+	if err != nil {
+		return nil, err
 	}
 
 	review := &entity.Review{
-		UserID:    reviewDTO.UserID,
+		UserID:    userID,
 		ProductID: reviewDTO.ProductID,
 		Rating:    rating,
 		Comment:   reviewDTO.Comment,
+		Status:    entity.ReviewStatusApproved,
 		CreatedBy: "user", // This should come from auth context
+		Aspects:   aspects,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
+	if reviewDTO.Locale != nil {
+		locale, err := valueobject.NewLocale(*reviewDTO.Locale)
+		if err != nil {
+			return nil, err
+		}
+		review.Locale = &locale
+	}
+	r.scoreSentiment(review)
+	r.detectLanguage(review)
+
+	// Reviews go public once their tenant's cooling-off period is over
+	review.PublishAt = review.CreatedAt
+	if tenant, ok := entity.TenantFromContext(ctx); ok {
+		review.PublishAt = review.PublishAt.Add(tenant.ReviewCoolingOff)
+	}
+	if !review.PublishAt.After(review.CreatedAt) {
+		review.PublishedAt = &review.PublishAt
+	}
+
+	// Stamp the review as a verified purchase when the author bought the
+	// product; only purchases of the author derived above count, so a review
+	// cannot borrow another customer's order
+	if proof != nil {
+		review.VerifiedPurchase = true
+		review.OrderID = &proof.orderID
+	} else {
+		order, err := r.orderRepo.FindLatestPurchase(ctx, review.UserID, review.ProductID)
+		switch {
+		case err == nil:
+			review.VerifiedPurchase = true
+			review.OrderID = &order.ID
+		case !errors.Is(err, domainerrors.ErrOrderNotFound):
+			return nil, err
+		}
+	}
+
+	// The product's review policies may close it to reviews, restrict them to
+	// verified purchases of the author, demand a comment or pre-moderate every
+	// review
+	rules, err := r.reviewRules(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	if err := rules.Check(review, true); err != nil {
+		return nil, err
+	}
 
-	return r.reviewRepo.Create(ctx, review)
+	err = r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Reviews over a velocity limit are held for moderation or rejected;
+		// every review of a product on hold or pre-moderated, every review
+		// copying a recent one and every review by an author of low
+		// reputation is held
+		exceeded, err := r.velocityLimiter.Check(ctx, review)
+		if err != nil {
+			return err
+		}
+		duplicates, err := r.duplicateDetector.Inspect(ctx, review)
+		if err != nil {
+			return err
+		}
+		reviewer, err := r.reviewerRepo.GetProfile(ctx, review.UserID)
+		if err != nil && !errors.Is(err, domainerrors.ErrReviewerNotFound) {
+			return err
+		}
+		var hold map[string]interface{}
+		switch {
+		case exceeded != nil && exceeded.Action == entity.VelocityActionReject:
+			return domainerrors.ErrReviewRateLimited
+		case exceeded != nil:
+			hold = map[string]interface{}{
+				"reason":         "velocity_limit",
+				"scope":          exceeded.Scope,
+				"limit":          exceeded.Limit,
+				"window_seconds": int64(exceeded.Window / time.Second),
+			}
+		case product.HoldNewReviews:
+			hold = map[string]interface{}{"reason": "product_hold"}
+		case rules.Moderation == entity.ReviewModerationPreModerate:
+			hold = map[string]interface{}{"reason": "pre_moderation"}
+		case len(duplicates) > 0:
+			hold = duplicateHold(duplicates)
+		case r.reviewerTrust.Holds(reviewer):
+			hold = map[string]interface{}{
+				"reason":     "low_reputation",
+				"reputation": reviewer.Reputation,
+			}
+		}
+		if hold != nil {
+			review.Status = entity.ReviewStatusPending
+			hold["status"] = review.Status
+		}
+
+		if err := r.reviewRepo.Create(ctx, review); err != nil {
+			return err
+		}
+		if len(review.Aspects) > 0 {
+			if err := r.aspectRepo.Replace(ctx, review.ID, review.Aspects); err != nil {
+				return err
+			}
+		}
+		if len(duplicates) > 0 {
+			if err := r.duplicateDetector.Link(ctx, review, duplicates); err != nil {
+				return err
+			}
+		}
+		if hold != nil {
+			if err := recordAudit(ctx, r.auditRepo, entity.AuditEntityReview, review.ID, entity.AuditActionHold, nil, hold); err != nil {
+				return err
+			}
+		}
+		if proof != nil {
+			if err := proof.redeem(ctx, review); err != nil {
+				return err
+			}
+		}
+		return recordReviewEvent(ctx, r.outboxRepo, review, entity.EventReviewCreated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
 }
 
 func (r *ReviewUseCaseImpl) Retrieve(ctx context.Context, id int64) (*dto.ReviewDTO, error) {
@@ -41,70 +271,589 @@ func (r *ReviewUseCaseImpl) Retrieve(ctx context.Context, id int64) (*dto.Review
 	if err != nil {
 		return nil, err
 	}
+	// Reviews awaiting or failing moderation, or still cooling off, are only
+	// shown to their author and moderators
+	if !review.IsPublic() && !canSeeUnpublished(ctx, review) {
+		return nil, domainerrors.ErrReviewNotFound
+	}
 
-	dto := &dto.ReviewDTO{
-		ID:        review.ID,
-		UserID:    review.UserID,
-		ProductID: review.ProductID,
-		Rating:    review.Rating.Int(), // Use Int() method instead of Value()
-		Comment:   review.Comment,
-		CreatedAt: review.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: review.UpdatedAt.Format(time.RFC3339),
-		CreatedBy: review.CreatedBy,
+	dtos, err := r.toReviewDTOs(ctx, []*entity.Review{review})
+	if err != nil {
+		return nil, err
 	}
 
-	return dto, nil
+	return dtos[0], nil
 }
 
+// Update edits a review. Its author may edit it until it is published;
+// moderators may edit any review.
 func (r *ReviewUseCaseImpl) Update(ctx context.Context, id int64, reviewDTO dto.UpdateReviewDTO) error {
 	// Retrieve the existing review
 	existingReview, err := r.reviewRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err := authorizeReviewChange(ctx, existingReview); err != nil {
+		return err
+	}
+	if existingReview.IsPublished() && !isModerator(ctx) {
+		return domainerrors.ErrReviewPublished
+	}
+	aspects, err := r.aspectRepo.ListByReviewIDs(ctx, []int64{id})
+	if err != nil {
+		return err
+	}
+	existingReview.Aspects = aspects[id]
+	before := toReviewDTO(existingReview)
 
 	// Update fields if provided in the DTO
 	if reviewDTO.Rating != nil {
-		rating, err := valueobject.NewRating(*reviewDTO.Rating)
+		// A review keeps the scale it was written on, even if its product's
+		// scale has changed since
+		rating, err := existingReview.Rating.Scale().Rate(*reviewDTO.Rating)
 		if err != nil {
-			return err
+			return fmt.Errorf("%w: %v", domainerrors.ErrInvalidRating, err)
 		}
 		existingReview.Rating = rating
 		existingReview.UpdatedAt = time.Now()
 	}
 
+	commentChanged := reviewDTO.Comment != nil && *reviewDTO.Comment != existingReview.Comment
 	if reviewDTO.Comment != nil {
 		existingReview.Comment = *reviewDTO.Comment
 		existingReview.UpdatedAt = time.Now()
 	}
+	if reviewDTO.Locale != nil {
+		existingReview.Locale = nil
+		if *reviewDTO.Locale != "" {
+			locale, err := valueobject.NewLocale(*reviewDTO.Locale)
+			if err != nil {
+				return err
+			}
+			existingReview.Locale = &locale
+		}
+		existingReview.UpdatedAt = time.Now()
+	}
+	r.scoreSentiment(existingReview)
+	r.detectLanguage(existingReview)
+
+	product, err := r.productRepo.GetByID(ctx, existingReview.ProductID)
+	if err != nil {
+		return err
+	}
+	rules, err := r.reviewRules(ctx, product)
+	if err != nil {
+		return err
+	}
+	if err := rules.Check(existingReview, false); err != nil {
+		return err
+	}
+	if reviewDTO.Aspects != nil {
+		existingReview.Aspects, err = r.resolveAspects(ctx, product, existingReview.Rating.Scale(), reviewDTO.Aspects)
+		if err != nil {
+			return err
+		}
+		existingReview.UpdatedAt = time.Now()
+	}
 
-	return r.reviewRepo.Update(ctx, existingReview)
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// A rewritten comment is fingerprinted again; a published review
+		// rewritten into a copy of another, or edited under pre-moderation,
+		// goes back to moderation
+		var duplicates []*entity.ReviewDuplicate
+		if commentChanged {
+			duplicates, err = r.duplicateDetector.Inspect(ctx, existingReview)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := r.reviewRepo.Update(ctx, existingReview); err != nil {
+			return err
+		}
+		if reviewDTO.Aspects != nil {
+			if err := r.aspectRepo.Replace(ctx, id, existingReview.Aspects); err != nil {
+				return err
+			}
+		}
+		if commentChanged {
+			if err := r.duplicateDetector.Link(ctx, existingReview, duplicates); err != nil {
+				return err
+			}
+		}
+		var hold map[string]interface{}
+		switch {
+		case len(duplicates) > 0:
+			hold = duplicateHold(duplicates)
+		case rules.Moderation == entity.ReviewModerationPreModerate:
+			hold = map[string]interface{}{"reason": "pre_moderation"}
+		}
+		if hold != nil && existingReview.Status == entity.ReviewStatusApproved {
+			existingReview.Status = entity.ReviewStatusPending
+			if err := r.reviewRepo.SetStatus(ctx, existingReview); err != nil {
+				return err
+			}
+			hold["status"] = existingReview.Status
+			if err := recordAudit(ctx, r.auditRepo, entity.AuditEntityReview, id, entity.AuditActionHold, nil, hold); err != nil {
+				return err
+			}
+		}
+		if err := recordAudit(ctx, r.auditRepo, entity.AuditEntityReview, id, entity.AuditActionUpdate, before, toReviewDTO(existingReview)); err != nil {
+			return err
+		}
+		return recordReviewEvent(ctx, r.outboxRepo, existingReview, entity.EventReviewUpdated)
+	})
 }
 
+// Delete removes a review; only its author and moderators may.
 func (r *ReviewUseCaseImpl) Delete(ctx context.Context, id int64) error {
-	return r.reviewRepo.Delete(ctx, id)
+	existingReview, err := r.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeReviewChange(ctx, existingReview); err != nil {
+		return err
+	}
+
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := r.reviewRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, r.auditRepo, entity.AuditEntityReview, id, entity.AuditActionDelete, toReviewDTO(existingReview), nil); err != nil {
+			return err
+		}
+		return recordReviewEvent(ctx, r.outboxRepo, existingReview, entity.EventReviewDeleted)
+	})
+}
+
+// Withdraw lets the author take back a review during its cooling-off period.
+func (r *ReviewUseCaseImpl) Withdraw(ctx context.Context, id int64) error {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return domainerrors.ErrAuthenticationRequired
+	}
+
+	existingReview, err := r.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existingReview.UserID != principal.UserID {
+		return domainerrors.ErrNotReviewAuthor
+	}
+	if existingReview.IsPublished() {
+		return domainerrors.ErrReviewPublished
+	}
+
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := r.reviewRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		// Subscribers never heard of the review, so no event is recorded
+		return recordAudit(ctx, r.auditRepo, entity.AuditEntityReview, id, entity.AuditActionWithdraw, toReviewDTO(existingReview), nil)
+	})
+}
+
+func (r *ReviewUseCaseImpl) List(ctx context.Context, query dto.ListReviewsQuery) ([]*dto.ReviewDTO, error) {
+	sort := query.Sort
+	if sort == "" {
+		sort = repository.ReviewSortRelevant
+	}
+
+	opts, err := resolveReviewFilters(ctx, reviewFilters{
+		Verified:          query.Verified,
+		ProductID:         query.ProductID,
+		Status:            query.Status,
+		Sentiment:         query.Sentiment,
+		SentimentMismatch: query.SentimentMismatch,
+		Lang:              query.Lang,
+		Published:         query.Published,
+	})
+	if err != nil {
+		return nil, err
+	}
+	opts.Offset = query.Offset
+	opts.Limit = query.Limit
+	opts.Sort = sort
+	opts.PreferredLanguages = valueobject.PreferredLanguages(query.AcceptLanguage)
+
+	reviews, err := r.reviewRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toReviewDTOs(ctx, reviews)
+}
+
+// reviewFilters are the filters of the review list, which exports share.
+type reviewFilters struct {
+	Verified          *bool
+	ProductID         *int64
+	Status            *string
+	Sentiment         *string
+	SentimentMismatch *bool
+	Lang              *string
+	Published         *bool
 }
 
-func (r *ReviewUseCaseImpl) List(ctx context.Context, offset, limit int) ([]*dto.ReviewDTO, error) {
-	reviews, err := r.reviewRepo.List(ctx, offset, limit)
+// resolveReviewFilters turns the list filters into repository options,
+// applying the list's rules: only approved, published reviews unless the
+// caller is a moderator.
+func resolveReviewFilters(ctx context.Context, filters reviewFilters) (repository.ReviewListOptions, error) {
+	status := entity.ReviewStatusApproved
+	if filters.Status != nil && *filters.Status != status {
+		if !isModerator(ctx) {
+			return repository.ReviewListOptions{}, domainerrors.ErrNotModerator
+		}
+		status = *filters.Status
+	}
+	// Reviews still cooling off are listed for moderators only
+	published := filters.Published
+	if !isModerator(ctx) {
+		if published != nil && !*published {
+			return repository.ReviewListOptions{}, domainerrors.ErrNotModerator
+		}
+		public := true
+		published = &public
+	}
+
+	var language *string
+	if filters.Lang != nil {
+		locale, err := valueobject.NewLocale(*filters.Lang)
+		if err != nil {
+			return repository.ReviewListOptions{}, err
+		}
+		lang := locale.Language()
+		language = &lang
+	}
+
+	return repository.ReviewListOptions{
+		VerifiedPurchase:  filters.Verified,
+		ProductID:         filters.ProductID,
+		Status:            &status,
+		SentimentLabel:    filters.Sentiment,
+		SentimentMismatch: filters.SentimentMismatch,
+		Language:          language,
+		Published:         published,
+	}, nil
+}
+
+// Moderate sets the moderation status of a review. Requires the admin role.
+func (r *ReviewUseCaseImpl) Moderate(ctx context.Context, id int64, moderateDTO dto.ModerateReviewDTO) (*dto.ReviewDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	review, err := r.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status == moderateDTO.Status {
+		return toReviewDTO(review), nil
+	}
+	before := toReviewDTO(review)
+
+	review.Status = moderateDTO.Status
+	err = r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := r.reviewRepo.SetStatus(ctx, review); err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, r.auditRepo, entity.AuditEntityReview, id, entity.AuditActionUpdate, before, toReviewDTO(review)); err != nil {
+			return err
+		}
+		return recordReviewEvent(ctx, r.outboxRepo, review, entity.EventReviewUpdated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toReviewDTO(review), nil
+}
+
+// ListDuplicates returns the earlier reviews the review is suspected to
+// copy, closest first. Originals deleted since are left out. Requires the
+// admin role.
+func (r *ReviewUseCaseImpl) ListDuplicates(ctx context.Context, id int64) ([]*dto.ReviewDuplicateDTO, error) {
+	if !isModerator(ctx) {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	review, err := r.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	duplicates, err := r.duplicateRepo.ListByReview(ctx, review.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	originalIDs := make([]int64, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		originalIDs = append(originalIDs, duplicate.OriginalID)
+	}
+	originals, err := r.reviewRepo.ListByIDs(ctx, originalIDs)
+	if err != nil {
+		return nil, err
+	}
+	originalDTOs, err := r.toReviewDTOs(ctx, originals)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*dto.ReviewDTO, len(originalDTOs))
+	for _, original := range originalDTOs {
+		byID[original.ID] = original
+	}
+
+	result := make([]*dto.ReviewDuplicateDTO, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		original, ok := byID[duplicate.OriginalID]
+		if !ok {
+			continue
+		}
+		result = append(result, &dto.ReviewDuplicateDTO{
+			Distance:   duplicate.Distance,
+			Similarity: 1 - float64(duplicate.Distance)/64,
+			DetectedAt: duplicate.CreatedAt.Format(time.RFC3339),
+			Original:   original,
+		})
+	}
+	return result, nil
+}
+
+// Vote records the user's helpful/unhelpful vote on a review, replacing any
+// vote they cast before. Authors may not vote on their own reviews, and only
+// moderators may vote on reviews that are not public.
+func (r *ReviewUseCaseImpl) Vote(ctx context.Context, reviewID, userID int64, voteDTO dto.ReviewVoteDTO) error {
+	review, err := r.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if !review.IsPublic() && !canSeeUnpublished(ctx, review) {
+		return domainerrors.ErrReviewNotFound
+	}
+
+	if review.UserID == userID {
+		return domainerrors.ErrSelfVote
+	}
+
+	return r.voteRepo.Upsert(ctx, &entity.ReviewVote{
+		ReviewID: reviewID,
+		UserID:   userID,
+		Helpful:  *voteDTO.Helpful,
+	})
+}
+
+func (r *ReviewUseCaseImpl) RemoveVote(ctx context.Context, reviewID, userID int64) error {
+	return r.voteRepo.Delete(ctx, reviewID, userID)
+}
+
+// scoreSentiment scores the review's comment and flags it when it
+// contradicts the rating.
+func (r *ReviewUseCaseImpl) scoreSentiment(review *entity.Review) {
+	review.Sentiment = nil
+	review.SentimentMismatch = false
+	sentiment, ok := r.sentimentAnalyzer.Analyze(review.Comment)
+	if !ok {
+		return
+	}
+	review.Sentiment = &sentiment
+	review.SentimentMismatch = sentiment.Contradicts(review.Rating)
+}
+
+// ratingScale returns the scale reviews of the product are rated on: its
+// category's, else its tenant's, else 1–5 stars.
+func (r *ReviewUseCaseImpl) ratingScale(ctx context.Context, product *entity.Product) (valueobject.RatingScale, error) {
+	if product.CategoryID != nil {
+		category, err := r.categoryRepo.GetByID(ctx, *product.CategoryID)
+		if err != nil {
+			return valueobject.RatingScale{}, err
+		}
+		if category.RatingScale != nil {
+			return *category.RatingScale, nil
+		}
+	}
+	if tenant, ok := entity.TenantFromContext(ctx); ok && tenant.RatingScale != nil {
+		return *tenant.RatingScale, nil
+	}
+	return valueobject.DefaultRatingScale, nil
+}
+
+// reviewRules resolves the rules reviews of the product are held to from its
+// own, its group's and its category's review policies.
+func (r *ReviewUseCaseImpl) reviewRules(ctx context.Context, product *entity.Product) (entity.ReviewRules, error) {
+	policies, err := r.policyRepo.ListApplicable(ctx, product)
+	if err != nil {
+		return entity.ReviewRules{}, err
+	}
+	return entity.ResolveReviewRules(policies), nil
+}
+
+// resolveAspects validates aspect ratings, keyed by aspect, against the
+// aspects of the product's category and the review's rating scale. Every
+// required aspect must be rated.
+func (r *ReviewUseCaseImpl) resolveAspects(ctx context.Context, product *entity.Product, scale valueobject.RatingScale, ratings map[string]float64) ([]*entity.AspectRating, error) {
+	if product.CategoryID == nil {
+		if len(ratings) > 0 {
+			return nil, domainerrors.ErrUnknownAspect
+		}
+		return nil, nil
+	}
+	category, err := r.categoryRepo.GetByID(ctx, *product.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range ratings {
+		if category.Aspect(key) == nil {
+			return nil, fmt.Errorf("%w: %s", domainerrors.ErrUnknownAspect, key)
+		}
+	}
+
+	var aspects []*entity.AspectRating
+	for _, aspect := range category.Aspects {
+		value, ok := ratings[aspect.Key]
+		if !ok {
+			if aspect.Required {
+				return nil, fmt.Errorf("%w: %s", domainerrors.ErrMissingAspectRating, aspect.Key)
+			}
+			continue
+		}
+		rating, err := scale.Rate(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", domainerrors.ErrInvalidRating, aspect.Key, err)
+		}
+		aspects = append(aspects, &entity.AspectRating{
+			AspectID: aspect.ID,
+			Key:      aspect.Key,
+			Rating:   rating,
+		})
+	}
+	return aspects, nil
+}
+
+// detectLanguage detects the language of the review's comment and sets the
+// review's language, preferring the one of its locale.
+func (r *ReviewUseCaseImpl) detectLanguage(review *entity.Review) {
+	review.DetectedLanguage = nil
+	if detected, ok := r.languageDetector.Detect(review.Comment); ok {
+		review.DetectedLanguage = &detected
+	}
+
+	review.Language = review.DetectedLanguage
+	if review.Locale != nil {
+		language := review.Locale.Language()
+		review.Language = &language
+	}
+}
+
+// duplicateHold describes holding a review for copying the given originals.
+func duplicateHold(duplicates []*entity.ReviewDuplicate) map[string]interface{} {
+	originalIDs := make([]int64, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		originalIDs = append(originalIDs, duplicate.OriginalID)
+	}
+	return map[string]interface{}{
+		"reason":       "near_duplicate",
+		"original_ids": originalIDs,
+	}
+}
+
+// toReviewDTOs maps reviews to DTOs together with their replies and attachments.
+func (r *ReviewUseCaseImpl) toReviewDTOs(ctx context.Context, reviews []*entity.Review) ([]*dto.ReviewDTO, error) {
+	reviewIDs := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		reviewIDs = append(reviewIDs, review.ID)
+	}
+
+	replies, err := r.replyRepo.ListByReviewIDs(ctx, reviewIDs)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := r.attachmentRepo.ListByReviewIDs(ctx, reviewIDs)
+	if err != nil {
+		return nil, err
+	}
+	aspects, err := r.aspectRepo.ListByReviewIDs(ctx, reviewIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	var dtos []*dto.ReviewDTO
 	for _, review := range reviews {
-		dto := &dto.ReviewDTO{
-			ID:        review.ID,
-			UserID:    review.UserID,
-			ProductID: review.ProductID,
-			Rating:    review.Rating.Int(), // Use Int() method instead of Value()
-			Comment:   review.Comment,
-			CreatedAt: review.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: review.UpdatedAt.Format(time.RFC3339),
-			CreatedBy: review.CreatedBy,
+		review.Aspects = aspects[review.ID]
+		reviewDTO := toReviewDTO(review)
+		if reply, ok := replies[review.ID]; ok {
+			reviewDTO.Reply = toReviewReplyDTO(reply)
+		}
+		for _, attachment := range attachments[review.ID] {
+			reviewDTO.Attachments = append(reviewDTO.Attachments, toReviewAttachmentDTO(attachment, r.blobStore))
 		}
-		dtos = append(dtos, dto)
+		dtos = append(dtos, reviewDTO)
 	}
 
 	return dtos, nil
-}
\ No newline at end of file
+}
+
+func toReviewDTO(review *entity.Review) *dto.ReviewDTO {
+	return &dto.ReviewDTO{
+		ID:               review.ID,
+		UserID:           review.UserID,
+		ProductID:        review.ProductID,
+		Rating:           review.Rating.Value(),
+		RatingScale:      toRatingScaleDTO(review.Rating.Scale()),
+		NormalizedRating: review.Rating.Normalized(),
+		Comment:          review.Comment,
+		HelpfulCount:     review.HelpfulCount,
+		UnhelpfulCount:   review.UnhelpfulCount,
+		VerifiedPurchase: review.VerifiedPurchase,
+		OrderID:          review.OrderID,
+		Status:           review.Status,
+		Sentiment:        toReviewSentimentDTO(review),
+		Language:         review.Language,
+		DetectedLanguage: review.DetectedLanguage,
+		Locale:           localeString(review.Locale),
+		Aspects:          toAspectRatingsDTO(review.Aspects),
+		CreatedAt:        review.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        review.UpdatedAt.Format(time.RFC3339),
+		CreatedBy:        review.CreatedBy,
+		PublishAt:        review.PublishAt.Format(time.RFC3339),
+		PublishedAt:      formatOptionalTime(review.PublishedAt),
+	}
+}
+
+func toRatingScaleDTO(scale valueobject.RatingScale) dto.RatingScaleDTO {
+	return dto.RatingScaleDTO{
+		Min:  scale.Min,
+		Max:  scale.Max,
+		Step: scale.Step,
+	}
+}
+
+func toReviewSentimentDTO(review *entity.Review) *dto.ReviewSentimentDTO {
+	if review.Sentiment == nil {
+		return nil
+	}
+	return &dto.ReviewSentimentDTO{
+		Score:    review.Sentiment.Score,
+		Label:    review.Sentiment.Label,
+		Mismatch: review.SentimentMismatch,
+	}
+}
+
+func toAspectRatingsDTO(aspects []*entity.AspectRating) map[string]float64 {
+	if len(aspects) == 0 {
+		return nil
+	}
+	ratings := make(map[string]float64, len(aspects))
+	for _, aspect := range aspects {
+		ratings[aspect.Key] = aspect.Rating.Value()
+	}
+	return ratings
+}
+
+func localeString(locale *valueobject.Locale) *string {
+	if locale == nil {
+		return nil
+	}
+	s := string(*locale)
+	return &s
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type ReviewerUseCaseImpl struct {
+	reviewerRepo repository.ReviewerRepository
+}
+
+func NewReviewerUseCaseImpl(reviewerRepo repository.ReviewerRepository) *ReviewerUseCaseImpl {
+	return &ReviewerUseCaseImpl{
+		reviewerRepo: reviewerRepo,
+	}
+}
+
+func (u *ReviewerUseCaseImpl) GetProfile(ctx context.Context, userID int64) (*dto.ReviewerProfileDTO, error) {
+	profile, err := u.reviewerRepo.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toReviewerProfileDTO(profile), nil
+}
+
+func toReviewerProfileDTO(profile *entity.ReviewerProfile) *dto.ReviewerProfileDTO {
+	return &dto.ReviewerProfileDTO{
+		UserID:         profile.UserID,
+		ReviewCount:    profile.ReviewCount,
+		ApprovedCount:  profile.ApprovedCount,
+		RejectedCount:  profile.RejectedCount,
+		VerifiedCount:  profile.VerifiedCount,
+		VerifiedRatio:  math.Round(profile.VerifiedRatio()*1000) / 1000,
+		HelpfulVotes:   profile.HelpfulVotes,
+		UnhelpfulVotes: profile.UnhelpfulVotes,
+		Reputation:     math.Round(profile.Reputation*10) / 10,
+		Badges:         profile.Badges(),
+		UpdatedAt:      profile.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+// tenantCacheTTL bounds how long a tenant lookup is reused. Tenants and their
+// domains change rarely, while every request needs one.
+const tenantCacheTTL = time.Minute
+
+// tenantCacheMaxEntries caps the cache, which is keyed by client-supplied
+// hostnames and slugs, so that it cannot be grown without bound.
+const tenantCacheMaxEntries = 10000
+
+type cachedTenant struct {
+	tenant    *entity.Tenant // nil when the lookup found nothing
+	expiresAt time.Time
+}
+
+type TenantResolverImpl struct {
+	tenantRepo    repository.TenantRepository
+	defaultTenant string
+
+	mu    sync.Mutex
+	cache map[string]cachedTenant
+}
+
+// NewTenantResolverImpl returns a resolver falling back to the tenant with the
+// defaultTenant slug when a request names none. An empty defaultTenant
+// requires every request to identify its tenant.
+func NewTenantResolverImpl(tenantRepo repository.TenantRepository, defaultTenant string) *TenantResolverImpl {
+	return &TenantResolverImpl{
+		tenantRepo:    tenantRepo,
+		defaultTenant: defaultTenant,
+		cache:         make(map[string]cachedTenant),
+	}
+}
+
+func (u *TenantResolverImpl) Resolve(ctx context.Context, hostname, slug string, principal *entity.Principal) (*entity.Tenant, error) {
+	var tenant *entity.Tenant
+	var err error
+
+	switch {
+	case slug != "":
+		if tenant, err = u.bySlug(ctx, slug); err != nil {
+			return nil, err
+		}
+	case hostname != "":
+		// Hosts without a mapping, such as a shared API domain, simply name no tenant
+		if tenant, err = u.byHostname(ctx, hostname); err != nil && !errors.Is(err, domainerrors.ErrTenantNotFound) {
+			return nil, err
+		}
+	}
+
+	if principal != nil {
+		// Tokens issued before tenancy carry no claim and belong to the default tenant
+		claim := principal.Tenant
+		if claim == "" {
+			claim = u.defaultTenant
+		}
+
+		switch {
+		case claim == "":
+			return nil, domainerrors.ErrTenantMismatch
+		case tenant == nil:
+			if tenant, err = u.bySlug(ctx, claim); err != nil {
+				return nil, err
+			}
+		case tenant.Slug != claim:
+			return nil, domainerrors.ErrTenantMismatch
+		}
+	}
+
+	if tenant == nil && u.defaultTenant != "" {
+		if tenant, err = u.bySlug(ctx, u.defaultTenant); err != nil {
+			return nil, err
+		}
+	}
+	if tenant == nil {
+		return nil, domainerrors.ErrTenantRequired
+	}
+
+	return tenant, nil
+}
+
+func (u *TenantResolverImpl) bySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
+	return u.lookup(ctx, "slug:"+slug, func(ctx context.Context) (*entity.Tenant, error) {
+		return u.tenantRepo.GetBySlug(ctx, slug)
+	})
+}
+
+func (u *TenantResolverImpl) byHostname(ctx context.Context, hostname string) (*entity.Tenant, error) {
+	return u.lookup(ctx, "host:"+hostname, func(ctx context.Context) (*entity.Tenant, error) {
+		return u.tenantRepo.GetByHostname(ctx, hostname)
+	})
+}
+
+// lookup serves a tenant lookup from the cache, remembering misses as well so
+// unknown hosts do not hit the database on every request.
+func (u *TenantResolverImpl) lookup(ctx context.Context, key string, fetch func(context.Context) (*entity.Tenant, error)) (*entity.Tenant, error) {
+	u.mu.Lock()
+	cached, ok := u.cache[key]
+	u.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		if cached.tenant == nil {
+			return nil, domainerrors.ErrTenantNotFound
+		}
+		return cached.tenant, nil
+	}
+
+	tenant, err := fetch(ctx)
+	if err != nil && !errors.Is(err, domainerrors.ErrTenantNotFound) {
+		return nil, err
+	}
+
+	u.mu.Lock()
+	if len(u.cache) >= tenantCacheMaxEntries {
+		u.cache = make(map[string]cachedTenant)
+	}
+	u.cache[key] = cachedTenant{tenant: tenant, expiresAt: time.Now().Add(tenantCacheTTL)}
+	u.mu.Unlock()
+
+	if tenant == nil {
+		return nil, domainerrors.ErrTenantNotFound
+	}
+	return tenant, nil
+}
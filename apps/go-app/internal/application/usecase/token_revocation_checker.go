@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+type TokenRevocationCheckerImpl struct {
+	revocationRepo repository.TokenRevocationRepository
+}
+
+func NewTokenRevocationCheckerImpl(revocationRepo repository.TokenRevocationRepository) *TokenRevocationCheckerImpl {
+	return &TokenRevocationCheckerImpl{revocationRepo: revocationRepo}
+}
+
+func (u *TokenRevocationCheckerImpl) Check(ctx context.Context, principal *entity.Principal) error {
+	revokedBefore, err := u.revocationRepo.RevokedBefore(ctx, principal.UserID)
+	if err != nil {
+		return err
+	}
+	// Tokens without an issue time cannot be told apart from revoked ones
+	if revokedBefore != nil && (principal.IssuedAt.IsZero() || principal.IssuedAt.Before(*revokedBefore)) {
+		return domainerrors.ErrTokenRevoked
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+
+	"github.com/rs/zerolog"
+)
+
+// velocityPruneInterval is how often counts of expired windows are deleted.
+const velocityPruneInterval = 10 * time.Minute
+
+// VelocityLimiterImpl enforces velocity policies with counters of fixed
+// windows kept in Postgres, so that limits hold across instances. The rate is
+// estimated over a sliding window: the previous window's count weighs in
+// proportionally to how much of it the sliding window still covers, which
+// keeps bursts straddling a window boundary from getting twice the limit.
+type VelocityLimiterImpl struct {
+	counterRepo repository.VelocityCounterRepository
+	tenantRepo  repository.TenantRepository
+	policies    []entity.VelocityPolicy
+	logger      *zerolog.Logger
+}
+
+// NewVelocityLimiterImpl returns a limiter enforcing the policies with a
+// positive limit and window.
+func NewVelocityLimiterImpl(
+	counterRepo repository.VelocityCounterRepository,
+	tenantRepo repository.TenantRepository,
+	policies []entity.VelocityPolicy,
+	logger *zerolog.Logger,
+) *VelocityLimiterImpl {
+	limiter := &VelocityLimiterImpl{
+		counterRepo: counterRepo,
+		tenantRepo:  tenantRepo,
+		logger:      logger,
+	}
+	for _, policy := range policies {
+		if policy.Limit > 0 && policy.Window > 0 {
+			limiter.policies = append(limiter.policies, policy)
+		}
+	}
+	return limiter
+}
+
+func (l *VelocityLimiterImpl) Check(ctx context.Context, review *entity.Review) (*entity.VelocityPolicy, error) {
+	now := time.Now()
+
+	var exceeded *entity.VelocityPolicy
+	for i := range l.policies {
+		policy := &l.policies[i]
+
+		scope, subject := velocitySubject(ctx, policy, review)
+		windowStart := now.Truncate(policy.Window)
+		current, previous, err := l.counterRepo.Hit(ctx, scope, subject, windowStart, windowStart.Add(-policy.Window))
+		if err != nil {
+			return nil, err
+		}
+
+		overlap := 1 - float64(now.Sub(windowStart))/float64(policy.Window)
+		if float64(previous)*overlap+float64(current) <= float64(policy.Limit) {
+			continue
+		}
+		if exceeded == nil || policy.Action == entity.VelocityActionReject {
+			exceeded = policy
+		}
+	}
+
+	return exceeded, nil
+}
+
+// velocitySubject returns whom the policy counts the review against: its
+// product, or the caller rather than the author the review names. Callers
+// without a login, who write reviews through invitations, are counted by
+// client address.
+func velocitySubject(ctx context.Context, policy *entity.VelocityPolicy, review *entity.Review) (scope, subject string) {
+	if policy.Scope == entity.VelocityScopeProduct {
+		return entity.VelocityScopeProduct, strconv.FormatInt(review.ProductID, 10)
+	}
+	if principal, ok := entity.PrincipalFromContext(ctx); ok {
+		return entity.VelocityScopeUser, strconv.FormatInt(principal.UserID, 10)
+	}
+	if clientIP, ok := entity.ClientIPFromContext(ctx); ok {
+		return entity.VelocityScopeClient, clientIP
+	}
+	return entity.VelocityScopeUser, strconv.FormatInt(review.UserID, 10)
+}
+
+// Run deletes the counts of expired windows until ctx is cancelled.
+func (l *VelocityLimiterImpl) Run(ctx context.Context) {
+	var longest time.Duration
+	for _, policy := range l.policies {
+		if policy.Window > longest {
+			longest = policy.Window
+		}
+	}
+	if longest == 0 {
+		return
+	}
+
+	for {
+		if err := l.prune(ctx, time.Now().Add(-2*longest)); err != nil && ctx.Err() == nil {
+			l.logger.Error().Err(err).Msg("Pruning review velocity counters failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(velocityPruneInterval):
+		}
+	}
+}
+
+func (l *VelocityLimiterImpl) prune(ctx context.Context, before time.Time) error {
+	tenants, err := l.tenantRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, tenant := range tenants {
+		if _, err := l.counterRepo.Prune(entity.ContextWithTenant(ctx, tenant), before); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/webhook"
+
+	"github.com/rs/zerolog"
+)
+
+// Retry delays grow exponentially from webhookBaseBackoff up to
+// webhookMaxBackoff, with jitter so that the retries of many deliveries to a
+// recovering endpoint do not arrive all at once.
+const (
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = 6 * time.Hour
+)
+
+// webhookLease is how long a claimed delivery stays hidden from other
+// dispatchers. It must outlast a request; a dispatcher that dies mid-send
+// leaves the delivery to be retried once the lease expires.
+const webhookLease = 5 * time.Minute
+
+// webhookEnvelope is the body POSTed to webhook endpoints.
+type webhookEnvelope struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt string          `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookDispatcherImpl delivers domain events to webhook subscriptions. As a
+// messaging.Publisher it fans each relayed event out into one delivery per
+// matching subscription, stored in the same transaction the relay marks the
+// event published in. Run then sends the deliveries, retrying failures with
+// backoff until maxAttempts, and disables subscriptions once disableAfter
+// attempts in a row have failed.
+type WebhookDispatcherImpl struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+	tenantRepo       repository.TenantRepository
+	txManager        repository.TxManager
+	sender           webhook.Sender
+	logger           *zerolog.Logger
+	pollInterval     time.Duration
+	batchSize        int
+	maxAttempts      int
+	disableAfter     int
+}
+
+func NewWebhookDispatcherImpl(
+	subscriptionRepo repository.WebhookSubscriptionRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	tenantRepo repository.TenantRepository,
+	txManager repository.TxManager,
+	sender webhook.Sender,
+	logger *zerolog.Logger,
+	pollInterval time.Duration,
+	batchSize int,
+	maxAttempts int,
+	disableAfter int,
+) *WebhookDispatcherImpl {
+	return &WebhookDispatcherImpl{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		tenantRepo:       tenantRepo,
+		txManager:        txManager,
+		sender:           sender,
+		logger:           logger,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+		maxAttempts:      maxAttempts,
+		disableAfter:     disableAfter,
+	}
+}
+
+// Publish queues the event for every active subscription interested in it.
+// ctx must carry the event's tenant.
+func (d *WebhookDispatcherImpl) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	subscriptions, err := d.subscriptionRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.EventType) {
+			continue
+		}
+
+		if body == nil {
+			if body, err = json.Marshal(webhookEnvelope{
+				ID:        event.ID,
+				Type:      event.EventType,
+				CreatedAt: event.CreatedAt.Format(time.RFC3339),
+				Data:      event.Payload,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := d.deliveryRepo.Create(ctx, &entity.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.EventType,
+			Payload:        body,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run sends due deliveries until ctx is cancelled. After a round that found
+// deliveries the next one starts straight away; otherwise the dispatcher waits
+// for the poll interval.
+func (d *WebhookDispatcherImpl) Run(ctx context.Context) {
+	for {
+		sent, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.logger.Error().Err(err).Msg("Webhook dispatch failed")
+		}
+		if sent > 0 && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
+// DispatchOnce sends one batch of due deliveries for every tenant and returns
+// how many were attempted.
+func (d *WebhookDispatcherImpl) DispatchOnce(ctx context.Context) (int, error) {
+	tenants, err := d.tenantRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for _, tenant := range tenants {
+		sent, err := d.dispatchTenant(entity.ContextWithTenant(ctx, tenant))
+		total += sent
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// dispatchTenant sends a claimed batch concurrently; the batch size bounds how
+// many requests are in flight. No transaction is held while sending.
+func (d *WebhookDispatcherImpl) dispatchTenant(ctx context.Context) (int, error) {
+	deliveries, err := d.deliveryRepo.ClaimDue(ctx, d.batchSize, webhookLease)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[int64]*entity.WebhookSubscription)
+	var wg sync.WaitGroup
+	errs := make([]error, len(deliveries))
+	for i, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = d.subscriptionRepo.GetByID(ctx, delivery.SubscriptionID)
+			if err != nil {
+				if errors.Is(err, domainerrors.ErrWebhookNotFound) {
+					// Deleted since the claim; its deliveries went with it
+					continue
+				}
+				return 0, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = d.deliver(ctx, subscription, delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(deliveries), errors.Join(errs...)
+}
+
+// deliver sends a claimed delivery and records the outcome. Only failures to
+// record the outcome are returned; failed sends are retried later.
+func (d *WebhookDispatcherImpl) deliver(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) error {
+	statusCode, sendErr := d.sender.Send(ctx, webhook.Request{
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventType:  delivery.EventType,
+		DeliveryID: delivery.ID,
+		Body:       delivery.Payload,
+	})
+
+	attempt := repository.WebhookAttempt{Status: entity.WebhookDeliverySucceeded, NextAttemptAt: time.Now()}
+	if statusCode != 0 {
+		attempt.ResponseStatus = &statusCode
+	}
+
+	return d.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if sendErr == nil {
+			if err := d.deliveryRepo.RecordAttempt(ctx, delivery.ID, attempt); err != nil {
+				return err
+			}
+			return d.subscriptionRepo.RecordSuccess(ctx, subscription.ID)
+		}
+
+		lastError := sendErr.Error()
+		attempt.LastError = &lastError
+		attempts := delivery.Attempts + 1
+		if attempts >= d.maxAttempts {
+			attempt.Status = entity.WebhookDeliveryFailed
+			d.logger.Warn().Err(sendErr).
+				Int64("delivery_id", delivery.ID).
+				Int64("subscription_id", subscription.ID).
+				Int("attempts", attempts).
+				Msg("Giving up on webhook delivery")
+		} else {
+			attempt.Status = entity.WebhookDeliveryPending
+			attempt.NextAttemptAt = time.Now().Add(webhookBackoff(attempts))
+		}
+		if err := d.deliveryRepo.RecordAttempt(ctx, delivery.ID, attempt); err != nil {
+			return err
+		}
+
+		updated, err := d.subscriptionRepo.RecordFailure(ctx, subscription.ID, d.disableAfter)
+		if err != nil {
+			return err
+		}
+		// Only the failure crossing the threshold disables the subscription
+		if !updated.Active && updated.ConsecutiveFailures == d.disableAfter {
+			d.logger.Warn().
+				Int64("subscription_id", subscription.ID).
+				Int("consecutive_failures", updated.ConsecutiveFailures).
+				Msg("Disabled failing webhook subscription")
+		}
+		return nil
+	})
+}
+
+// webhookBackoff returns the delay before retrying a delivery that failed
+// attempts times: half of the exponential delay plus a random share of the
+// other half.
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookBaseBackoff
+	for i := 1; i < attempts && delay < webhookMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > webhookMaxBackoff {
+		delay = webhookMaxBackoff
+	}
+
+	half := delay / 2
+	return half + rand.N(half+1)
+}
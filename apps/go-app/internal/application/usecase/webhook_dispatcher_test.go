@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/webhook"
+
+	"github.com/rs/zerolog"
+)
+
+// fakeWebhookSubscriptionRepo keeps subscriptions in memory.
+type fakeWebhookSubscriptionRepo struct {
+	mu            sync.Mutex
+	subscriptions map[int64]*entity.WebhookSubscription
+}
+
+func (r *fakeWebhookSubscriptionRepo) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscription.ID = int64(len(r.subscriptions) + 1)
+	r.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (r *fakeWebhookSubscriptionRepo) GetByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, domainerrors.ErrWebhookNotFound
+	}
+	copied := *subscription
+	return &copied, nil
+}
+
+func (r *fakeWebhookSubscriptionRepo) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeWebhookSubscriptionRepo) ListActive(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var active []*entity.WebhookSubscription
+	for id := int64(1); id <= int64(len(r.subscriptions)); id++ {
+		if subscription, ok := r.subscriptions[id]; ok && subscription.Active {
+			active = append(active, subscription)
+		}
+	}
+	return active, nil
+}
+
+func (r *fakeWebhookSubscriptionRepo) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	return errors.New("not implemented")
+}
+
+func (r *fakeWebhookSubscriptionRepo) Delete(ctx context.Context, id int64) error {
+	return errors.New("not implemented")
+}
+
+func (r *fakeWebhookSubscriptionRepo) RecordSuccess(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscriptions[id].ConsecutiveFailures = 0
+	return nil
+}
+
+func (r *fakeWebhookSubscriptionRepo) RecordFailure(ctx context.Context, id int64, disableAfter int) (*entity.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscription := r.subscriptions[id]
+	subscription.ConsecutiveFailures++
+	if subscription.Active && subscription.ConsecutiveFailures >= disableAfter {
+		now := time.Now()
+		subscription.Active = false
+		subscription.DisabledAt = &now
+	}
+	copied := *subscription
+	return &copied, nil
+}
+
+// fakeWebhookDeliveryRepo keeps deliveries in memory and records every
+// attempt, with the time it was recorded at.
+type fakeWebhookDeliveryRepo struct {
+	mu            sync.Mutex
+	now           time.Time
+	subscriptions *fakeWebhookSubscriptionRepo
+	deliveries    []*entity.WebhookDelivery
+	attempts      []recordedAttempt
+}
+
+type recordedAttempt struct {
+	attempt    repository.WebhookAttempt
+	recordedAt time.Time
+}
+
+func (r *fakeWebhookDeliveryRepo) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery.ID = int64(len(r.deliveries) + 1)
+	delivery.Status = entity.WebhookDeliveryPending
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+func (r *fakeWebhookDeliveryRepo) GetByID(ctx context.Context, subscriptionID, id int64) (*entity.WebhookDelivery, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeWebhookDeliveryRepo) List(ctx context.Context, subscriptionID int64, opts repository.WebhookDeliveryListOptions) ([]*entity.WebhookDelivery, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeWebhookDeliveryRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*entity.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var claimed []*entity.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		subscription := r.subscriptions.subscriptions[delivery.SubscriptionID]
+		if delivery.Status != entity.WebhookDeliveryPending || delivery.NextAttemptAt.After(r.now) || !subscription.Active {
+			continue
+		}
+		if len(claimed) == limit {
+			break
+		}
+		delivery.NextAttemptAt = r.now.Add(lease)
+		copied := *delivery
+		claimed = append(claimed, &copied)
+	}
+	return claimed, nil
+}
+
+func (r *fakeWebhookDeliveryRepo) RecordAttempt(ctx context.Context, id int64, attempt repository.WebhookAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery := r.deliveries[id-1]
+	delivery.Attempts++
+	delivery.Status = attempt.Status
+	delivery.NextAttemptAt = attempt.NextAttemptAt
+	r.attempts = append(r.attempts, recordedAttempt{attempt: attempt, recordedAt: time.Now()})
+	return nil
+}
+
+// advance moves the repository's clock past every pending retry.
+func (r *fakeWebhookDeliveryRepo) advance() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.now = time.Now().Add(2 * webhookMaxBackoff)
+}
+
+func TestWebhookDispatcherRetrySchedule(t *testing.T) {
+	const secret = "s3cret"
+
+	tests := []struct {
+		name string
+		// responses are the status codes the endpoint answers in turn
+		responses    []int
+		maxAttempts  int
+		disableAfter int
+		wantStatuses []string
+		wantActive   bool
+	}{
+		{
+			name:         "accepted at once",
+			responses:    []int{http.StatusOK},
+			maxAttempts:  3,
+			disableAfter: 10,
+			wantStatuses: []string{entity.WebhookDeliverySucceeded},
+			wantActive:   true,
+		},
+		{
+			name:         "retried until accepted",
+			responses:    []int{http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusNoContent},
+			maxAttempts:  5,
+			disableAfter: 10,
+			wantStatuses: []string{entity.WebhookDeliveryPending, entity.WebhookDeliveryPending, entity.WebhookDeliverySucceeded},
+			wantActive:   true,
+		},
+		{
+			name:         "given up after the last attempt",
+			responses:    []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK},
+			maxAttempts:  3,
+			disableAfter: 10,
+			wantStatuses: []string{entity.WebhookDeliveryPending, entity.WebhookDeliveryPending, entity.WebhookDeliveryFailed},
+			wantActive:   true,
+		},
+		{
+			name:         "subscription disabled after failures in a row",
+			responses:    []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			maxAttempts:  5,
+			disableAfter: 2,
+			wantStatuses: []string{entity.WebhookDeliveryPending, entity.WebhookDeliveryPending},
+			wantActive:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var calls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				body, _ := io.ReadAll(r.Body)
+				timestamp, _ := strconv.ParseInt(r.Header.Get(webhook.TimestampHeader), 10, 64)
+				if !webhook.Verify(secret, timestamp, body, r.Header.Get(webhook.SignatureHeader)) {
+					t.Errorf("request %d has an invalid signature", calls+1)
+				}
+				w.WriteHeader(tt.responses[calls])
+				calls++
+			}))
+			defer server.Close()
+
+			subscriptions := &fakeWebhookSubscriptionRepo{subscriptions: make(map[int64]*entity.WebhookSubscription)}
+			deliveries := &fakeWebhookDeliveryRepo{now: time.Now(), subscriptions: subscriptions}
+			logger := zerolog.Nop()
+			dispatcher := NewWebhookDispatcherImpl(subscriptions, deliveries, &fakeTenantRepo{tenants: []*entity.Tenant{{ID: 1}}}, fakeTxManager{},
+				webhook.NewHTTPSender(server.Client()), &logger, time.Second, 10, tt.maxAttempts, tt.disableAfter)
+
+			ctx := context.Background()
+			if err := subscriptions.Create(ctx, &entity.WebhookSubscription{URL: server.URL, Secret: secret, EventTypes: []string{"review.*"}, Active: true}); err != nil {
+				t.Fatal(err)
+			}
+			if err := dispatcher.Publish(ctx, &entity.OutboxEvent{ID: 1, EventType: entity.EventReviewCreated, Payload: json.RawMessage(`{}`), CreatedAt: time.Now()}); err != nil {
+				t.Fatal(err)
+			}
+
+			for round := 0; round < len(tt.responses)+2; round++ {
+				if _, err := dispatcher.DispatchOnce(ctx); err != nil {
+					t.Fatalf("round %d: %v", round, err)
+				}
+				deliveries.advance()
+			}
+
+			if len(deliveries.attempts) != len(tt.wantStatuses) {
+				t.Fatalf("recorded %d attempts, want %d", len(deliveries.attempts), len(tt.wantStatuses))
+			}
+			for i, recorded := range deliveries.attempts {
+				attempt := recorded.attempt
+				if attempt.Status != tt.wantStatuses[i] {
+					t.Errorf("attempt %d status = %s, want %s", i+1, attempt.Status, tt.wantStatuses[i])
+				}
+				if attempt.ResponseStatus == nil || *attempt.ResponseStatus != tt.responses[i] {
+					t.Errorf("attempt %d response status = %v, want %d", i+1, attempt.ResponseStatus, tt.responses[i])
+				}
+				if attempt.Status != entity.WebhookDeliveryPending {
+					continue
+				}
+				// Retries follow the jittered exponential schedule
+				full := webhookBaseBackoff << i
+				delay := attempt.NextAttemptAt.Sub(recorded.recordedAt)
+				if delay < full/2-time.Second || delay > full {
+					t.Errorf("attempt %d retries after %v, want between %v and %v", i+1, delay, full/2, full)
+				}
+			}
+
+			subscription, _ := subscriptions.GetByID(ctx, 1)
+			if subscription.Active != tt.wantActive {
+				t.Errorf("subscription active = %v, want %v", subscription.Active, tt.wantActive)
+			}
+		})
+	}
+}
+
+func TestWebhookDispatcherPublishMatchesSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	subscriptions := &fakeWebhookSubscriptionRepo{subscriptions: make(map[int64]*entity.WebhookSubscription)}
+	deliveries := &fakeWebhookDeliveryRepo{now: time.Now(), subscriptions: subscriptions}
+	for _, subscription := range []*entity.WebhookSubscription{
+		{URL: "https://a.example", EventTypes: []string{"review.*"}, Active: true},
+		{URL: "https://b.example", EventTypes: []string{"review.deleted"}, Active: true},
+		{URL: "https://c.example", EventTypes: []string{"*"}, Active: false},
+		{URL: "https://d.example", EventTypes: []string{"*"}, Active: true},
+	} {
+		if err := subscriptions.Create(ctx, subscription); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	logger := zerolog.Nop()
+	dispatcher := NewWebhookDispatcherImpl(subscriptions, deliveries, &fakeTenantRepo{}, fakeTxManager{}, nil, &logger, time.Second, 10, 3, 10)
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := dispatcher.Publish(ctx, &entity.OutboxEvent{ID: 9, EventType: entity.EventReviewCreated, Payload: json.RawMessage(`{"id":3}`), CreatedAt: createdAt}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int64
+	for _, delivery := range deliveries.deliveries {
+		got = append(got, delivery.SubscriptionID)
+		want := `{"id":9,"type":"review.created","created_at":"2026-01-02T03:04:05Z","data":{"id":3}}`
+		if string(delivery.Payload) != want {
+			t.Errorf("payload = %s, want %s", delivery.Payload, want)
+		}
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 4 {
+		t.Errorf("delivered to subscriptions %v, want [1 4]", got)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		full     time.Duration
+	}{
+		{attempts: 1, full: webhookBaseBackoff},
+		{attempts: 2, full: 2 * webhookBaseBackoff},
+		{attempts: 5, full: 16 * webhookBaseBackoff},
+		{attempts: 10, full: 512 * webhookBaseBackoff},
+		{attempts: 11, full: webhookMaxBackoff},
+		{attempts: 30, full: webhookMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			got := webhookBackoff(tt.attempts)
+			if got < tt.full/2 || got > tt.full {
+				t.Fatalf("webhookBackoff(%d) = %v, want between %v and %v", tt.attempts, got, tt.full/2, tt.full)
+			}
+		}
+	}
+}
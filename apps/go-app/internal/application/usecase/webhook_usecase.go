@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+)
+
+// eventFilterPattern accepts "*", an event type such as "review.created" and
+// a prefix wildcard such as "review.*".
+var eventFilterPattern = regexp.MustCompile(`^(\*|[a-z_]+(\.[a-z_]+)*(\.\*)?)$`)
+
+type WebhookUseCaseImpl struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+}
+
+func NewWebhookUseCaseImpl(
+	subscriptionRepo repository.WebhookSubscriptionRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+) *WebhookUseCaseImpl {
+	return &WebhookUseCaseImpl{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}
+}
+
+func (u *WebhookUseCaseImpl) Create(ctx context.Context, webhookDTO dto.CreateWebhookDTO) (*dto.WebhookDTO, error) {
+	if err := validateWebhook(webhookDTO.URL, webhookDTO.EventTypes); err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if webhookDTO.Secret != nil {
+		secret = *webhookDTO.Secret
+	} else {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription := &entity.WebhookSubscription{
+		URL:        webhookDTO.URL,
+		Secret:     secret,
+		EventTypes: webhookDTO.EventTypes,
+	}
+	if err := u.subscriptionRepo.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	// The secret is shown once; afterwards only the receiver knows it
+	webhook := toWebhookDTO(subscription)
+	webhook.Secret = subscription.Secret
+	return webhook, nil
+}
+
+func (u *WebhookUseCaseImpl) Retrieve(ctx context.Context, id int64) (*dto.WebhookDTO, error) {
+	subscription, err := u.subscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDTO(subscription), nil
+}
+
+func (u *WebhookUseCaseImpl) Update(ctx context.Context, id int64, webhookDTO dto.UpdateWebhookDTO) (*dto.WebhookDTO, error) {
+	if err := validateWebhook(webhookDTO.URL, webhookDTO.EventTypes); err != nil {
+		return nil, err
+	}
+
+	subscription, err := u.subscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.URL = webhookDTO.URL
+	subscription.EventTypes = webhookDTO.EventTypes
+	switch {
+	case webhookDTO.Active && !subscription.Active:
+		subscription.ConsecutiveFailures = 0
+		subscription.DisabledAt = nil
+	case !webhookDTO.Active && subscription.Active:
+		now := time.Now()
+		subscription.DisabledAt = &now
+	}
+	subscription.Active = webhookDTO.Active
+
+	if err := u.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return toWebhookDTO(subscription), nil
+}
+
+func (u *WebhookUseCaseImpl) Delete(ctx context.Context, id int64) error {
+	return u.subscriptionRepo.Delete(ctx, id)
+}
+
+func (u *WebhookUseCaseImpl) List(ctx context.Context) ([]*dto.WebhookDTO, error) {
+	subscriptions, err := u.subscriptionRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dtos []*dto.WebhookDTO
+	for _, subscription := range subscriptions {
+		dtos = append(dtos, toWebhookDTO(subscription))
+	}
+
+	return dtos, nil
+}
+
+func (u *WebhookUseCaseImpl) ListDeliveries(ctx context.Context, id int64, query dto.ListWebhookDeliveriesQuery) ([]*dto.WebhookDeliveryDTO, error) {
+	if _, err := u.subscriptionRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := u.deliveryRepo.List(ctx, id, repository.WebhookDeliveryListOptions{
+		Offset: query.Offset,
+		Limit:  query.Limit,
+		Status: query.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dtos []*dto.WebhookDeliveryDTO
+	for _, delivery := range deliveries {
+		dtos = append(dtos, toWebhookDeliveryDTO(delivery))
+	}
+
+	return dtos, nil
+}
+
+// Redeliver keeps the original delivery in the log untouched and queues a copy
+// of its payload, which the dispatcher sends on its next round.
+func (u *WebhookUseCaseImpl) Redeliver(ctx context.Context, id, deliveryID int64) (*dto.WebhookDeliveryDTO, error) {
+	original, err := u.deliveryRepo.GetByID(ctx, id, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &entity.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+	}
+	if err := u.deliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return toWebhookDeliveryDTO(delivery), nil
+}
+
+func validateWebhook(rawURL string, eventTypes []string) error {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return domainerrors.ErrInvalidWebhookURL
+	}
+
+	for _, eventType := range eventTypes {
+		if !eventFilterPattern.MatchString(eventType) {
+			return domainerrors.ErrInvalidEventFilter
+		}
+	}
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+func toWebhookDTO(subscription *entity.WebhookSubscription) *dto.WebhookDTO {
+	webhookDTO := &dto.WebhookDTO{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		EventTypes:          subscription.EventTypes,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           subscription.UpdatedAt.Format(time.RFC3339),
+	}
+	if subscription.DisabledAt != nil {
+		webhookDTO.DisabledAt = subscription.DisabledAt.Format(time.RFC3339)
+	}
+
+	return webhookDTO
+}
+
+func toWebhookDeliveryDTO(delivery *entity.WebhookDelivery) *dto.WebhookDeliveryDTO {
+	deliveryDTO := &dto.WebhookDeliveryDTO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt.Format(time.RFC3339),
+		Payload:        json.RawMessage(delivery.Payload),
+	}
+	if delivery.Status == entity.WebhookDeliveryPending {
+		deliveryDTO.NextAttemptAt = delivery.NextAttemptAt.Format(time.RFC3339)
+	}
+	if delivery.LastAttemptAt != nil {
+		deliveryDTO.LastAttemptAt = delivery.LastAttemptAt.Format(time.RFC3339)
+	}
+	if delivery.DeliveredAt != nil {
+		deliveryDTO.DeliveredAt = delivery.DeliveredAt.Format(time.RFC3339)
+	}
+
+	return deliveryDTO
+}
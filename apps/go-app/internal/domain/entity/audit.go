@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audited entity types.
+const (
+	AuditEntityReview      = "review"
+	AuditEntityReviewReply = "review_reply"
+	AuditEntityProduct     = "product"
+	AuditEntityRatingAlert = "rating_alert"
+	AuditEntityCategory    = "category"
+	AuditEntityQuestion    = "product_question"
+	AuditEntityAnswer      = "product_answer"
+	AuditEntityPolicy      = "review_policy"
+)
+
+// Audited actions.
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+	// AuditActionWithdraw records an author withdrawing a review before it
+	// was published
+	AuditActionWithdraw = "withdraw"
+	// AuditActionHold records a review held for moderation automatically
+	AuditActionHold = "hold"
+	// AuditActionResolveReports records a moderator resolving a review's reports
+	AuditActionResolveReports = "resolve_reports"
+	// AuditActionGrantOwner and AuditActionRevokeOwner record a product's
+	// owners changing
+	AuditActionGrantOwner  = "grant_owner"
+	AuditActionRevokeOwner = "revoke_owner"
+)
+
+// AuditEntry records who changed an entity and its state before and after the change.
+type AuditEntry struct {
+	ID         int64
+	EntityType string
+	EntityID   int64
+	Action     string
+	Actor      string
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+	"user-review-ingest/internal/domain/valueobject"
+)
+
+// Category groups products and defines the aspects their reviewers rate
+// besides the overall rating.
+type Category struct {
+	ID      int64
+	Slug    string
+	Name    string
+	Aspects []*CategoryAspect
+	// RatingScale overrides the tenant's for reviews of the category's
+	// products; nil to use the tenant's
+	RatingScale *valueobject.RatingScale
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CategoryAspect is a quality of a category's products that reviewers rate
+// separately, such as "fit" for apparel.
+type CategoryAspect struct {
+	ID         int64
+	CategoryID int64
+	Key        string
+	Name       string
+	// Required aspects must be rated by every new review
+	Required bool
+	Position int
+}
+
+// Aspect returns the category's aspect with the given key, or nil.
+func (c *Category) Aspect(key string) *CategoryAspect {
+	for _, aspect := range c.Aspects {
+		if aspect.Key == key {
+			return aspect
+		}
+	}
+	return nil
+}
+
+// AspectRating is a review's rating of one aspect of the product.
+type AspectRating struct {
+	AspectID int64
+	Key      string
+	Rating   valueobject.Rating
+}
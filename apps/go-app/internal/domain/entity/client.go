@@ -0,0 +1,17 @@
+package entity
+
+import "context"
+
+type clientIPKey struct{}
+
+// ContextWithClientIP returns a copy of ctx carrying the address of the
+// client a request came from.
+func ContextWithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// ClientIPFromContext returns the client address carried by ctx, if any.
+func ClientIPFromContext(ctx context.Context) (string, bool) {
+	clientIP, ok := ctx.Value(clientIPKey{}).(string)
+	return clientIP, ok && clientIP != ""
+}
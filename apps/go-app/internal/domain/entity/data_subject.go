@@ -0,0 +1,69 @@
+package entity
+
+import "time"
+
+// Kinds of data subject requests.
+const (
+	DataSubjectExport  = "export"
+	DataSubjectErasure = "erasure"
+)
+
+// What erasure does with the subject's reviews: anonymized reviews stay
+// published without their author, deleted ones are removed entirely.
+const (
+	ReviewErasureAnonymize = "anonymize"
+	ReviewErasureDelete    = "delete"
+)
+
+// DataSubjectRequest is the immutable record of a fulfilled access or erasure
+// request.
+type DataSubjectRequest struct {
+	ID     int64
+	Kind   string
+	UserID int64
+	// AccountID is the auth account covered by the request, if one was named
+	AccountID    *string
+	RequestedBy  string
+	ReviewPolicy *string
+	// Summary counts the records exported or erased, per kind of record
+	Summary       map[string]int64
+	ArchiveSHA256 *string
+	FulfilledAt   time.Time
+}
+
+// Erasure is the outcome of erasing a user's personal data.
+type Erasure struct {
+	// Summary counts the records erased, per kind of record
+	Summary map[string]int64
+	// Reviews are the user's live reviews as the erasure left them:
+	// anonymized, or as they were when deleted
+	Reviews []*Review
+}
+
+// PersonalData is everything stored about a user within a tenant.
+type PersonalData struct {
+	Reviews         []*Review
+	Votes           []*ReviewVote
+	Reports         []*ReviewReport
+	Replies         []*ReviewReply
+	Questions       []*ProductQuestion
+	Answers         []*ProductAnswer
+	AnswerVotes     []*ProductAnswerVote
+	Attachments     []*ReviewAttachment
+	Orders          []*Order
+	Invitations     []*ReviewInvitation
+	OwnedProductIDs []int64
+	ExportJobs      []*ExportJob
+	// Reviewer is nil when the user never wrote a review
+	Reviewer *ReviewerProfile
+	// Account is nil when no account was named or it does not exist
+	Account *UserAccount
+}
+
+// UserAccount is the login of a user: credentials, profiles and the OAuth
+// providers linked to it.
+type UserAccount struct {
+	Auth        *UserAuth
+	Profiles    []*UserProfile
+	Connections []*OAuthConnection
+}
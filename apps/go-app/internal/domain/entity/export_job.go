@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// Export job statuses.
+const (
+	ExportJobQueued    = "queued"
+	ExportJobRunning   = "running"
+	ExportJobSucceeded = "succeeded"
+	ExportJobFailed    = "failed"
+)
+
+// ReviewExportFilters selects the reviews an export contains. They are the
+// review list filters, resolved against the requester's permissions when the
+// export is requested.
+type ReviewExportFilters struct {
+	VerifiedPurchase  *bool   `json:"verified_purchase,omitempty"`
+	ProductID         *int64  `json:"product_id,omitempty"`
+	Status            *string `json:"status,omitempty"`
+	SentimentLabel    *string `json:"sentiment_label,omitempty"`
+	SentimentMismatch *bool   `json:"sentiment_mismatch,omitempty"`
+	Language          *string `json:"language,omitempty"`
+	Published         *bool   `json:"published,omitempty"`
+}
+
+// ExportJob is a review export produced in the background and kept in blob
+// storage until it is downloaded.
+type ExportJob struct {
+	ID          int64
+	RequestedBy int64
+	Format      string
+	Gzip        bool
+	Filters     ReviewExportFilters
+	Status      string
+	BlobKey     *string
+	RowCount    *int64
+	SizeBytes   *int64
+	Error       *string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
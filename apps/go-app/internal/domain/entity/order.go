@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// Order records that a customer bought a product. One storefront order with
+// several products is stored as one Order per product.
+type Order struct {
+	ID          int64
+	ExternalID  string
+	CustomerID  int64
+	ProductID   int64
+	PurchasedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
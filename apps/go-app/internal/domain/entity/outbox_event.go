@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Aggregates that emit domain events.
+const (
+	AggregateReview      = "review"
+	AggregateRatingAlert = "rating_alert"
+)
+
+// Review domain events.
+const (
+	EventReviewCreated = "review.created"
+	EventReviewUpdated = "review.updated"
+	EventReviewDeleted = "review.deleted"
+	// EventReviewPublished follows a review's cooling-off period
+	EventReviewPublished = "review.published"
+)
+
+// Rating anomaly events.
+const (
+	EventRatingAlertRaised = "rating_alert.raised"
+)
+
+// OutboxEvent is a domain event stored alongside the change it describes,
+// waiting to be relayed to subscribers.
+type OutboxEvent struct {
+	ID       int64
+	TenantID int64
+	// Seq orders events by commit; 0 until the event has been sequenced
+	Seq           int64
+	AggregateType string
+	AggregateID   int64
+	EventType     string
+	Payload       json.RawMessage
+	Attempts      int
+	CreatedAt     time.Time
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// Roles granted through access tokens.
+const (
+	RoleAdmin = "admin"
+)
+
+// Principal is the authenticated caller of a request, as resolved from its access token.
+type Principal struct {
+	UserID int64
+	Roles  []string
+	// Tenant is the slug of the tenant the token was issued for, if any
+	Tenant string
+	// IssuedAt is when the access token was issued; zero when the token has no iat claim
+	IssuedAt time.Time
+}
+
+// HasRole reports whether the principal has been granted the given role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the principal.
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal carried by ctx, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// ActorFromContext describes the caller for audit records.
+func ActorFromContext(ctx context.Context) string {
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		return UserActor(principal.UserID)
+	}
+	return "anonymous"
+}
+
+// UserActor describes the user for audit records.
+func UserActor(userID int64) string {
+	return "user:" + strconv.FormatInt(userID, 10)
+}
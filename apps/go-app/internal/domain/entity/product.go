@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// Product is a catalog entry that reviews are written for. Variants of a
+// product (sizes, colours, ...) point at the group's primary product through
+// GroupID and share its reviews.
+type Product struct {
+	ID         int64
+	SKU        string
+	ExternalID *string
+	Name       string
+	GroupID    *int64
+	CategoryID *int64
+	ArchivedAt *time.Time
+	// HoldNewReviews queues every new review of the product for moderation,
+	// as when it is being review-bombed
+	HoldNewReviews bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsArchived reports whether the product was withdrawn from the catalog.
+func (p *Product) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
+// GroupRootID returns the ID of the primary product of the product's group.
+func (p *Product) GroupRootID() int64 {
+	if p.GroupID != nil {
+		return *p.GroupID
+	}
+	return p.ID
+}
+
+// ProductOwner grants a user the right to act on behalf of a product's brand:
+// replying to its reviews and giving brand answers to its questions.
+type ProductOwner struct {
+	ProductID int64
+	UserID    int64
+	CreatedAt time.Time
+}
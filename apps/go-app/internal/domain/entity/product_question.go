@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// ProductQuestion is a shopper's question about a product. Questions and
+// answers are moderated with the statuses of reviews.
+type ProductQuestion struct {
+	ID        int64
+	ProductID int64
+	UserID    int64
+	Body      string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+	// Answers are not loaded by the repository
+	Answers []*ProductAnswer
+}
+
+// ProductAnswer answers a product question, either as a shopper or, for
+// Brand answers, on behalf of the product's brand.
+type ProductAnswer struct {
+	ID             int64
+	QuestionID     int64
+	UserID         int64
+	Body           string
+	Brand          bool
+	Status         string
+	HelpfulCount   int
+	UnhelpfulCount int
+	HelpfulScore   float64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+// ProductAnswerVote is a single user's helpful/unhelpful judgement of an
+// answer.
+type ProductAnswerVote struct {
+	AnswerID  int64
+	UserID    int64
+	Helpful   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,26 @@
+package entity
+
+// ProductSummary aggregates the approved reviews of a product and its
+// variants.
+type ProductSummary struct {
+	ProductID     int64
+	ReviewCount   int64
+	AverageRating float64
+	// Distribution counts the reviews per star rating
+	Distribution map[int]int64
+	// Aspects are those of the product's category, in display order
+	Aspects []*AspectSummary
+	// QuestionCount, AnsweredQuestionCount and AnswerCount count the
+	// approved questions, those with an approved answer, and their approved
+	// answers
+	QuestionCount         int64
+	AnsweredQuestionCount int64
+	AnswerCount           int64
+}
+
+type AspectSummary struct {
+	Key           string
+	Name          string
+	RatingCount   int64
+	AverageRating float64
+}
@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+// Kinds of rating anomalies.
+const (
+	// RatingAlertVolumeSpike flags far more reviews than the product usually gets
+	RatingAlertVolumeSpike = "volume_spike"
+	// RatingAlertRatingShift flags a mean rating far from the product's usual one
+	RatingAlertRatingShift = "rating_shift"
+)
+
+// Rating alert statuses. An alert stays open until a moderator acknowledges
+// or resolves it; the product gets no new alert of the same kind meanwhile.
+const (
+	RatingAlertOpen         = "open"
+	RatingAlertAcknowledged = "acknowledged"
+	RatingAlertResolved     = "resolved"
+)
+
+// RatingAlert records abnormal rating activity on a product within a window,
+// compared with the product's history.
+type RatingAlert struct {
+	ID          int64
+	ProductID   int64
+	Kind        string
+	Status      string
+	WindowStart time.Time
+	WindowEnd   time.Time
+	ReviewCount int
+	MeanRating  float64
+	// ExpectedCount is the number of reviews the history predicts for the window
+	ExpectedCount  float64
+	BaselineMean   float64
+	BaselineStddev float64
+	// Score is how many standard errors the window is away from the baseline
+	Score float64
+	// ProductHeld tells whether the alert switched the product to holding new reviews
+	ProductHeld bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RatingWindowStats summarizes a product's reviews within a window and over
+// the baseline period preceding it.
+type RatingWindowStats struct {
+	ProductID      int64
+	WindowCount    int64
+	WindowMean     float64
+	BaselineCount  int64
+	BaselineMean   float64
+	BaselineStddev float64
+}
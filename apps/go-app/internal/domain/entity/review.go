@@ -5,14 +5,59 @@ import (
 	"user-review-ingest/internal/domain/valueobject"
 )
 
+// Moderation statuses of a review. Only approved reviews are listed publicly,
+// once they are published.
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
 type Review struct {
-	ID        int64
-	UserID    int64
-	ProductID int64
-	Rating    valueobject.Rating
-	Comment   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
-	CreatedBy string
+	ID               int64
+	UserID           int64
+	ProductID        int64
+	Rating           valueobject.Rating
+	Comment          string
+	HelpfulCount     int
+	UnhelpfulCount   int
+	HelpfulScore     float64
+	VerifiedPurchase bool
+	OrderID          *int64
+	Status           string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
+	CreatedBy        string
+	// PublishAt is when the review goes public, at the end of its tenant's
+	// cooling-off period
+	PublishAt time.Time
+	// PublishedAt is set once the review has been published
+	PublishedAt *time.Time
+	// Fingerprint identifies near-duplicate comments; nil for short comments
+	Fingerprint *valueobject.SimHash
+	// Sentiment of the comment; nil when it carries none
+	Sentiment *valueobject.Sentiment
+	// SentimentMismatch marks comments that contradict the rating
+	SentimentMismatch bool
+	// DetectedLanguage is the ISO 639-1 code detected from the comment
+	DetectedLanguage *string
+	// Locale is the language tag the client says the review is written in
+	Locale *valueobject.Locale
+	// Language is the locale's language if set, else the detected one
+	Language *string
+	// Aspects rates aspects of the product's category. Not loaded by the
+	// repository.
+	Aspects []*AspectRating
+}
+
+// IsPublic reports whether the review is shown to everyone: it is approved and
+// its cooling-off period is over.
+func (r *Review) IsPublic() bool {
+	return r.Status == ReviewStatusApproved && r.IsPublished()
+}
+
+// IsPublished reports whether the review's cooling-off period is over.
+func (r *Review) IsPublished() bool {
+	return r.PublishedAt != nil
 }
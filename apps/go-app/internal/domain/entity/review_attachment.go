@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// ReviewAttachment is an image attached to a review. The image and its
+// thumbnail live in blob storage under BlobKey and ThumbnailKey.
+type ReviewAttachment struct {
+	ID           int64
+	ReviewID     int64
+	BlobKey      string
+	ThumbnailKey string
+	ContentType  string
+	SizeBytes    int64
+	Width        int
+	Height       int
+	CreatedAt    time.Time
+	DeletedAt    *time.Time
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// Change feed operations.
+const (
+	ReviewChangeInsert = "insert"
+	ReviewChangeUpdate = "update"
+	ReviewChangeDelete = "delete"
+)
+
+// ReviewChange records that a review was inserted, updated or deleted. Seq
+// orders the changes of a tenant in the order they were committed.
+type ReviewChange struct {
+	Seq       int64
+	ReviewID  int64
+	Operation string
+	ChangedAt time.Time
+}
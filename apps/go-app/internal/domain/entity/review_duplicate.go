@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// ReviewDuplicate links a review to an earlier one whose comment it nearly
+// copies.
+type ReviewDuplicate struct {
+	ReviewID   int64
+	OriginalID int64
+	// Distance is how many bits the comments' fingerprints differ in
+	Distance  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+// Statuses of a review invitation, derived from its timestamps.
+const (
+	InvitationStatusIssued    = "issued"
+	InvitationStatusOpened    = "opened"
+	InvitationStatusCompleted = "completed"
+	InvitationStatusExpired   = "expired"
+)
+
+// ReviewInvitation invites a customer to review a product they ordered,
+// through a signed link that can be redeemed once.
+type ReviewInvitation struct {
+	ID          int64
+	OrderID     int64
+	CustomerID  int64
+	ProductID   int64
+	ExpiresAt   time.Time
+	OpenedAt    *time.Time
+	CompletedAt *time.Time
+	// ReviewID is the review written through the invitation, unless it has
+	// been deleted since
+	ReviewID  *int64
+	CreatedAt time.Time
+}
+
+// Status reports how far the customer got with the invitation at now.
+func (i *ReviewInvitation) Status(now time.Time) string {
+	switch {
+	case i.CompletedAt != nil:
+		return InvitationStatusCompleted
+	case !now.Before(i.ExpiresAt):
+		return InvitationStatusExpired
+	case i.OpenedAt != nil:
+		return InvitationStatusOpened
+	default:
+		return InvitationStatusIssued
+	}
+}
+
+// InvitationStats counts the invitations issued in a period by how far
+// their customers got.
+type InvitationStats struct {
+	Issued    int64
+	Opened    int64
+	Completed int64
+	// Expired counts invitations that expired without being redeemed
+	Expired int64
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+	domainerrors "user-review-ingest/internal/domain/errors"
+)
+
+// How new reviews of a product are moderated: auto-approved ones are published
+// unless something holds them, pre-moderated ones all wait for a moderator,
+// and so do their edits.
+const (
+	ReviewModerationAutoApprove = "auto_approve"
+	ReviewModerationPreModerate = "pre_moderate"
+)
+
+// Rules of a review policy, as named in policy violations.
+const (
+	PolicyRuleClosed           = "closed"
+	PolicyRuleVerifiedOnly     = "verified_only"
+	PolicyRuleCommentRequired  = "comment_required"
+	PolicyRuleMinCommentLength = "min_comment_length"
+)
+
+// ReviewPolicy sets rules for the reviews of a product, and of the variants
+// in its group, or of a category's products. Exactly one of ProductID and
+// CategoryID is set. Rules left nil are inherited.
+type ReviewPolicy struct {
+	ID               int64
+	ProductID        *int64
+	CategoryID       *int64
+	MinCommentLength *int
+	CommentRequired  *bool
+	VerifiedOnly     *bool
+	Moderation       *string
+	Closed           *bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// ReviewRules are the rules a review is held to once its product's policies
+// are resolved.
+type ReviewRules struct {
+	MinCommentLength int
+	CommentRequired  bool
+	VerifiedOnly     bool
+	Moderation       string
+	Closed           bool
+}
+
+// ResolveReviewRules merges policies, most specific first, into the rules
+// reviews are held to. Rules no policy sets keep their defaults: comments are
+// optional, anyone can review and reviews are auto-approved.
+func ResolveReviewRules(policies []*ReviewPolicy) ReviewRules {
+	rules := ReviewRules{Moderation: ReviewModerationAutoApprove}
+	for i := len(policies) - 1; i >= 0; i-- {
+		policy := policies[i]
+		if policy.MinCommentLength != nil {
+			rules.MinCommentLength = *policy.MinCommentLength
+		}
+		if policy.CommentRequired != nil {
+			rules.CommentRequired = *policy.CommentRequired
+		}
+		if policy.VerifiedOnly != nil {
+			rules.VerifiedOnly = *policy.VerifiedOnly
+		}
+		if policy.Moderation != nil {
+			rules.Moderation = *policy.Moderation
+		}
+		if policy.Closed != nil {
+			rules.Closed = *policy.Closed
+		}
+	}
+	return rules
+}
+
+// Check returns a PolicyViolationError naming every rule the review breaks,
+// or nil. The verified purchase rule only applies to new reviews.
+func (r ReviewRules) Check(review *Review, isNew bool) error {
+	var violations []domainerrors.PolicyViolation
+	if r.Closed {
+		violations = append(violations, domainerrors.PolicyViolation{
+			Rule:    PolicyRuleClosed,
+			Message: "reviews of this product are closed",
+		})
+	}
+	if isNew && r.VerifiedOnly && !review.VerifiedPurchase {
+		violations = append(violations, domainerrors.PolicyViolation{
+			Rule:    PolicyRuleVerifiedOnly,
+			Message: "only verified purchases can be reviewed",
+		})
+	}
+
+	length := utf8.RuneCountInString(strings.TrimSpace(review.Comment))
+	switch {
+	case length == 0 && r.CommentRequired:
+		violations = append(violations, domainerrors.PolicyViolation{
+			Rule:    PolicyRuleCommentRequired,
+			Message: "a comment is required",
+		})
+	case length > 0 && length < r.MinCommentLength:
+		violations = append(violations, domainerrors.PolicyViolation{
+			Rule:    PolicyRuleMinCommentLength,
+			Message: fmt.Sprintf("comment must be at least %d characters long", r.MinCommentLength),
+		})
+	}
+
+	if len(violations) > 0 {
+		return &domainerrors.PolicyViolationError{Violations: violations}
+	}
+	return nil
+}
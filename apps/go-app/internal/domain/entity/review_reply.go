@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// ReviewReply is the product brand's public answer to a review.
+type ReviewReply struct {
+	ID        int64
+	ReviewID  int64
+	AuthorID  int64
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
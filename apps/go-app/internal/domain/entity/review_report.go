@@ -0,0 +1,43 @@
+package entity
+
+import "time"
+
+// Reasons a shopper can report a review for.
+const (
+	ReviewReportReasonSpam      = "spam"
+	ReviewReportReasonOffensive = "offensive"
+	ReviewReportReasonFake      = "fake"
+	ReviewReportReasonOther     = "other"
+)
+
+// Resolutions of a review report. Reports stay open until a moderator
+// upholds or dismisses them.
+const (
+	ReviewReportStatusOpen      = "open"
+	ReviewReportStatusUpheld    = "upheld"
+	ReviewReportStatusDismissed = "dismissed"
+)
+
+// ReviewReport is a shopper's report of an abusive review.
+type ReviewReport struct {
+	ID         int64
+	ReviewID   int64
+	ReporterID int64
+	Reason     string
+	Details    *string
+	Status     string
+	ResolvedBy *int64
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// ReportedReview counts the reports of one review.
+type ReportedReview struct {
+	ReviewID       int64
+	OpenCount      int64
+	UpheldCount    int64
+	DismissedCount int64
+	// ReasonCounts counts the reports per reason, resolved ones included
+	ReasonCounts   map[string]int64
+	LastReportedAt time.Time
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// ReviewVote is a single user's helpful/unhelpful judgement of a review.
+type ReviewVote struct {
+	ReviewID  int64
+	UserID    int64
+	Helpful   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,69 @@
+package entity
+
+import "time"
+
+// Badges awarded to reviewers from their profile.
+const (
+	BadgeTopReviewer     = "top_reviewer"
+	BadgeVerifiedBuyer   = "verified_buyer"
+	BadgeHelpfulReviewer = "helpful_reviewer"
+)
+
+// ReviewerProfile aggregates an author's live reviews. Its totals are kept
+// current as reviews are written, voted on, moderated and deleted.
+type ReviewerProfile struct {
+	UserID        int64
+	ReviewCount   int
+	ApprovedCount int
+	RejectedCount int
+	VerifiedCount int
+	// Votes received on the author's reviews
+	HelpfulVotes   int
+	UnhelpfulVotes int
+	// Reputation ranges from 0 to 100; it grows with helpful votes, verified
+	// purchases and reviews passing moderation, and with the number of reviews
+	Reputation float64
+	UpdatedAt  time.Time
+}
+
+// VerifiedRatio is the share of the author's reviews that are verified purchases.
+func (p *ReviewerProfile) VerifiedRatio() float64 {
+	if p.ReviewCount <= 0 {
+		return 0
+	}
+	return float64(p.VerifiedCount) / float64(p.ReviewCount)
+}
+
+// Badges lists the badges the author has earned.
+func (p *ReviewerProfile) Badges() []string {
+	badges := []string{}
+	if p.Reputation >= 80 && p.ReviewCount >= 10 {
+		badges = append(badges, BadgeTopReviewer)
+	}
+	if p.VerifiedCount >= 3 && p.VerifiedRatio() >= 0.8 {
+		badges = append(badges, BadgeVerifiedBuyer)
+	}
+	if p.HelpfulVotes >= 25 && p.HelpfulVotes >= 3*p.UnhelpfulVotes {
+		badges = append(badges, BadgeHelpfulReviewer)
+	}
+	return badges
+}
+
+// ReviewerTrustPolicy holds the new reviews of authors with an established
+// but low reputation for moderation.
+type ReviewerTrustPolicy struct {
+	// HoldBelow is the reputation under which reviews are held; 0 disables it
+	HoldBelow float64
+	// MinReviews is how many reviews an author needs before their reputation
+	// is trusted either way
+	MinReviews int
+}
+
+// Holds reports whether a new review by the author of profile, which is nil
+// for first-time authors, must wait for moderation.
+func (p ReviewerTrustPolicy) Holds(profile *ReviewerProfile) bool {
+	return p.HoldBelow > 0 &&
+		profile != nil &&
+		profile.ReviewCount >= p.MinReviews &&
+		profile.Reputation < p.HoldBelow
+}
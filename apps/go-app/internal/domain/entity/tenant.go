@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/valueobject"
+)
+
+// Tenant is a storefront whose data is isolated from every other storefront
+// served by the same deployment.
+type Tenant struct {
+	ID        int64
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+	// RatingScale reviewers of the tenant rate on; nil for 1–5 stars
+	RatingScale *valueobject.RatingScale
+	// ReviewCoolingOff is how long new reviews wait before going public
+	ReviewCoolingOff time.Duration
+}
+
+type tenantKey struct{}
+
+// ContextWithTenant returns a copy of ctx scoped to the tenant.
+func ContextWithTenant(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant ctx is scoped to, if any.
+func TenantFromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(*Tenant)
+	return tenant, ok
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// What review velocity limits count reviews per. Per-user limits count
+// reviews written without logging in per client address instead.
+const (
+	VelocityScopeUser    = "user"
+	VelocityScopeClient  = "client"
+	VelocityScopeProduct = "product"
+)
+
+// What happens to a review written over a velocity limit: held reviews wait
+// for moderation as pending, rejected ones are not stored.
+const (
+	VelocityActionHold   = "hold"
+	VelocityActionReject = "reject"
+)
+
+// VelocityPolicy limits how many reviews a user writes, or a product
+// receives, within a window.
+type VelocityPolicy struct {
+	Scope  string
+	Limit  int
+	Window time.Duration
+	Action string
+}
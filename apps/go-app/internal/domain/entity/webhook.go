@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// WebhookSubscription is an HTTP endpoint a tenant registered to be called
+// back when events it is interested in happen.
+type WebhookSubscription struct {
+	ID     int64
+	URL    string
+	Secret string
+	// EventTypes lists the events to deliver. "review.*" matches every event
+	// whose type starts with "review.", "*" matches every event.
+	EventTypes []string
+	// Active is false once the subscription was disabled, by hand or after
+	// too many failed deliveries in a row
+	Active              bool
+	ConsecutiveFailures int
+	DisabledAt          *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// Matches reports whether events of eventType are delivered to the subscription.
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	for _, filter := range s.EventTypes {
+		if filter == "*" || filter == eventType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(filter, "*"); ok && strings.HasSuffix(prefix, ".") && strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookDelivery is the delivery of one event to one subscription, retried
+// until the endpoint accepts it or the attempts run out.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	EventType      string
+	// Payload is the request body, kept so that redeliveries are identical
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus *int
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
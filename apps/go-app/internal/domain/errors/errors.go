@@ -3,5 +3,78 @@ package errors
 import "errors"
 
 var (
-	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewNotFound       = errors.New("review not found")
+	ErrReviewVoteNotFound   = errors.New("review vote not found")
+	ErrSelfVote             = errors.New("authors cannot vote on their own reviews")
+	ErrReviewReplyNotFound  = errors.New("review reply not found")
+	ErrReviewReplyExists    = errors.New("review already has a reply")
+	ErrNotProductOwner      = errors.New("caller does not own the reviewed product")
+	ErrProductOwnerNotFound = errors.New("user does not own the product")
+	ErrNotModerator         = errors.New("caller is not allowed to moderate reviews")
+	ErrReviewRateLimited    = errors.New("too many reviews written recently, try again later")
+	ErrReviewPublished      = errors.New("review has already been published")
+
+	ErrReviewAlreadyReported = errors.New("caller has already reported this review")
+	ErrSelfReport            = errors.New("authors cannot report their own reviews")
+	ErrNoOpenReports         = errors.New("review has no open reports")
+
+	ErrQuestionNotFound   = errors.New("question not found")
+	ErrAnswerNotFound     = errors.New("answer not found")
+	ErrAnswerVoteNotFound = errors.New("answer vote not found")
+	ErrSelfAnswerVote     = errors.New("authors cannot vote on their own answers")
+	ErrNotQuestionAuthor  = errors.New("caller is not the author of the question")
+	ErrNotAnswerAuthor    = errors.New("caller is not the author of the answer")
+
+	ErrNotReviewAuthor      = errors.New("caller is not the author of the review")
+	ErrAttachmentNotFound   = errors.New("attachment not found")
+	ErrAttachmentTooLarge   = errors.New("attachment exceeds the maximum upload size")
+	ErrTooManyAttachments   = errors.New("review has reached the maximum number of attachments")
+	ErrUnsupportedMediaType = errors.New("unsupported media type: only JPEG, PNG and GIF images are accepted")
+	ErrInvalidImage         = errors.New("image could not be decoded")
+	ErrImageTooLarge        = errors.New("image dimensions are too large")
+
+	ErrReviewerNotFound = errors.New("reviewer not found")
+
+	ErrOrderNotFound = errors.New("order not found")
+
+	ErrInvitationNotFound  = errors.New("review invitation not found or invalid")
+	ErrInvitationExpired   = errors.New("review invitation has expired")
+	ErrInvitationRedeemed  = errors.New("review invitation has already been used")
+	ErrInvitationsDisabled = errors.New("review invitations are not configured")
+
+	ErrProductNotFound     = errors.New("product not found")
+	ErrProductArchived     = errors.New("product is archived")
+	ErrProductExists       = errors.New("a product with this SKU or external ID already exists")
+	ErrInvalidProductGroup = errors.New("invalid product group")
+
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryExists      = errors.New("a category with this slug already exists")
+	ErrInvalidCategory     = errors.New("invalid product category")
+	ErrUnknownAspect       = errors.New("aspect is not defined for the product's category")
+	ErrMissingAspectRating = errors.New("a required aspect was not rated")
+	ErrInvalidRating       = errors.New("rating is not on the rating scale")
+	ErrInvalidRatingScale  = errors.New("invalid rating scale")
+
+	ErrEventNotFound = errors.New("event not found")
+	ErrStreamClosed  = errors.New("event stream is shutting down")
+
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhookURL       = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidEventFilter      = errors.New("invalid event type filter")
+
+	ErrExportJobNotFound   = errors.New("export job not found")
+	ErrExportNotReady      = errors.New("export has not finished yet")
+	ErrUnknownExportFormat = errors.New("unknown export format: expected csv, ndjson or parquet")
+
+	ErrInvalidChangeToken = errors.New("invalid change feed token")
+
+	ErrRatingAlertNotFound = errors.New("rating alert not found")
+
+	ErrAuthenticationRequired = errors.New("authentication required")
+	ErrTokenRevoked           = errors.New("access token has been revoked")
+
+	ErrTenantRequired = errors.New("request is not scoped to a tenant")
+	ErrTenantNotFound = errors.New("tenant not found")
+	ErrTenantMismatch = errors.New("access token was not issued for this tenant")
 )
@@ -0,0 +1,37 @@
+package errors
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrReviewPolicyNotFound = errors.New("review policy not found")
+	ErrInvalidReviewPolicy  = errors.New("invalid review policy")
+	// ErrReviewPolicyViolation matches every PolicyViolationError
+	ErrReviewPolicyViolation = errors.New("review violates the review policy")
+)
+
+// PolicyViolation is a rule of a review policy that a review breaks.
+type PolicyViolation struct {
+	Rule    string
+	Message string
+}
+
+// PolicyViolationError lists every rule of its product's review policy that
+// a review breaks.
+type PolicyViolationError struct {
+	Violations []PolicyViolation
+}
+
+func (e *PolicyViolationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+	return ErrReviewPolicyViolation.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *PolicyViolationError) Is(target error) bool {
+	return target == ErrReviewPolicyViolation
+}
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type AuditRepository interface {
+	Record(ctx context.Context, entry *entity.AuditEntry) error
+}
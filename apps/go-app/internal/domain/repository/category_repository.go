@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type CategoryRepository interface {
+	// Create stores the category without its aspects.
+	Create(ctx context.Context, category *entity.Category) error
+	// GetByID returns the category with its aspects.
+	GetByID(ctx context.Context, id int64) (*entity.Category, error)
+	// List returns categories without their aspects.
+	List(ctx context.Context, offset, limit int) ([]*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) error
+	// Delete removes the category with its aspects and their ratings.
+	// Products of the category are left without one.
+	Delete(ctx context.Context, id int64) error
+	// ReplaceAspects stores the category's aspects, matched by key, and
+	// deletes the others along with their ratings.
+	ReplaceAspects(ctx context.Context, category *entity.Category) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type DataSubjectRepository interface {
+	// Collect gathers the personal data of userID and, when accountID is set,
+	// of that auth account.
+	Collect(ctx context.Context, userID int64, accountID *string) (*entity.PersonalData, error)
+	// Erase removes the personal data of userID and of the account, treating
+	// reviews according to reviewPolicy, and redacts its copies in the audit
+	// log, the outbox and webhook deliveries. Nothing is erased when the
+	// account does not exist in the tenant. Run it in a transaction.
+	Erase(ctx context.Context, userID int64, accountID *string, reviewPolicy string) (*entity.Erasure, error)
+}
+
+type DataSubjectRequestRepository interface {
+	Create(ctx context.Context, request *entity.DataSubjectRequest) error
+	ListByUser(ctx context.Context, userID int64) ([]*entity.DataSubjectRequest, error)
+}
+
+type TokenRevocationRepository interface {
+	// Revoke invalidates the user's access tokens issued before the given time.
+	Revoke(ctx context.Context, userID int64, before time.Time) error
+	// RevokedBefore returns the time before which the user's tokens are
+	// invalid, or nil when none were revoked.
+	RevokedBefore(ctx context.Context, userID int64) (*time.Time, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ExportJobRepository interface {
+	Create(ctx context.Context, job *entity.ExportJob) error
+	GetByID(ctx context.Context, id int64) (*entity.ExportJob, error)
+	// Claim marks the oldest queued job as running and returns it, taking over
+	// jobs left running for longer than lease. It returns nil when there is
+	// nothing to do.
+	Claim(ctx context.Context, lease time.Duration) (*entity.ExportJob, error)
+	Complete(ctx context.Context, id int64, blobKey string, rowCount, sizeBytes int64) error
+	Fail(ctx context.Context, id int64, reason string) error
+}
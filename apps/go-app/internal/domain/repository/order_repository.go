@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type OrderRepository interface {
+	// Upsert stores the order, updating it if the same external ID and product
+	// were ingested before.
+	Upsert(ctx context.Context, order *entity.Order) error
+	// GetByExternalID returns the line of the order with the external ID for
+	// the product.
+	GetByExternalID(ctx context.Context, externalID string, productID int64) (*entity.Order, error)
+	// FindLatestPurchase returns the customer's most recent past order of the product.
+	FindLatestPurchase(ctx context.Context, customerID, productID int64) (*entity.Order, error)
+	// VerifyReviews marks the customer's unverified reviews of the product
+	// written after the purchase as verified and returns how many changed.
+	VerifyReviews(ctx context.Context, order *entity.Order) (int64, error)
+}
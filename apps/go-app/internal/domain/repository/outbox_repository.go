@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type OutboxRepository interface {
+	Append(ctx context.Context, event *entity.OutboxEvent) error
+	GetByID(ctx context.Context, id int64) (*entity.OutboxEvent, error)
+	// Sequence numbers the committed events that have none yet. It must run
+	// in a transaction of its own, which it holds the tenant's sequencing
+	// lock for.
+	Sequence(ctx context.Context) (int64, error)
+	// LatestSeq returns the highest sequence number of an aggregate type's
+	// events, or 0 if none was sequenced yet.
+	LatestSeq(ctx context.Context, aggregateType string) (int64, error)
+	// ListAfter returns up to limit sequenced events of an aggregate type
+	// following afterSeq, in commit order, whether published or not.
+	ListAfter(ctx context.Context, aggregateType string, afterSeq int64, limit int) ([]*entity.OutboxEvent, error)
+	// ClaimPending locks up to limit due events, at most one per aggregate and
+	// always its oldest unpublished one. Must run inside a transaction.
+	ClaimPending(ctx context.Context, limit int) ([]*entity.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id int64) error
+	// MarkFailed records a failed attempt and when to try again.
+	MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	// DeadLetter gives up on the event after a final failed attempt.
+	DeadLetter(ctx context.Context, id int64, lastError string) error
+}
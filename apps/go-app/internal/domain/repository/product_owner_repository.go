@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ProductOwnerRepository interface {
+	IsOwner(ctx context.Context, productID, userID int64) (bool, error)
+	List(ctx context.Context, productID int64) ([]*entity.ProductOwner, error)
+	// Add grants ownership and reports whether the user was not an owner yet
+	Add(ctx context.Context, productID, userID int64) (bool, error)
+	Remove(ctx context.Context, productID, userID int64) error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// ProductQuestionFilter selects questions about a product and its variants.
+type ProductQuestionFilter struct {
+	ProductID int64
+	// Status only lists questions with this moderation status
+	Status *string
+	Offset int
+	Limit  int
+}
+
+type ProductQuestionRepository interface {
+	Create(ctx context.Context, question *entity.ProductQuestion) error
+	GetByID(ctx context.Context, id int64) (*entity.ProductQuestion, error)
+	List(ctx context.Context, filter ProductQuestionFilter) ([]*entity.ProductQuestion, error)
+	Update(ctx context.Context, question *entity.ProductQuestion) error
+	SetStatus(ctx context.Context, question *entity.ProductQuestion) error
+	// Delete soft-deletes the question; its answers go with it.
+	Delete(ctx context.Context, id int64) error
+}
+
+// ProductAnswerRepository persists answers and their helpfulness votes,
+// keeping the denormalized counters on the answer in sync with the votes.
+type ProductAnswerRepository interface {
+	Create(ctx context.Context, answer *entity.ProductAnswer) error
+	GetByID(ctx context.Context, id int64) (*entity.ProductAnswer, error)
+	// ListByQuestionIDs returns the answers to the questions, keyed by
+	// question: brand answers first, then the most helpful. A nil status
+	// lists answers of every status.
+	ListByQuestionIDs(ctx context.Context, questionIDs []int64, status *string) (map[int64][]*entity.ProductAnswer, error)
+	Update(ctx context.Context, answer *entity.ProductAnswer) error
+	SetStatus(ctx context.Context, answer *entity.ProductAnswer) error
+	Delete(ctx context.Context, id int64) error
+	UpsertVote(ctx context.Context, vote *entity.ProductAnswerVote) error
+	DeleteVote(ctx context.Context, answerID, userID int64) error
+}
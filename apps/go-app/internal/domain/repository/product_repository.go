@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ProductListOptions struct {
+	Offset          int
+	Limit           int
+	IncludeArchived bool
+	// GroupID restricts the listing to a group's primary product and its variants
+	GroupID *int64
+}
+
+type ProductRepository interface {
+	Create(ctx context.Context, product *entity.Product) error
+	GetByID(ctx context.Context, id int64) (*entity.Product, error)
+	GetBySKU(ctx context.Context, sku string) (*entity.Product, error)
+	List(ctx context.Context, opts ProductListOptions) ([]*entity.Product, error)
+	Update(ctx context.Context, product *entity.Product) error
+	// UpsertBySKU creates the product or refreshes the one with the same SKU,
+	// unarchiving it. The group is left untouched.
+	UpsertBySKU(ctx context.Context, product *entity.Product) error
+	// ArchiveMissing archives every live product whose SKU is not listed and
+	// returns how many were archived.
+	ArchiveMissing(ctx context.Context, skus []string) (int64, error)
+	// HoldReviews switches the product to holding new reviews for moderation.
+	HoldReviews(ctx context.Context, id int64) error
+	// Regroup moves the variants of one group into another.
+	Regroup(ctx context.Context, fromGroupID, toGroupID int64) error
+}
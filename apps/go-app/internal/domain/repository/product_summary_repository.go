@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ProductSummaryRepository interface {
+	// Summarize aggregates the approved reviews of the product and its
+	// variants, along with their ratings of its category's aspects.
+	Summarize(ctx context.Context, product *entity.Product) (*entity.ProductSummary, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type RatingAlertListOptions struct {
+	Offset int
+	Limit  int
+
+	// Filters; nil means no filtering on that attribute
+	Status    *string
+	ProductID *int64
+}
+
+type RatingAlertRepository interface {
+	// WindowStats summarizes, per product with at least minReviews reviews
+	// since windowStart, the reviews since windowStart against those received
+	// between baselineStart and windowStart.
+	WindowStats(ctx context.Context, windowStart, baselineStart time.Time, minReviews int) ([]*entity.RatingWindowStats, error)
+	// Create stores the alert unless the product already has an unresolved
+	// alert of the kind, and reports whether it was stored.
+	Create(ctx context.Context, alert *entity.RatingAlert) (bool, error)
+	GetByID(ctx context.Context, id int64) (*entity.RatingAlert, error)
+	List(ctx context.Context, opts RatingAlertListOptions) ([]*entity.RatingAlert, error)
+	SetStatus(ctx context.Context, alert *entity.RatingAlert) error
+}
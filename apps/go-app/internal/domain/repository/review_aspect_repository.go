@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewAspectRepository interface {
+	// Replace sets the review's aspect ratings.
+	Replace(ctx context.Context, reviewID int64, ratings []*entity.AspectRating) error
+	// ListByReviewIDs returns the aspect ratings of the reviews, keyed by
+	// review ID.
+	ListByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64][]*entity.AspectRating, error)
+}
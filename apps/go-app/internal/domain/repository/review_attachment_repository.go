@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewAttachmentRepository interface {
+	Create(ctx context.Context, attachment *entity.ReviewAttachment) error
+	GetByID(ctx context.Context, reviewID, id int64) (*entity.ReviewAttachment, error)
+	ListByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64][]*entity.ReviewAttachment, error)
+	CountByReviewID(ctx context.Context, reviewID int64) (int, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewChangeRepository interface {
+	// Sequence numbers the committed changes that have none yet. It must run
+	// in a transaction of its own, which it holds the tenant's sequencing
+	// lock for.
+	Sequence(ctx context.Context) (int64, error)
+	// ListAfter returns up to limit sequenced changes following afterSeq.
+	ListAfter(ctx context.Context, afterSeq int64, limit int) ([]*entity.ReviewChange, error)
+}
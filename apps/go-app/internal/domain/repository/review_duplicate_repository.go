@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/valueobject"
+)
+
+type ReviewDuplicateRepository interface {
+	// FindNear returns the reviews written since the given time, other than
+	// reviewID, whose fingerprint is within maxDistance bits of fingerprint.
+	// maxDistance must be below valueobject.SimHashBands.
+	FindNear(ctx context.Context, reviewID int64, fingerprint valueobject.SimHash, maxDistance int, since time.Time) ([]*entity.ReviewDuplicate, error)
+	// Replace sets the originals the review is suspected to copy.
+	Replace(ctx context.Context, reviewID int64, duplicates []*entity.ReviewDuplicate) error
+	ListByReview(ctx context.Context, reviewID int64) ([]*entity.ReviewDuplicate, error)
+}
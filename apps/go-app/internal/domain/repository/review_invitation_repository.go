@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// InvitationStatsFilter narrows conversion statistics to the invitations of
+// a product, or issued in [IssuedFrom, IssuedTo).
+type InvitationStatsFilter struct {
+	ProductID  *int64
+	IssuedFrom *time.Time
+	IssuedTo   *time.Time
+}
+
+type ReviewInvitationRepository interface {
+	// Issue stores the invitation. Issuing again for the same order returns
+	// the existing invitation, its expiry extended unless it was redeemed.
+	Issue(ctx context.Context, invitation *entity.ReviewInvitation) error
+	GetByID(ctx context.Context, id int64) (*entity.ReviewInvitation, error)
+	// Open records the first time the invitation's link was followed.
+	Open(ctx context.Context, id int64) (*entity.ReviewInvitation, error)
+	// Complete redeems the invitation for the review, failing with
+	// ErrInvitationRedeemed or ErrInvitationExpired when it no longer can be.
+	// Call it in the review's transaction.
+	Complete(ctx context.Context, id, reviewID int64) (*entity.ReviewInvitation, error)
+	Stats(ctx context.Context, filter InvitationStatsFilter) (*entity.InvitationStats, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewPolicyRepository interface {
+	// Put creates or replaces the policy of its product or category.
+	Put(ctx context.Context, policy *entity.ReviewPolicy) error
+	GetForProduct(ctx context.Context, productID int64) (*entity.ReviewPolicy, error)
+	GetForCategory(ctx context.Context, categoryID int64) (*entity.ReviewPolicy, error)
+	DeleteForProduct(ctx context.Context, productID int64) error
+	DeleteForCategory(ctx context.Context, categoryID int64) error
+	// ListApplicable returns the policies that apply to reviews of the
+	// product, most specific first: its own, its group's and its category's.
+	ListApplicable(ctx context.Context, product *entity.Product) ([]*entity.ReviewPolicy, error)
+}
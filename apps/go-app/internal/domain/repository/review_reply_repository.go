@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewReplyRepository interface {
+	Create(ctx context.Context, reply *entity.ReviewReply) error
+	GetByReviewID(ctx context.Context, reviewID int64) (*entity.ReviewReply, error)
+	ListByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64]*entity.ReviewReply, error)
+	Update(ctx context.Context, reply *entity.ReviewReply) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewReportRepository interface {
+	// Create fails with ErrReviewAlreadyReported if the reporter has reported
+	// the review before.
+	Create(ctx context.Context, report *entity.ReviewReport) error
+	CountOpen(ctx context.Context, reviewID int64) (int64, error)
+	ListByReview(ctx context.Context, reviewID int64) ([]*entity.ReviewReport, error)
+	// ListReported counts the reports of reported reviews, most open reports
+	// first. With openOnly, reviews without open reports are left out.
+	ListReported(ctx context.Context, openOnly bool, offset, limit int) ([]*entity.ReportedReview, error)
+	// Resolve sets the status of the review's open reports and returns them.
+	Resolve(ctx context.Context, reviewID int64, status string, resolvedBy *int64) ([]*entity.ReviewReport, error)
+}
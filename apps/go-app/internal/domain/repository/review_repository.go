@@ -5,10 +5,54 @@ import (
 	"user-review-ingest/internal/domain/entity"
 )
 
+// Supported orderings for review listings.
+const (
+	// ReviewSortRelevant weighs the helpfulness of reviews and the reputation
+	// of their authors equally
+	ReviewSortRelevant    = "relevant"
+	ReviewSortNewest      = "newest"
+	ReviewSortMostHelpful = "most_helpful"
+	ReviewSortVerified    = "verified"
+)
+
+type ReviewListOptions struct {
+	Offset int
+	Limit  int
+	Sort   string
+
+	// Filters; nil means no filtering on that attribute
+	VerifiedPurchase *bool
+	// ProductID matches reviews of the product and of every variant in its group
+	ProductID *int64
+	Status    *string
+	// SentimentLabel is one of the valueobject.Sentiment labels
+	SentimentLabel    *string
+	SentimentMismatch *bool
+	// Language is an ISO 639 code
+	Language *string
+	// Published selects reviews past (true) or within (false) their
+	// cooling-off period
+	Published *bool
+	// PreferredLanguages orders reviews in these languages first, in the
+	// given order. Ignored by Export.
+	PreferredLanguages []string
+}
+
 type ReviewRepository interface {
 	Create(ctx context.Context, review *entity.Review) error
 	GetByID(ctx context.Context, id int64) (*entity.Review, error)
 	Update(ctx context.Context, review *entity.Review) error
+	// SetStatus stores the review's moderation status.
+	SetStatus(ctx context.Context, review *entity.Review) error
 	Delete(ctx context.Context, id int64) error
-	List(ctx context.Context, offset, limit int) ([]*entity.Review, error)
+	List(ctx context.Context, opts ReviewListOptions) ([]*entity.Review, error)
+	// ListByIDs returns the reviews among ids that exist and are not deleted.
+	ListByIDs(ctx context.Context, ids []int64) ([]*entity.Review, error)
+	// Export calls fn for every review matching the filters of opts, in id
+	// order, reading them through a server-side cursor. Offset, Limit and Sort
+	// are ignored.
+	Export(ctx context.Context, opts ReviewListOptions, fn func(*entity.Review) error) error
+	// PublishDue publishes up to limit reviews whose cooling-off period is
+	// over and returns them.
+	PublishDue(ctx context.Context, limit int) ([]*entity.Review, error)
 }
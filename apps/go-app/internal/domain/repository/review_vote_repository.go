@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// ReviewVoteRepository persists helpfulness votes and keeps the denormalized
+// counters on the voted review in sync with them.
+type ReviewVoteRepository interface {
+	Upsert(ctx context.Context, vote *entity.ReviewVote) error
+	Delete(ctx context.Context, reviewID, userID int64) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type ReviewerRepository interface {
+	// GetProfile returns ErrReviewerNotFound for users who never wrote a review.
+	GetProfile(ctx context.Context, userID int64) (*entity.ReviewerProfile, error)
+}
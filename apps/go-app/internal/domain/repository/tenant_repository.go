@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type TenantRepository interface {
+	List(ctx context.Context) ([]*entity.Tenant, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Tenant, error)
+	// GetByHostname returns the tenant a storefront hostname belongs to.
+	GetByHostname(ctx context.Context, hostname string) (*entity.Tenant, error)
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// TxManager runs a unit of work atomically. Repositories called with the
+// context passed to fn take part in the same transaction.
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type VelocityCounterRepository interface {
+	// Hit counts a review of the subject in the window starting at
+	// windowStart and returns the counts of that window and of the one
+	// starting at previousWindowStart. Counts taken in a transaction are
+	// undone with it.
+	Hit(ctx context.Context, scope, subject string, windowStart, previousWindowStart time.Time) (current, previous int64, err error)
+	// Prune deletes the counts of windows starting before the given time.
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+)
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) error
+	GetByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error)
+	List(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	ListActive(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	Update(ctx context.Context, subscription *entity.WebhookSubscription) error
+	Delete(ctx context.Context, id int64) error
+	// RecordSuccess resets the count of consecutive failed deliveries.
+	RecordSuccess(ctx context.Context, id int64) error
+	// RecordFailure counts a failed delivery and disables the subscription once
+	// disableAfter deliveries in a row have failed.
+	RecordFailure(ctx context.Context, id int64, disableAfter int) (*entity.WebhookSubscription, error)
+}
+
+type WebhookDeliveryListOptions struct {
+	Offset int
+	Limit  int
+	Status *string
+}
+
+// WebhookAttempt is the outcome of sending a delivery once.
+type WebhookAttempt struct {
+	Status         string
+	ResponseStatus *int
+	LastError      *string
+	NextAttemptAt  time.Time
+}
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	GetByID(ctx context.Context, subscriptionID, id int64) (*entity.WebhookDelivery, error)
+	List(ctx context.Context, subscriptionID int64, opts WebhookDeliveryListOptions) ([]*entity.WebhookDelivery, error)
+	// ClaimDue leases up to limit due deliveries of active subscriptions for
+	// lease, hiding them from other dispatchers while they are sent.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*entity.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, id int64, attempt WebhookAttempt) error
+}
@@ -0,0 +1,54 @@
+package valueobject
+
+import (
+	"errors"
+
+	"golang.org/x/text/language"
+)
+
+// maxPreferredLanguages caps how many languages of an Accept-Language header
+// are honored.
+const maxPreferredLanguages = 10
+
+// Locale is a BCP 47 language tag, such as "pt-BR", in canonical form.
+type Locale string
+
+func NewLocale(tag string) (Locale, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", errors.New("locale must be a BCP 47 language tag")
+	}
+	return Locale(parsed.String()), nil
+}
+
+// Language returns the ISO 639 code of the locale's language, "pt" for
+// "pt-BR".
+func (l Locale) Language() string {
+	base, _ := language.Make(string(l)).Base()
+	return base.String()
+}
+
+// PreferredLanguages returns the languages of an Accept-Language header, most
+// preferred first and without repeats. Malformed headers yield none, as
+// clients cannot be expected to send well-formed ones.
+func PreferredLanguages(acceptLanguage string) []string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
+	}
+
+	var languages []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		base, confidence := tag.Base()
+		if confidence == language.No || seen[base.String()] {
+			continue
+		}
+		seen[base.String()] = true
+		languages = append(languages, base.String())
+		if len(languages) == maxPreferredLanguages {
+			break
+		}
+	}
+	return languages
+}
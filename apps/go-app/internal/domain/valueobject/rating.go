@@ -1,16 +1,87 @@
 package valueobject
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"math"
+)
 
-type Rating int
+// maxRatingSteps caps how many distinct values a rating scale may have.
+const maxRatingSteps = 100
 
-func NewRating(value int) (Rating, error) {
-	if value < 1 || value > 5 {
-		return 0, errors.New("rating must be between 1 and 5")
+// Bounds of the common scale every rating is normalized to for aggregation.
+const (
+	normalizedMin = 1
+	normalizedMax = 5
+)
+
+// RatingScale is the range and granularity reviewers rate on, such as 1–5
+// stars, half stars, 0–10 for NPS or 0–1 for thumbs down/up.
+type RatingScale struct {
+	Min  float64
+	Max  float64
+	Step float64
+}
+
+// DefaultRatingScale is the 1–5 star scale used unless a tenant or category
+// defines its own.
+var DefaultRatingScale = RatingScale{Min: 1, Max: 5, Step: 1}
+
+func NewRatingScale(min, max, step float64) (RatingScale, error) {
+	if min >= max {
+		return RatingScale{}, errors.New("rating scale minimum must be below its maximum")
+	}
+	if step <= 0 {
+		return RatingScale{}, errors.New("rating scale step must be positive")
+	}
+	steps := (max - min) / step
+	if !isWhole(steps) {
+		return RatingScale{}, errors.New("rating scale range must be a whole number of steps")
+	}
+	if math.Round(steps) > maxRatingSteps {
+		return RatingScale{}, fmt.Errorf("rating scale must have at most %d steps", maxRatingSteps)
+	}
+	return RatingScale{Min: min, Max: max, Step: step}, nil
+}
+
+// Rate returns the rating of value on the scale, which must lie within the
+// scale and on one of its steps.
+func (s RatingScale) Rate(value float64) (Rating, error) {
+	if value < s.Min || value > s.Max || !isWhole((value-s.Min)/s.Step) {
+		return Rating{}, fmt.Errorf("rating must be between %g and %g in steps of %g", s.Min, s.Max, s.Step)
 	}
-	return Rating(value), nil
+	return Rating{value: value, scale: s}, nil
 }
 
+// Rating is a value on a rating scale.
+type Rating struct {
+	value float64
+	scale RatingScale
+}
+
+// Value returns the rating on its own scale.
+func (r Rating) Value() float64 {
+	return r.value
+}
+
+func (r Rating) Scale() RatingScale {
+	return r.scale
+}
+
+// Normalized returns the rating mapped linearly onto the common 1–5 scale, so
+// ratings on different scales can be aggregated.
+func (r Rating) Normalized() float64 {
+	position := (r.value - r.scale.Min) / (r.scale.Max - r.scale.Min)
+	return normalizedMin + position*(normalizedMax-normalizedMin)
+}
+
+// Int returns the normalized rating rounded to whole stars.
 func (r Rating) Int() int {
-	return int(r)
+	return int(math.Round(r.Normalized()))
+}
+
+// isWhole reports whether f is an integer, allowing for the rounding error of
+// fractional steps such as 0.1.
+func isWhole(f float64) bool {
+	return math.Abs(f-math.Round(f)) < 1e-9
 }
@@ -0,0 +1,56 @@
+package valueobject
+
+// Sentiment labels of a text.
+const (
+	SentimentPositive = "positive"
+	SentimentNeutral  = "neutral"
+	SentimentNegative = "negative"
+)
+
+const (
+	// sentimentNeutralBand is how far from zero a score must be to be labeled
+	// positive or negative.
+	sentimentNeutralBand = 0.05
+	// sentimentMismatchScore is how strongly a text must lean the other way
+	// to contradict a star rating.
+	sentimentMismatchScore = 0.5
+)
+
+// Sentiment is how positive a text reads, scored from -1 (very negative) to
+// 1 (very positive).
+type Sentiment struct {
+	Score float64
+	Label string
+}
+
+// NewSentiment labels the score, clamped to [-1, 1].
+func NewSentiment(score float64) Sentiment {
+	switch {
+	case score > 1:
+		score = 1
+	case score < -1:
+		score = -1
+	}
+
+	label := SentimentNeutral
+	switch {
+	case score >= sentimentNeutralBand:
+		label = SentimentPositive
+	case score <= -sentimentNeutralBand:
+		label = SentimentNegative
+	}
+	return Sentiment{Score: score, Label: label}
+}
+
+// Contradicts reports whether the text clearly disagrees with the rating:
+// glowing text with one or two stars, or scathing text with four or five,
+// once the rating is normalized.
+func (s Sentiment) Contradicts(rating Rating) bool {
+	switch {
+	case rating.Normalized() <= 2:
+		return s.Score >= sentimentMismatchScore
+	case rating.Normalized() >= 4:
+		return s.Score <= -sentimentMismatchScore
+	}
+	return false
+}
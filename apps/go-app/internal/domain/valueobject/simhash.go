@@ -0,0 +1,73 @@
+package valueobject
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// shingleSize is how many consecutive words make up a feature of the text.
+const shingleSize = 3
+
+// SimHashBands is how many 16-bit bands a SimHash is split into for lookup.
+// Fingerprints within SimHashBands-1 bits of each other share at least one
+// band exactly.
+const SimHashBands = 4
+
+// SimHash is a 64-bit locality-sensitive fingerprint of a text: texts that
+// differ in a few words have fingerprints that differ in a few bits.
+type SimHash uint64
+
+// NewSimHash fingerprints text from its overlapping word shingles, ignoring
+// case and punctuation. Texts shorter than minWords words are too short to be
+// told apart from coincidence and get no fingerprint.
+func NewSimHash(text string, minWords int) (SimHash, bool) {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) < minWords || len(words) == 0 {
+		return 0, false
+	}
+
+	var weights [64]int
+	for i := 0; i+shingleSize <= len(words) || i == 0; i++ {
+		end := i + shingleSize
+		if end > len(words) {
+			end = len(words)
+		}
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:end], " ")))
+		feature := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if feature&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fingerprint SimHash
+	for bit, weight := range weights {
+		if weight > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+	return fingerprint, true
+}
+
+// Distance returns how many bits the fingerprints differ in.
+func (s SimHash) Distance(other SimHash) int {
+	return bits.OnesCount64(uint64(s ^ other))
+}
+
+// Similarity returns the share of bits the fingerprints agree on, from 0 to 1.
+func (s SimHash) Similarity(other SimHash) float64 {
+	return 1 - float64(s.Distance(other))/64
+}
+
+// Band returns the i-th 16-bit band of the fingerprint, most significant first.
+func (s SimHash) Band(i int) int {
+	return int(uint64(s) >> (16 * (SimHashBands - 1 - i)) & 0xffff)
+}
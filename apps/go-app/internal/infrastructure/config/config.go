@@ -11,6 +11,98 @@ type Config struct {
 	Port        int    `env:"PORT" default:"8080"`
 	DatabaseURL string `env:"DATABASE_URL" required:"true"`
 	JWTSecret   string `env:"JWT_SECRET" required:"true"`
+
+	// Tenant used when a request names none; empty requires every request to name one
+	DefaultTenant string `env:"DEFAULT_TENANT"`
+
+	// Comma-separated addresses or CIDRs of reverse proxies whose
+	// X-Forwarded-For is believed; empty takes client addresses from the connection
+	TrustedProxies string `env:"TRUSTED_PROXIES"`
+
+	// Blob storage for review media: "local" or "s3"
+	BlobStore         string `env:"BLOB_STORE" default:"local"`
+	BlobLocalDir      string `env:"BLOB_LOCAL_DIR" default:"./data/blobs"`
+	BlobPublicBaseURL string `env:"BLOB_PUBLIC_BASE_URL"`
+	S3Endpoint        string `env:"S3_ENDPOINT"`
+	S3Region          string `env:"S3_REGION" default:"us-east-1"`
+	S3Bucket          string `env:"S3_BUCKET"`
+	S3AccessKeyID     string `env:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `env:"S3_SECRET_ACCESS_KEY"`
+	S3UsePathStyle    bool   `env:"S3_USE_PATH_STYLE" default:"true"`
+
+	MediaMaxUploadBytes int `env:"MEDIA_MAX_UPLOAD_BYTES" default:"10485760"`
+
+	// Domain event publishing: "log" or "memory"
+	EventPublisher       string `env:"EVENT_PUBLISHER" default:"log"`
+	OutboxPollIntervalMs int    `env:"OUTBOX_POLL_INTERVAL_MS" default:"1000"`
+	OutboxBatchSize      int    `env:"OUTBOX_BATCH_SIZE" default:"100"`
+	OutboxMaxAttempts    int    `env:"OUTBOX_MAX_ATTEMPTS" default:"10"`
+
+	// Outbound webhooks. Endpoints on loopback and private networks are refused
+	// unless WEBHOOK_ALLOW_PRIVATE_NETWORKS is set, e.g. for local receivers.
+	WebhookTimeoutMs            int  `env:"WEBHOOK_TIMEOUT_MS" default:"10000"`
+	WebhookPollIntervalMs       int  `env:"WEBHOOK_POLL_INTERVAL_MS" default:"1000"`
+	WebhookBatchSize            int  `env:"WEBHOOK_BATCH_SIZE" default:"20"`
+	WebhookMaxAttempts          int  `env:"WEBHOOK_MAX_ATTEMPTS" default:"12"`
+	WebhookDisableAfter         int  `env:"WEBHOOK_DISABLE_AFTER" default:"50"`
+	WebhookAllowPrivateNetworks bool `env:"WEBHOOK_ALLOW_PRIVATE_NETWORKS" default:"false"`
+
+	// Review velocity limits, per author and per reviewed product. A limit of 0
+	// disables it; the action is "hold" (queue for moderation) or "reject".
+	ReviewUserLimit            int    `env:"REVIEW_USER_LIMIT" default:"10"`
+	ReviewUserWindowSeconds    int    `env:"REVIEW_USER_WINDOW_SECONDS" default:"3600"`
+	ReviewUserLimitAction      string `env:"REVIEW_USER_LIMIT_ACTION" default:"reject"`
+	ReviewProductLimit         int    `env:"REVIEW_PRODUCT_LIMIT" default:"100"`
+	ReviewProductWindowSeconds int    `env:"REVIEW_PRODUCT_WINDOW_SECONDS" default:"600"`
+	ReviewProductLimitAction   string `env:"REVIEW_PRODUCT_LIMIT_ACTION" default:"hold"`
+
+	// Rating anomaly detection: every interval, each product's reviews in the
+	// last window are compared with its baseline. Abnormal activity scores at
+	// least the threshold, in standard errors. An interval of 0 disables it.
+	RatingAnomalyIntervalSeconds int  `env:"RATING_ANOMALY_INTERVAL_SECONDS" default:"300"`
+	RatingAnomalyWindowSeconds   int  `env:"RATING_ANOMALY_WINDOW_SECONDS" default:"3600"`
+	RatingAnomalyBaselineDays    int  `env:"RATING_ANOMALY_BASELINE_DAYS" default:"30"`
+	RatingAnomalyMinReviews      int  `env:"RATING_ANOMALY_MIN_REVIEWS" default:"10"`
+	RatingAnomalyThreshold       int  `env:"RATING_ANOMALY_THRESHOLD" default:"4"`
+	RatingAnomalyHoldProducts    bool `env:"RATING_ANOMALY_HOLD_PRODUCTS" default:"false"`
+
+	// Near-duplicate detection: comments of at least the minimum words are
+	// compared with reviews from the lookback period, and held when their
+	// fingerprints differ in at most the max distance bits (0 to 3). A
+	// lookback of 0 disables it.
+	DuplicateMinWords     int `env:"DUPLICATE_MIN_WORDS" default:"8"`
+	DuplicateMaxDistance  int `env:"DUPLICATE_MAX_DISTANCE" default:"3"`
+	DuplicateLookbackDays int `env:"DUPLICATE_LOOKBACK_DAYS" default:"30"`
+
+	// Open abuse reports that send an approved review back to moderation; 0
+	// disables it
+	ReviewReportThreshold int `env:"REVIEW_REPORT_THRESHOLD" default:"3"`
+
+	// New reviews by authors with at least the minimum reviews and a
+	// reputation (0 to 100) below the threshold are held for moderation; a
+	// threshold of 0 disables it
+	ReviewerHoldBelowReputation int `env:"REVIEWER_HOLD_BELOW_REPUTATION" default:"0"`
+	ReviewerHoldMinReviews      int `env:"REVIEWER_HOLD_MIN_REVIEWS" default:"5"`
+
+	// How often reviews whose tenant's cooling-off period is over are
+	// published; 0 disables publishing
+	ReviewPublishIntervalSeconds int `env:"REVIEW_PUBLISH_INTERVAL_SECONDS" default:"60"`
+
+	// How often the background worker looks for queued review exports
+	ExportPollIntervalMs int `env:"EXPORT_POLL_INTERVAL_MS" default:"5000"`
+	// Where queued exports are kept; unlike BLOB_LOCAL_DIR and S3_BUCKET
+	// neither is served publicly. The bucket is required with BLOB_STORE=s3
+	// and shares the S3_* endpoint and credentials.
+	ExportLocalDir string `env:"EXPORT_LOCAL_DIR" default:"./data/exports"`
+	ExportS3Bucket string `env:"EXPORT_S3_BUCKET"`
+
+	// Shared secret for signing order webhooks; the webhook is disabled when empty
+	OrderWebhookSecret string `env:"ORDER_WEBHOOK_SECRET"`
+
+	// Key for signing review invitation links, and how long they stay valid;
+	// invitations are disabled when the key is empty
+	ReviewInvitationSecret   string `env:"REVIEW_INVITATION_SECRET"`
+	ReviewInvitationTTLHours int    `env:"REVIEW_INVITATION_TTL_HOURS" default:"720"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -49,6 +141,12 @@ func LoadConfig() (*Config, error) {
 					return nil, fmt.Errorf("invalid value for %s: %v", key, err)
 				}
 				val.Field(i).SetInt(int64(n))
+			case reflect.Bool:
+				b, err := strconv.ParseBool(raw)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %s: %v", key, err)
+				}
+				val.Field(i).SetBool(b)
 			}
 		}
 	}
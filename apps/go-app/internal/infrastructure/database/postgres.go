@@ -2,12 +2,21 @@ package database
 
 import (
 	"context"
+	"strconv"
+	"user-review-ingest/internal/domain/entity"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 func NewPostgresConnection(databaseURL string) (*pgxpool.Pool, error) {
-	pool, err := pgxpool.New(context.Background(), databaseURL)
+	config, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	config.PrepareConn = bindTenant
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		return nil, err
 	}
@@ -18,3 +27,20 @@ func NewPostgresConnection(databaseURL string) (*pgxpool.Pool, error) {
 
 	return pool, nil
 }
+
+// bindTenant scopes the session to the tenant of the acquiring context before
+// the pool hands a connection out, clearing whatever tenant it served before.
+// Row-level security policies read it back through app_current_tenant(), so a
+// connection acquired without a tenant sees no tenant-owned rows at all.
+func bindTenant(ctx context.Context, conn *pgx.Conn) (bool, error) {
+	var tenantID string
+	if tenant, ok := entity.TenantFromContext(ctx); ok {
+		tenantID = strconv.FormatInt(tenant.ID, 10)
+	}
+
+	if _, err := conn.Exec(ctx, "SELECT set_config('app.tenant_id', $1, false)", tenantID); err != nil {
+		// Never reuse a connection whose tenant binding is unknown
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,398 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Parquet physical types, converted types, encodings and codecs used here.
+const (
+	parquetBoolean   = 0
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	convertedNone            = -1
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	codecUncompressed = 0
+	codecGzip         = 2
+
+	pageTypeData = 0
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+)
+
+var parquetMagic = []byte("PAR1")
+
+// parquetRowGroupRows bounds how many rows are buffered before a row group is
+// written out, which bounds the writer's memory.
+const parquetRowGroupRows = 10000
+
+// ParquetColumn describes one flat column of a Parquet file.
+type ParquetColumn struct {
+	Name string
+	// Type is one of the parquet* physical types
+	Type      int32
+	Converted int32
+	Optional  bool
+}
+
+type columnBuffer struct {
+	values    bytes.Buffer
+	bools     []bool
+	defLevels []bool
+}
+
+// ParquetWriter writes rows of a flat schema as a Parquet file: one PLAIN
+// encoded data page per column and row group, optionally GZIP compressed.
+// It writes to w as row groups fill up, so only one row group is held in
+// memory.
+type ParquetWriter struct {
+	w        *countingWriter
+	columns  []ParquetColumn
+	codec    int32
+	buffers  []columnBuffer
+	rows     int
+	total    int64
+	groups   []rowGroupMeta
+	started  bool
+	closed   bool
+	createBy string
+}
+
+type columnChunkMeta struct {
+	offset            int64
+	uncompressedSize  int64
+	compressedSize    int64
+	numValues         int64
+	dataPageOffset    int64
+	columnIndexInFile int
+}
+
+type rowGroupMeta struct {
+	columns  []columnChunkMeta
+	byteSize int64
+	numRows  int64
+}
+
+func NewParquetWriter(w io.Writer, columns []ParquetColumn, compress bool) *ParquetWriter {
+	codec := int32(codecUncompressed)
+	if compress {
+		codec = codecGzip
+	}
+	return &ParquetWriter{
+		w:        &countingWriter{w: w},
+		columns:  columns,
+		codec:    codec,
+		buffers:  make([]columnBuffer, len(columns)),
+		createBy: "user-review-ingest",
+	}
+}
+
+// WriteRow appends a row. Values are given in column order: int32, int64,
+// float64, bool, string or time.Time (for timestamp columns), or nil for a
+// null in an optional column.
+func (p *ParquetWriter) WriteRow(values []any) error {
+	if len(values) != len(p.columns) {
+		return fmt.Errorf("parquet row has %d values, schema has %d columns", len(values), len(p.columns))
+	}
+
+	for i, value := range values {
+		column := p.columns[i]
+		buffer := &p.buffers[i]
+
+		if value == nil {
+			if !column.Optional {
+				return fmt.Errorf("parquet column %s is required", column.Name)
+			}
+			buffer.defLevels = append(buffer.defLevels, false)
+			continue
+		}
+		if column.Optional {
+			buffer.defLevels = append(buffer.defLevels, true)
+		}
+
+		if err := appendPlain(buffer, column, value); err != nil {
+			return err
+		}
+	}
+
+	p.rows++
+	if p.rows >= parquetRowGroupRows {
+		return p.flushRowGroup()
+	}
+	return nil
+}
+
+func appendPlain(buffer *columnBuffer, column ParquetColumn, value any) error {
+	var scratch [8]byte
+	switch v := value.(type) {
+	case int32:
+		if column.Type != parquetInt32 {
+			break
+		}
+		binary.LittleEndian.PutUint32(scratch[:4], uint32(v))
+		buffer.values.Write(scratch[:4])
+		return nil
+	case int64:
+		if column.Type != parquetInt64 {
+			break
+		}
+		binary.LittleEndian.PutUint64(scratch[:], uint64(v))
+		buffer.values.Write(scratch[:])
+		return nil
+	case time.Time:
+		if column.Type != parquetInt64 || column.Converted != convertedTimestampMillis {
+			break
+		}
+		binary.LittleEndian.PutUint64(scratch[:], uint64(v.UnixMilli()))
+		buffer.values.Write(scratch[:])
+		return nil
+	case float64:
+		if column.Type != parquetDouble {
+			break
+		}
+		binary.LittleEndian.PutUint64(scratch[:], math.Float64bits(v))
+		buffer.values.Write(scratch[:])
+		return nil
+	case bool:
+		if column.Type != parquetBoolean {
+			break
+		}
+		buffer.bools = append(buffer.bools, v)
+		return nil
+	case string:
+		if column.Type != parquetByteArray {
+			break
+		}
+		binary.LittleEndian.PutUint32(scratch[:4], uint32(len(v)))
+		buffer.values.Write(scratch[:4])
+		buffer.values.WriteString(v)
+		return nil
+	}
+	return fmt.Errorf("parquet column %s cannot hold %T", column.Name, value)
+}
+
+// Close writes the buffered rows and the file footer. It does not close the
+// underlying writer.
+func (p *ParquetWriter) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+
+	if err := p.start(); err != nil {
+		return err
+	}
+	if p.rows > 0 {
+		if err := p.flushRowGroup(); err != nil {
+			return err
+		}
+	}
+
+	footer := p.fileMetadata()
+	if _, err := p.w.Write(footer); err != nil {
+		return err
+	}
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer)))
+	if _, err := p.w.Write(length[:]); err != nil {
+		return err
+	}
+	_, err := p.w.Write(parquetMagic)
+	return err
+}
+
+func (p *ParquetWriter) start() error {
+	if p.started {
+		return nil
+	}
+	p.started = true
+	_, err := p.w.Write(parquetMagic)
+	return err
+}
+
+func (p *ParquetWriter) flushRowGroup() error {
+	if err := p.start(); err != nil {
+		return err
+	}
+
+	group := rowGroupMeta{numRows: int64(p.rows)}
+	for i := range p.columns {
+		chunk, err := p.writeColumnChunk(i)
+		if err != nil {
+			return err
+		}
+		group.columns = append(group.columns, chunk)
+		group.byteSize += chunk.uncompressedSize
+		p.buffers[i] = columnBuffer{}
+	}
+
+	p.groups = append(p.groups, group)
+	p.total += int64(p.rows)
+	p.rows = 0
+	return nil
+}
+
+func (p *ParquetWriter) writeColumnChunk(i int) (columnChunkMeta, error) {
+	column := p.columns[i]
+	buffer := &p.buffers[i]
+
+	var body bytes.Buffer
+	if column.Optional {
+		levels := encodeBitPackedHybrid(buffer.defLevels)
+		var length [4]byte
+		binary.LittleEndian.PutUint32(length[:], uint32(len(levels)))
+		body.Write(length[:])
+		body.Write(levels)
+	}
+	if column.Type == parquetBoolean {
+		body.Write(packBools(buffer.bools))
+	} else {
+		body.Write(buffer.values.Bytes())
+	}
+
+	uncompressedSize := body.Len()
+	page := body.Bytes()
+	if p.codec == codecGzip {
+		var compressed bytes.Buffer
+		zw := gzip.NewWriter(&compressed)
+		if _, err := zw.Write(page); err != nil {
+			return columnChunkMeta{}, err
+		}
+		if err := zw.Close(); err != nil {
+			return columnChunkMeta{}, err
+		}
+		page = compressed.Bytes()
+	}
+
+	header := newThriftWriter()
+	header.i32Field(1, pageTypeData)
+	header.i32Field(2, int32(uncompressedSize))
+	header.i32Field(3, int32(len(page)))
+	header.structField(5)
+	header.i32Field(1, int32(p.rows))
+	header.i32Field(2, encodingPlain)
+	header.i32Field(3, encodingRLE)
+	header.i32Field(4, encodingRLE)
+	header.endStruct()
+	header.endStruct()
+
+	offset := p.w.n
+	if _, err := p.w.Write(header.bytes()); err != nil {
+		return columnChunkMeta{}, err
+	}
+	if _, err := p.w.Write(page); err != nil {
+		return columnChunkMeta{}, err
+	}
+
+	return columnChunkMeta{
+		offset:            offset,
+		uncompressedSize:  int64(len(header.bytes()) + uncompressedSize),
+		compressedSize:    int64(len(header.bytes()) + len(page)),
+		numValues:         int64(p.rows),
+		dataPageOffset:    offset,
+		columnIndexInFile: i,
+	}, nil
+}
+
+func (p *ParquetWriter) fileMetadata() []byte {
+	t := newThriftWriter()
+	t.i32Field(1, 1)
+
+	t.listField(2, thriftStruct, len(p.columns)+1)
+	t.beginStruct()
+	t.stringField(4, "schema")
+	t.i32Field(5, int32(len(p.columns)))
+	t.endStruct()
+	for _, column := range p.columns {
+		t.beginStruct()
+		t.i32Field(1, column.Type)
+		repetition := int32(repetitionRequired)
+		if column.Optional {
+			repetition = repetitionOptional
+		}
+		t.i32Field(3, repetition)
+		t.stringField(4, column.Name)
+		if column.Converted != convertedNone {
+			t.i32Field(6, column.Converted)
+		}
+		t.endStruct()
+	}
+
+	t.i64Field(3, p.total)
+
+	t.listField(4, thriftStruct, len(p.groups))
+	for _, group := range p.groups {
+		t.beginStruct()
+		t.listField(1, thriftStruct, len(group.columns))
+		for _, chunk := range group.columns {
+			column := p.columns[chunk.columnIndexInFile]
+			t.beginStruct()
+			t.i64Field(2, chunk.offset)
+			t.structField(3)
+			t.i32Field(1, column.Type)
+			t.listField(2, thriftI32, 2)
+			t.i32Elem(encodingPlain)
+			t.i32Elem(encodingRLE)
+			t.listField(3, thriftBinary, 1)
+			t.stringElem(column.Name)
+			t.i32Field(4, p.codec)
+			t.i64Field(5, chunk.numValues)
+			t.i64Field(6, chunk.uncompressedSize)
+			t.i64Field(7, chunk.compressedSize)
+			t.i64Field(9, chunk.dataPageOffset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64Field(2, group.byteSize)
+		t.i64Field(3, group.numRows)
+		t.endStruct()
+	}
+
+	t.stringField(6, p.createBy)
+	t.endStruct()
+	return t.bytes()
+}
+
+// encodeBitPackedHybrid encodes 1-bit levels as a single bit-packed run of the
+// RLE/bit-packing hybrid encoding.
+func encodeBitPackedHybrid(levels []bool) []byte {
+	groups := (len(levels) + 7) / 8
+	out := binary.AppendUvarint(nil, uint64(groups)<<1|1)
+	return append(out, packBools(levels)...)
+}
+
+// packBools packs values one bit each, least significant bit first.
+func packBools(values []bool) []byte {
+	packed := make([]byte, (len(values)+7)/8)
+	for i, v := range values {
+		if v {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	return packed
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
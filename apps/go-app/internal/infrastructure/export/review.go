@@ -0,0 +1,227 @@
+package export
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+	domainerrors "user-review-ingest/internal/domain/errors"
+)
+
+// Export formats.
+const (
+	FormatCSV     = "csv"
+	FormatNDJSON  = "ndjson"
+	FormatParquet = "parquet"
+)
+
+// ReviewRow is one exported review.
+type ReviewRow struct {
+	ID               int64     `json:"id"`
+	UserID           int64     `json:"user_id"`
+	ProductID        int64     `json:"product_id"`
+	Rating           float64   `json:"rating"`
+	RatingScaleMin   float64   `json:"rating_scale_min"`
+	RatingScaleMax   float64   `json:"rating_scale_max"`
+	NormalizedRating float64   `json:"normalized_rating"`
+	Comment          string    `json:"comment"`
+	Status           string    `json:"status"`
+	HelpfulCount     int       `json:"helpful_count"`
+	UnhelpfulCount   int       `json:"unhelpful_count"`
+	VerifiedPurchase bool      `json:"verified_purchase"`
+	OrderID          *int64    `json:"order_id"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+var reviewColumns = []ParquetColumn{
+	{Name: "id", Type: parquetInt64, Converted: convertedNone},
+	{Name: "user_id", Type: parquetInt64, Converted: convertedNone},
+	{Name: "product_id", Type: parquetInt64, Converted: convertedNone},
+	{Name: "rating", Type: parquetDouble, Converted: convertedNone},
+	{Name: "rating_scale_min", Type: parquetDouble, Converted: convertedNone},
+	{Name: "rating_scale_max", Type: parquetDouble, Converted: convertedNone},
+	{Name: "normalized_rating", Type: parquetDouble, Converted: convertedNone},
+	{Name: "comment", Type: parquetByteArray, Converted: convertedUTF8},
+	{Name: "status", Type: parquetByteArray, Converted: convertedUTF8},
+	{Name: "helpful_count", Type: parquetInt32, Converted: convertedNone},
+	{Name: "unhelpful_count", Type: parquetInt32, Converted: convertedNone},
+	{Name: "verified_purchase", Type: parquetBoolean, Converted: convertedNone},
+	{Name: "order_id", Type: parquetInt64, Converted: convertedNone, Optional: true},
+	{Name: "created_at", Type: parquetInt64, Converted: convertedTimestampMillis},
+	{Name: "updated_at", Type: parquetInt64, Converted: convertedTimestampMillis},
+}
+
+// ReviewWriter encodes exported reviews in one format.
+type ReviewWriter interface {
+	Write(row *ReviewRow) error
+	// Close flushes buffered output; the underlying writer stays open.
+	Close() error
+}
+
+// NewReviewWriter returns a writer of the format onto w. With compress, CSV
+// and NDJSON are gzipped as a whole while Parquet compresses its pages.
+func NewReviewWriter(format string, w io.Writer, compress bool) (ReviewWriter, error) {
+	switch format {
+	case FormatCSV, FormatNDJSON:
+		var zw *gzip.Writer
+		if compress {
+			zw = gzip.NewWriter(w)
+			w = zw
+		}
+		if format == FormatCSV {
+			return &csvReviewWriter{w: csv.NewWriter(w), gzip: zw}, nil
+		}
+		return &ndjsonReviewWriter{enc: json.NewEncoder(w), gzip: zw}, nil
+	case FormatParquet:
+		return &parquetReviewWriter{w: NewParquetWriter(w, reviewColumns, compress)}, nil
+	default:
+		return nil, domainerrors.ErrUnknownExportFormat
+	}
+}
+
+// ValidFormat reports whether format is a supported export format.
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatNDJSON || format == FormatParquet
+}
+
+// ContentType returns the media type of an export, as served.
+func ContentType(format string, compress bool) string {
+	switch {
+	case format == FormatParquet:
+		return "application/vnd.apache.parquet"
+	case compress:
+		return "application/gzip"
+	case format == FormatCSV:
+		return "text/csv; charset=utf-8"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// FileName returns the file name of an export of base.
+func FileName(base, format string, compress bool) string {
+	name := base + "." + format
+	if compress && format != FormatParquet {
+		name += ".gz"
+	}
+	return name
+}
+
+type csvReviewWriter struct {
+	w         *csv.Writer
+	gzip      *gzip.Writer
+	wroteHead bool
+}
+
+func (c *csvReviewWriter) writeHeader() error {
+	if c.wroteHead {
+		return nil
+	}
+	c.wroteHead = true
+
+	header := make([]string, 0, len(reviewColumns))
+	for _, column := range reviewColumns {
+		header = append(header, column.Name)
+	}
+	return c.w.Write(header)
+}
+
+func (c *csvReviewWriter) Write(row *ReviewRow) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	orderID := ""
+	if row.OrderID != nil {
+		orderID = strconv.FormatInt(*row.OrderID, 10)
+	}
+	return c.w.Write([]string{
+		strconv.FormatInt(row.ID, 10),
+		strconv.FormatInt(row.UserID, 10),
+		strconv.FormatInt(row.ProductID, 10),
+		formatFloat(row.Rating),
+		formatFloat(row.RatingScaleMin),
+		formatFloat(row.RatingScaleMax),
+		formatFloat(row.NormalizedRating),
+		row.Comment,
+		row.Status,
+		strconv.Itoa(row.HelpfulCount),
+		strconv.Itoa(row.UnhelpfulCount),
+		strconv.FormatBool(row.VerifiedPurchase),
+		orderID,
+		row.CreatedAt.UTC().Format(time.RFC3339),
+		row.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (c *csvReviewWriter) Close() error {
+	// An empty export still names its columns
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return err
+	}
+	if c.gzip != nil {
+		return c.gzip.Close()
+	}
+	return nil
+}
+
+type ndjsonReviewWriter struct {
+	enc  *json.Encoder
+	gzip *gzip.Writer
+}
+
+func (n *ndjsonReviewWriter) Write(row *ReviewRow) error {
+	return n.enc.Encode(row)
+}
+
+func (n *ndjsonReviewWriter) Close() error {
+	if n.gzip != nil {
+		return n.gzip.Close()
+	}
+	return nil
+}
+
+type parquetReviewWriter struct {
+	w *ParquetWriter
+}
+
+func (p *parquetReviewWriter) Write(row *ReviewRow) error {
+	var orderID any
+	if row.OrderID != nil {
+		orderID = *row.OrderID
+	}
+	return p.w.WriteRow([]any{
+		row.ID,
+		row.UserID,
+		row.ProductID,
+		row.Rating,
+		row.RatingScaleMin,
+		row.RatingScaleMax,
+		row.NormalizedRating,
+		row.Comment,
+		row.Status,
+		int32(row.HelpfulCount),
+		int32(row.UnhelpfulCount),
+		row.VerifiedPurchase,
+		orderID,
+		row.CreatedAt,
+		row.UpdatedAt,
+	})
+}
+
+func (p *parquetReviewWriter) Close() error {
+	return p.w.Close()
+}
+
+// formatFloat formats f in as few digits as represent it exactly, so whole
+// ratings export as "4" rather than "4.0".
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	categoryUseCase interfaces.CategoryUseCase
+}
+
+func NewCategoryHandler(categoryUseCase interfaces.CategoryUseCase) *CategoryHandler {
+	return &CategoryHandler{
+		categoryUseCase: categoryUseCase,
+	}
+}
+
+// @Summary Create a category
+// @Description Create a product category with the aspects its reviewers rate besides the overall rating, such as "fit" for apparel. Requires the admin role.
+// @Tags categories
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param category body dto.CategoryInputDTO true "Category"
+// @Success 201 {object} dto.CategoryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /v1/categories [post]
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	var categoryDTO dto.CategoryInputDTO
+	if err := c.ShouldBindJSON(&categoryDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	category, err := h.categoryUseCase.Create(c.Request.Context(), categoryDTO)
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, category)
+}
+
+// @Summary Get a category by ID
+// @Description Get a product category with its aspects. Requires the admin role.
+// @Tags categories
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Success 200 {object} dto.CategoryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/categories/{id} [get]
+func (h *CategoryHandler) GetCategory(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category ID"})
+		return
+	}
+
+	category, err := h.categoryUseCase.Retrieve(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// @Summary Update a category
+// @Description Replace a category's attributes and aspects. Aspects are matched by key: ratings of kept aspects are preserved, those of removed aspects are deleted. Requires the admin role.
+// @Tags categories
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Param category body dto.CategoryInputDTO true "Category"
+// @Success 200 {object} dto.CategoryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /v1/categories/{id} [put]
+func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category ID"})
+		return
+	}
+
+	var categoryDTO dto.CategoryInputDTO
+	if err := c.ShouldBindJSON(&categoryDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	category, err := h.categoryUseCase.Update(c.Request.Context(), id, categoryDTO)
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// @Summary Delete a category
+// @Description Delete a category with its aspects and every rating of them. Its products are left without a category. Requires the admin role.
+// @Tags categories
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category ID"})
+		return
+	}
+
+	if err := h.categoryUseCase.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List categories
+// @Description List product categories by slug, without their aspects. Requires the admin role.
+// @Tags categories
+// @Produce  json
+// @Security BearerAuth
+// @Param offset query int false "Offset" minimum(0)
+// @Param limit query int false "Limit" default(50) minimum(1) maximum(100)
+// @Success 200 {array} dto.CategoryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/categories [get]
+func (h *CategoryHandler) ListCategories(c *gin.Context) {
+	var query dto.ListCategoriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	categories, err := h.categoryUseCase.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+func categoryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrCategoryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrCategoryExists):
+		return http.StatusConflict
+	case errors.Is(err, domainerrors.ErrInvalidRatingScale):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
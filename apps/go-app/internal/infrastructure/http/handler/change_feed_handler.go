@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChangeFeedHandler struct {
+	changeFeed interfaces.ChangeFeed
+}
+
+func NewChangeFeedHandler(changeFeed interfaces.ChangeFeed) *ChangeFeedHandler {
+	return &ChangeFeedHandler{
+		changeFeed: changeFeed,
+	}
+}
+
+// @Summary List review changes
+// @Description Ordered, resumable feed of review inserts, updates and deletes for incremental sync. Pass the next_token of the previous page as since; omit it to start from the beginning. Insert and update entries carry the review as it is now, so clients should apply them as upserts. Delete entries are tombstones for reviews that were deleted or are no longer visible to the caller; only approved reviews are visible unless the caller is an admin. With wait, an empty page is only returned after waiting that many seconds for a change. Requires authentication.
+// @Tags changes
+// @Produce  json
+// @Security BearerAuth
+// @Param since query string false "next_token of the previous page"
+// @Param limit query int false "Maximum number of changes" default(100) minimum(1) maximum(1000)
+// @Param wait query int false "Seconds to wait for changes when there are none (long-polling), at most 60" default(0)
+// @Success 200 {object} dto.ChangesPageDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /v1/changes [get]
+func (h *ChangeFeedHandler) ListChanges(c *gin.Context) {
+	var query dto.ListChangesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := h.changeFeed.List(c.Request.Context(), query)
+	if err != nil {
+		if c.Request.Context().Err() != nil {
+			// The client went away while waiting
+			return
+		}
+		c.JSON(changeFeedErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, page)
+}
+
+func changeFeedErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidChangeToken):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DataSubjectHandler struct {
+	dataSubjectUseCase interfaces.DataSubjectUseCase
+}
+
+func NewDataSubjectHandler(dataSubjectUseCase interfaces.DataSubjectUseCase) *DataSubjectHandler {
+	return &DataSubjectHandler{
+		dataSubjectUseCase: dataSubjectUseCase,
+	}
+}
+
+// @Summary Export my data
+// @Description Download everything stored about the calling user as a JSON archive: reviews (including deleted ones), votes, replies, attachments, orders, product ownerships and export jobs. The export is recorded. Requires authentication.
+// @Tags data-subjects
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.DataSubjectArchiveDTO
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /v1/me/data-export [get]
+func (h *DataSubjectHandler) ExportSelf(c *gin.Context) {
+	archive, request, err := h.dataSubjectUseCase.ExportSelf(c.Request.Context())
+	if err != nil {
+		c.JSON(dataSubjectErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	sendArchive(c, archive, request)
+}
+
+// @Summary Export a user's data
+// @Description Download everything stored about a user as a JSON archive. With account_id, the auth account, its profiles and its OAuth connections (without tokens) are included. The export is recorded along with the archive's SHA-256, sent in the X-Archive-SHA256 header. Requires the admin role.
+// @Tags data-subjects
+// @Produce json
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Param account_id query string false "Auth account of the user" format(uuid)
+// @Success 200 {object} dto.DataSubjectArchiveDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /v1/data-subjects/{userId}/export [get]
+func (h *DataSubjectHandler) Export(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var query dto.DataSubjectExportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	archive, request, err := h.dataSubjectUseCase.Export(c.Request.Context(), userID, query)
+	if err != nil {
+		c.JSON(dataSubjectErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	sendArchive(c, archive, request)
+}
+
+// @Summary Erase a user's data
+// @Description Delete a user's votes, orders, product ownerships and export jobs, anonymize their replies, and anonymize (default) or delete their reviews. With account_id, the auth account, its profiles and its OAuth connections are deleted as well. Access tokens issued to the user until now are revoked. Everything happens at once, and the request is recorded with the number of records erased. Requires the admin role.
+// @Tags data-subjects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Param erasure body dto.EraseDataSubjectDTO false "Erasure request"
+// @Success 201 {object} dto.DataSubjectRequestDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/data-subjects/{userId}/erasure [post]
+func (h *DataSubjectHandler) Erase(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	// The body is optional: without one, reviews are anonymized
+	var eraseDTO dto.EraseDataSubjectDTO
+	if err := c.ShouldBindJSON(&eraseDTO); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	request, err := h.dataSubjectUseCase.Erase(c.Request.Context(), userID, eraseDTO)
+	if err != nil {
+		c.JSON(dataSubjectErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, request)
+}
+
+// @Summary List a user's data requests
+// @Description List the fulfilled exports and erasures of a user, newest first. The records cannot be changed or deleted. Requires the admin role.
+// @Tags data-subjects
+// @Produce json
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Success 200 {array} dto.DataSubjectRequestDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /v1/data-subjects/{userId}/requests [get]
+func (h *DataSubjectHandler) ListRequests(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	requests, err := h.dataSubjectUseCase.ListRequests(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(dataSubjectErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, requests)
+}
+
+// sendArchive sends the archive as a file download, with the digest it was
+// recorded under.
+func sendArchive(c *gin.Context, archive []byte, request *dto.DataSubjectRequestDTO) {
+	c.Header("Content-Disposition", attachment(fmt.Sprintf("user-%d-data-export-%d.json", request.UserID, request.ID)))
+	c.Header("Cache-Control", "no-store")
+	if request.ArchiveSHA256 != nil {
+		c.Header("X-Archive-SHA256", *request.ArchiveSHA256)
+	}
+	c.Data(http.StatusOK, "application/json", archive)
+}
+
+func dataSubjectErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrAuthenticationRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domainerrors.ErrUserNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrderHandler struct {
+	orderUseCase interfaces.OrderUseCase
+}
+
+func NewOrderHandler(orderUseCase interfaces.OrderUseCase) *OrderHandler {
+	return &OrderHandler{
+		orderUseCase: orderUseCase,
+	}
+}
+
+// @Summary Receive an order webhook
+// @Description Ingest a single order from the commerce platform. The body must be signed with HMAC-SHA256 of the shared secret, sent as "X-Webhook-Signature: sha256=<hex>". Matching reviews are marked as verified purchases.
+// @Tags orders
+// @Accept json
+// @Produce  json
+// @Param X-Webhook-Signature header string true "sha256=<hex HMAC of the body>"
+// @Param order body dto.OrderDTO true "Order"
+// @Success 200 {object} dto.OrderIngestResultDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/orders/webhook [post]
+func (h *OrderHandler) ReceiveWebhook(c *gin.Context) {
+	var orderDTO dto.OrderDTO
+	if err := c.ShouldBindJSON(&orderDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.orderUseCase.Ingest(c.Request.Context(), orderDTO)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Import orders in bulk
+// @Description Ingest up to 1000 orders at once, e.g. to backfill purchase history. Requires the admin role.
+// @Tags orders
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param orders body dto.BulkOrdersDTO true "Orders"
+// @Success 200 {object} dto.OrderIngestResultDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/orders/bulk [post]
+func (h *OrderHandler) ImportOrders(c *gin.Context) {
+	var bulkDTO dto.BulkOrdersDTO
+	if err := c.ShouldBindJSON(&bulkDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.orderUseCase.IngestBulk(c.Request.Context(), bulkDTO)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
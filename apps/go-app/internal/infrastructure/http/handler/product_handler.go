@@ -0,0 +1,341 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProductHandler struct {
+	productUseCase interfaces.ProductUseCase
+}
+
+func NewProductHandler(productUseCase interfaces.ProductUseCase) *ProductHandler {
+	return &ProductHandler{
+		productUseCase: productUseCase,
+	}
+}
+
+// @Summary Create a product
+// @Description Add a product to the catalog. Set group_id to make it a variant sharing reviews with that product's group. Requires the admin role.
+// @Tags products
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param product body dto.CreateProductDTO true "Product"
+// @Success 201 {object} dto.ProductDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /v1/products [post]
+func (h *ProductHandler) CreateProduct(c *gin.Context) {
+	var productDTO dto.CreateProductDTO
+	if err := c.ShouldBindJSON(&productDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := h.productUseCase.Create(c.Request.Context(), productDTO)
+	if err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, product)
+}
+
+// @Summary Get a product by ID
+// @Description Get a single catalog product, including archived ones. Requires the admin role.
+// @Tags products
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Success 200 {object} dto.ProductDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id} [get]
+func (h *ProductHandler) GetProduct(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	product, err := h.productUseCase.Retrieve(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, product)
+}
+
+// @Summary Update a product
+// @Description Replace a product's attributes. Setting archived to false restores an archived product. Requires the admin role.
+// @Tags products
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param product body dto.UpdateProductDTO true "Product"
+// @Success 200 {object} dto.ProductDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /v1/products/{id} [put]
+func (h *ProductHandler) UpdateProduct(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	var productDTO dto.UpdateProductDTO
+	if err := c.ShouldBindJSON(&productDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := h.productUseCase.Update(c.Request.Context(), id, productDTO)
+	if err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, product)
+}
+
+// @Summary Archive a product
+// @Description Withdraw a product from the catalog. Existing reviews remain; new reviews are rejected. Requires the admin role.
+// @Tags products
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id} [delete]
+func (h *ProductHandler) ArchiveProduct(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	if err := h.productUseCase.Archive(c.Request.Context(), id); err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List products
+// @Description List catalog products. Requires the admin role.
+// @Tags products
+// @Produce  json
+// @Security BearerAuth
+// @Param offset query int false "Offset" minimum(0)
+// @Param limit query int false "Limit" default(50) minimum(1) maximum(100)
+// @Param include_archived query bool false "Include archived products"
+// @Param group_id query int false "Only the primary product and variants of this group"
+// @Success 200 {array} dto.ProductDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/products [get]
+func (h *ProductHandler) ListProducts(c *gin.Context) {
+	var query dto.ListProductsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	products, err := h.productUseCase.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, products)
+}
+
+// @Summary Summarize a product's reviews
+// @Description Aggregate the approved reviews of a product and its variants: review count, average rating, rating distribution and the average rating of each aspect of the product's category, plus the number of approved questions, answered questions and answers.
+// @Tags products
+// @Produce  json
+// @Param id path int true "Product ID"
+// @Success 200 {object} dto.ProductSummaryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/summary [get]
+func (h *ProductHandler) GetProductSummary(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	summary, err := h.productUseCase.Summary(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// @Summary Sync the product catalog
+// @Description Upsert a catalog feed by SKU in one transaction. group_sku names the primary product of a variant group; archive_missing archives live products absent from the feed. Requires the admin role.
+// @Tags products
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param catalog body dto.ProductSyncDTO true "Catalog feed"
+// @Success 200 {object} dto.ProductSyncResultDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /v1/products/sync [post]
+func (h *ProductHandler) SyncProducts(c *gin.Context) {
+	var syncDTO dto.ProductSyncDTO
+	if err := c.ShouldBindJSON(&syncDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.productUseCase.Sync(c.Request.Context(), syncDTO)
+	if err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary List a product's owners
+// @Description List the users who reply to the product's reviews and answer its questions on behalf of its brand. Requires the admin role.
+// @Tags products
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Success 200 {array} dto.ProductOwnerDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/owners [get]
+func (h *ProductHandler) ListProductOwners(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	owners, err := h.productUseCase.ListOwners(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, owners)
+}
+
+// @Summary Add a product owner
+// @Description Let a user reply to the product's reviews and answer its questions on behalf of its brand. Adding an existing owner changes nothing. Requires the admin role.
+// @Tags products
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param owner body dto.ProductOwnerInputDTO true "Owner"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/owners [post]
+func (h *ProductHandler) AddProductOwner(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	var ownerDTO dto.ProductOwnerInputDTO
+	if err := c.ShouldBindJSON(&ownerDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.productUseCase.AddOwner(c.Request.Context(), id, ownerDTO); err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Remove a product owner
+// @Description Stop a user from acting on behalf of the product's brand. Replies and answers they gave stay. Requires the admin role.
+// @Tags products
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param userId path int true "User ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/owners/{userId} [delete]
+func (h *ProductHandler) RemoveProductOwner(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	if err := h.productUseCase.RemoveOwner(c.Request.Context(), id, userID); err != nil {
+		c.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func productErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrProductNotFound), errors.Is(err, domainerrors.ErrProductOwnerNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrProductExists):
+		return http.StatusConflict
+	case errors.Is(err, domainerrors.ErrInvalidProductGroup), errors.Is(err, domainerrors.ErrInvalidCategory):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
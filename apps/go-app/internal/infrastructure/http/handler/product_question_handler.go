@@ -0,0 +1,468 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProductQuestionHandler struct {
+	qaUseCase interfaces.ProductQAUseCase
+}
+
+func NewProductQuestionHandler(qaUseCase interfaces.ProductQAUseCase) *ProductQuestionHandler {
+	return &ProductQuestionHandler{
+		qaUseCase: qaUseCase,
+	}
+}
+
+// @Summary Ask a question about a product
+// @Description Ask a question about a live catalog product.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param question body dto.ProductQuestionInputDTO true "Question"
+// @Success 201 {object} dto.ProductQuestionDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/questions [post]
+func (h *ProductQuestionHandler) AskQuestion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	var questionDTO dto.ProductQuestionInputDTO
+	if err := c.ShouldBindJSON(&questionDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	question, err := h.qaUseCase.Ask(c.Request.Context(), id, principal.UserID, questionDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, question)
+}
+
+// @Summary List the questions about a product
+// @Description List the questions about a product and its variants, newest first, each with its answers: brand answers first, then most helpful first.
+// @Tags questions
+// @Produce  json
+// @Param id path int true "Product ID"
+// @Param offset query int false "Offset" minimum(0)
+// @Param limit query int false "Limit" default(10) minimum(1) maximum(100)
+// @Param status query string false "Moderation status; defaults to approved, other statuses require the admin role" Enums(pending, approved, rejected)
+// @Success 200 {array} dto.ProductQuestionDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/questions [get]
+func (h *ProductQuestionHandler) ListQuestions(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	var query dto.ListProductQuestionsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	questions, err := h.qaUseCase.ListQuestions(c.Request.Context(), id, query)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, questions)
+}
+
+// @Summary Get a question by ID
+// @Description Get a question with its answers. Unapproved questions are visible to their author and admins only.
+// @Tags questions
+// @Produce  json
+// @Param id path int true "Question ID"
+// @Success 200 {object} dto.ProductQuestionDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/questions/{id} [get]
+func (h *ProductQuestionHandler) GetQuestion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question ID"})
+		return
+	}
+
+	question, err := h.qaUseCase.GetQuestion(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, question)
+}
+
+// @Summary Update a question
+// @Description Replace the body of a question. Restricted to its author and admins.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Question ID"
+// @Param question body dto.ProductQuestionInputDTO true "Question"
+// @Success 200 {object} dto.ProductQuestionDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/questions/{id} [put]
+func (h *ProductQuestionHandler) UpdateQuestion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question ID"})
+		return
+	}
+
+	var questionDTO dto.ProductQuestionInputDTO
+	if err := c.ShouldBindJSON(&questionDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.qaUseCase.UpdateQuestion(c.Request.Context(), id, questionDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, question)
+}
+
+// @Summary Delete a question
+// @Description Delete a question and its answers. Restricted to its author and admins.
+// @Tags questions
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Question ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/questions/{id} [delete]
+func (h *ProductQuestionHandler) DeleteQuestion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question ID"})
+		return
+	}
+
+	if err := h.qaUseCase.DeleteQuestion(c.Request.Context(), id); err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Moderate a question
+// @Description Set the moderation status of a question. Requires the admin role.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Question ID"
+// @Param status body dto.ModerateProductQADTO true "Moderation status"
+// @Success 200 {object} dto.ProductQuestionDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/questions/{id}/status [put]
+func (h *ProductQuestionHandler) ModerateQuestion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question ID"})
+		return
+	}
+
+	var moderateDTO dto.ModerateProductQADTO
+	if err := c.ShouldBindJSON(&moderateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.qaUseCase.ModerateQuestion(c.Request.Context(), id, moderateDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, question)
+}
+
+// @Summary Answer a question
+// @Description Answer an approved question. Set brand to answer on behalf of the product's brand, which requires owning the product or the admin role.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Question ID"
+// @Param answer body dto.ProductAnswerInputDTO true "Answer"
+// @Success 201 {object} dto.ProductAnswerDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/questions/{id}/answers [post]
+func (h *ProductQuestionHandler) AnswerQuestion(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question ID"})
+		return
+	}
+
+	var answerDTO dto.ProductAnswerInputDTO
+	if err := c.ShouldBindJSON(&answerDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	answer, err := h.qaUseCase.Answer(c.Request.Context(), id, principal.UserID, answerDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, answer)
+}
+
+// @Summary Update an answer
+// @Description Replace the body of an answer. Restricted to its author and admins.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Answer ID"
+// @Param answer body dto.UpdateProductAnswerDTO true "Answer"
+// @Success 200 {object} dto.ProductAnswerDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/answers/{id} [put]
+func (h *ProductQuestionHandler) UpdateAnswer(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer ID"})
+		return
+	}
+
+	var answerDTO dto.UpdateProductAnswerDTO
+	if err := c.ShouldBindJSON(&answerDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	answer, err := h.qaUseCase.UpdateAnswer(c.Request.Context(), id, answerDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
+
+// @Summary Delete an answer
+// @Description Delete an answer. Restricted to its author and admins.
+// @Tags questions
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Answer ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/answers/{id} [delete]
+func (h *ProductQuestionHandler) DeleteAnswer(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer ID"})
+		return
+	}
+
+	if err := h.qaUseCase.DeleteAnswer(c.Request.Context(), id); err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Moderate an answer
+// @Description Set the moderation status of an answer. Requires the admin role.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Answer ID"
+// @Param status body dto.ModerateProductQADTO true "Moderation status"
+// @Success 200 {object} dto.ProductAnswerDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/answers/{id}/status [put]
+func (h *ProductQuestionHandler) ModerateAnswer(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer ID"})
+		return
+	}
+
+	var moderateDTO dto.ModerateProductQADTO
+	if err := c.ShouldBindJSON(&moderateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	answer, err := h.qaUseCase.ModerateAnswer(c.Request.Context(), id, moderateDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
+
+// @Summary Vote on an answer
+// @Description Mark an answer as helpful or unhelpful. Voting again replaces the caller's previous vote.
+// @Tags questions
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Answer ID"
+// @Param vote body dto.ProductAnswerVoteDTO true "Vote"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/answers/{id}/votes [post]
+func (h *ProductQuestionHandler) VoteAnswer(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer ID"})
+		return
+	}
+
+	var voteDTO dto.ProductAnswerVoteDTO
+	if err := c.ShouldBindJSON(&voteDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	err = h.qaUseCase.VoteAnswer(c.Request.Context(), id, principal.UserID, voteDTO)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Remove a vote from an answer
+// @Description Withdraw the caller's helpfulness vote on an answer
+// @Tags questions
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Answer ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/answers/{id}/votes [delete]
+func (h *ProductQuestionHandler) RemoveAnswerVote(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer ID"})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	err = h.qaUseCase.RemoveAnswerVote(c.Request.Context(), id, principal.UserID)
+	if err != nil {
+		c.JSON(productQAErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func productQAErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrProductNotFound), errors.Is(err, domainerrors.ErrQuestionNotFound),
+		errors.Is(err, domainerrors.ErrAnswerNotFound), errors.Is(err, domainerrors.ErrAnswerVoteNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrProductArchived):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, domainerrors.ErrNotModerator), errors.Is(err, domainerrors.ErrNotProductOwner),
+		errors.Is(err, domainerrors.ErrNotQuestionAuthor), errors.Is(err, domainerrors.ErrNotAnswerAuthor),
+		errors.Is(err, domainerrors.ErrSelfAnswerVote):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RatingAlertHandler struct {
+	alertUseCase interfaces.RatingAlertUseCase
+}
+
+func NewRatingAlertHandler(alertUseCase interfaces.RatingAlertUseCase) *RatingAlertHandler {
+	return &RatingAlertHandler{
+		alertUseCase: alertUseCase,
+	}
+}
+
+// @Summary List rating alerts
+// @Description List the abnormal rating activity detected on products, newest first. Requires the admin role.
+// @Tags rating-alerts
+// @Produce json
+// @Security BearerAuth
+// @Param offset query int false "Offset" default(0) minimum(0)
+// @Param limit query int false "Limit" default(50) minimum(1) maximum(100)
+// @Param status query string false "Alert status" Enums(open, acknowledged, resolved)
+// @Param product_id query int false "Only alerts on this product"
+// @Success 200 {array} dto.RatingAlertDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /v1/rating-alerts [get]
+func (h *RatingAlertHandler) ListRatingAlerts(c *gin.Context) {
+	var query dto.ListRatingAlertsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	alerts, err := h.alertUseCase.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(ratingAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, alerts)
+}
+
+// @Summary Get a rating alert
+// @Description Get a single rating alert by its ID. Requires the admin role.
+// @Tags rating-alerts
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Alert ID"
+// @Success 200 {object} dto.RatingAlertDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/rating-alerts/{id} [get]
+func (h *RatingAlertHandler) GetRatingAlert(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert ID"})
+		return
+	}
+
+	alert, err := h.alertUseCase.Retrieve(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(ratingAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, alert)
+}
+
+// @Summary Update a rating alert's status
+// @Description Acknowledge or resolve a rating alert. A product gets no new alert of a kind while one is unresolved. Resolving does not lift a hold on the product's new reviews; update the product for that. Requires the admin role.
+// @Tags rating-alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Alert ID"
+// @Param status body dto.UpdateRatingAlertStatusDTO true "New status"
+// @Success 200 {object} dto.RatingAlertDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/rating-alerts/{id}/status [put]
+func (h *RatingAlertHandler) UpdateRatingAlertStatus(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert ID"})
+		return
+	}
+
+	var statusDTO dto.UpdateRatingAlertStatusDTO
+	if err := c.ShouldBindJSON(&statusDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	alert, err := h.alertUseCase.UpdateStatus(c.Request.Context(), id, statusDTO)
+	if err != nil {
+		c.JSON(ratingAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, alert)
+}
+
+func ratingAlertErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrRatingAlertNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrNotModerator):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead leaves room for multipart boundaries and part headers on
+// top of the file itself when capping request bodies.
+const multipartOverhead = 64 << 10
+
+type ReviewAttachmentHandler struct {
+	attachmentUseCase interfaces.ReviewAttachmentUseCase
+	maxUploadBytes    int64
+}
+
+func NewReviewAttachmentHandler(attachmentUseCase interfaces.ReviewAttachmentUseCase, maxUploadBytes int64) *ReviewAttachmentHandler {
+	return &ReviewAttachmentHandler{
+		attachmentUseCase: attachmentUseCase,
+		maxUploadBytes:    maxUploadBytes,
+	}
+}
+
+// @Summary Attach an image to a review
+// @Description Upload a JPEG, PNG or GIF image for a review. Metadata such as EXIF is stripped and a thumbnail is generated. Only the review's author may upload.
+// @Tags reviews
+// @Accept multipart/form-data
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param file formData file true "Image file"
+// @Success 201 {object} dto.ReviewAttachmentDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 413 {object} dto.ErrorResponse
+// @Failure 415 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/attachments [post]
+func (h *ReviewAttachmentHandler) UploadAttachment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadBytes+multipartOverhead)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": domainerrors.ErrAttachmentTooLarge.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "missing file"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentUseCase.Upload(c.Request.Context(), id, file)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+// @Summary Remove an image from a review
+// @Description Delete one of a review's attachments
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param attachmentId path int true "Attachment ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/attachments/{attachmentId} [delete]
+func (h *ReviewAttachmentHandler) DeleteAttachment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	attachmentID, err := strconv.ParseInt(c.Param("attachmentId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid attachment ID"})
+		return
+	}
+
+	if err := h.attachmentUseCase.Delete(c.Request.Context(), id, attachmentID); err != nil {
+		c.JSON(attachmentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func attachmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound), errors.Is(err, domainerrors.ErrAttachmentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrNotReviewAuthor):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrTooManyAttachments):
+		return http.StatusConflict
+	case errors.Is(err, domainerrors.ErrAttachmentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domainerrors.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, domainerrors.ErrInvalidImage):
+		return http.StatusBadRequest
+	case errors.Is(err, domainerrors.ErrImageTooLarge):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
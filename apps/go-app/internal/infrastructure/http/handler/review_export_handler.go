@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/infrastructure/export"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewExportHandler struct {
+	exportUseCase interfaces.ReviewExportUseCase
+}
+
+func NewReviewExportHandler(exportUseCase interfaces.ReviewExportUseCase) *ReviewExportHandler {
+	return &ReviewExportHandler{
+		exportUseCase: exportUseCase,
+	}
+}
+
+// @Summary Export reviews
+// @Description Stream every review matching the list filters as CSV, NDJSON or Parquet, read from the database as it is sent. Only approved reviews are exported unless the caller is a moderator. With gzip, CSV and NDJSON are sent gzipped (.gz) and Parquet pages are GZIP-compressed. For large exports prefer POST /v1/reviews/exports. Requires authentication.
+// @Tags reviews
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.apache.parquet
+// @Produce application/gzip
+// @Security BearerAuth
+// @Param format query string false "Export format" Enums(csv, ndjson, parquet) default(csv)
+// @Param gzip query bool false "Compress the export"
+// @Param verified query bool false "Only reviews with (true) or without (false) a verified purchase"
+// @Param product_id query int false "Only reviews of this product and its variants"
+// @Param status query string false "Moderation status, approved by default; other statuses require the admin role" Enums(pending, approved, rejected)
+// @Param sentiment query string false "Sentiment of the comment" Enums(positive, neutral, negative)
+// @Param sentiment_mismatch query bool false "Only reviews whose comment contradicts (true) or agrees with (false) their rating"
+// @Param lang query string false "Only reviews in this language, as a BCP 47 tag"
+// @Param published query bool false "Only reviews past (true) or within (false) their cooling-off period. Without the admin role only published reviews are exported"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /v1/reviews/export [get]
+func (h *ReviewExportHandler) ExportReviews(c *gin.Context) {
+	var query dto.ExportReviewsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err := h.exportUseCase.Export(c.Request.Context(), query, func() io.Writer {
+		c.Header("Content-Type", export.ContentType(query.Format, query.Gzip))
+		c.Header("Content-Disposition", attachment(export.FileName("reviews-export", query.Format, query.Gzip)))
+		c.Header("Cache-Control", "no-store")
+		c.Status(http.StatusOK)
+		return c.Writer
+	})
+	if err != nil {
+		if c.Writer.Written() {
+			// The export is partly sent; drop the connection so that the
+			// client sees a truncated response rather than a complete file
+			_ = c.Error(err)
+			if conn, _, hijackErr := c.Writer.Hijack(); hijackErr == nil {
+				_ = conn.Close()
+			}
+			return
+		}
+		c.Header("Content-Type", "")
+		c.Header("Content-Disposition", "")
+		c.JSON(exportErrorStatus(err), gin.H{"error": err.Error()})
+	}
+}
+
+// @Summary Request a review export
+// @Description Queue an export to be produced in the background and stored for download. Filters are those of GET /v1/reviews/export and are checked against the caller's permissions now. Poll the job until its status is succeeded, then download it. Requires authentication.
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param job body dto.CreateExportJobDTO true "Export"
+// @Success 202 {object} dto.ExportJobDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /v1/reviews/exports [post]
+func (h *ReviewExportHandler) CreateExportJob(c *gin.Context) {
+	var jobDTO dto.CreateExportJobDTO
+	if err := c.ShouldBindJSON(&jobDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	job, err := h.exportUseCase.CreateJob(c.Request.Context(), jobDTO)
+	if err != nil {
+		c.JSON(exportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Location", "/v1/reviews/exports/"+strconv.FormatInt(job.ID, 10))
+	c.JSON(http.StatusAccepted, job)
+}
+
+// @Summary Get a review export
+// @Description Get the status of an export job. Only the caller who requested it and admins can see it.
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Export job ID"
+// @Success 200 {object} dto.ExportJobDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/reviews/exports/{id} [get]
+func (h *ReviewExportHandler) GetExportJob(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid export job ID"})
+		return
+	}
+
+	job, err := h.exportUseCase.RetrieveJob(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(exportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// @Summary Download a review export
+// @Description Download the file of a finished export job. Only the caller who requested it and admins can download it.
+// @Tags reviews
+// @Produce application/octet-stream
+// @Security BearerAuth
+// @Param id path int true "Export job ID"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /v1/reviews/exports/{id}/download [get]
+func (h *ReviewExportHandler) DownloadExport(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid export job ID"})
+		return
+	}
+
+	job, file, err := h.exportUseCase.Download(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(exportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	extraHeaders := map[string]string{
+		"Content-Disposition": attachment(job.FileName),
+		"Cache-Control":       "no-store",
+	}
+	var size int64 = -1
+	if job.SizeBytes != nil {
+		size = *job.SizeBytes
+	}
+	c.DataFromReader(http.StatusOK, size, export.ContentType(job.Format, job.Gzip), file, extraHeaders)
+}
+
+func attachment(fileName string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+}
+
+func exportErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrExportJobNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrExportNotReady):
+		return http.StatusConflict
+	case errors.Is(err, domainerrors.ErrNotModerator):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrAuthenticationRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domainerrors.ErrUnknownExportFormat):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
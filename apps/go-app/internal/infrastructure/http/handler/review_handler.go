@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"user-review-ingest/internal/application/dto"
 	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/infrastructure/http/middleware"
 
 	"github.com/gin-gonic/gin"
 )
@@ -22,13 +25,17 @@ func NewReviewHandler(
 }
 
 // @Summary Create a new review
-// @Description Create a new review with the input payload
+// @Description Create a new review written by the caller. Reviews over a velocity limit are held for moderation as pending or rejected with 429, depending on the limit. Reviews breaking their product's review policy are rejected with 422, naming every rule they break.
 // @Tags reviews
 // @Accept  json
 // @Produce  json
+// @Security BearerAuth
 // @Param review body dto.CreateReviewDTO true "Create Review"
 // @Success 201 {object} nil
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.PolicyViolationResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /v1/reviews [post]
 func (h *ReviewHandler) CreateReview(c *gin.Context) {
@@ -38,8 +45,13 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 		return
 	}
 
+	if _, ok := middleware.PrincipalFromContext(c); !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
 	if err := h.reviewUseCase.Create(c.Request.Context(), reviewDTO); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(createReviewErrorStatus(err), reviewErrorBody(err))
 		return
 	}
 
@@ -72,15 +84,20 @@ func (h *ReviewHandler) GetReview(c *gin.Context) {
 }
 
 // @Summary Update a review by ID
-// @Description Update a single review by its ID
+// @Description Update a single review by its ID. Restricted to the author until the review is published, and to admins. Edits breaking the product's review policy are rejected with 422, naming every rule they break.
 // @Tags reviews
 // @Accept json
 // @Produce  json
+// @Security BearerAuth
 // @Param id path int true "Review ID"
 // @Param review body dto.UpdateReviewDTO true "Update Review"
 // @Success 200 {object} nil
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.PolicyViolationResponse
 // @Router /v1/reviews/{id} [put]
 func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
@@ -97,7 +114,7 @@ func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 
 	err = h.reviewUseCase.Update(c.Request.Context(), id, reviewDTO)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.JSON(updateReviewErrorStatus(err), reviewErrorBody(err))
 		return
 	}
 
@@ -105,13 +122,17 @@ func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 }
 
 // @Summary Delete a review by ID
-// @Description Delete a single review by its ID
+// @Description Delete a single review by its ID. Restricted to the author and admins.
 // @Tags reviews
 // @Produce  json
+// @Security BearerAuth
 // @Param id path int true "Review ID"
 // @Success 204 {object} nil
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
 // @Router /v1/reviews/{id} [delete]
 func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
@@ -122,7 +143,36 @@ func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 
 	err = h.reviewUseCase.Delete(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.JSON(deleteReviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Withdraw a review
+// @Description Delete the caller's review before it is published, during the tenant's cooling-off period. Restricted to the author.
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/withdraw [post]
+func (h *ReviewHandler) WithdrawReview(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	if err := h.reviewUseCase.Withdraw(c.Request.Context(), id); err != nil {
+		c.JSON(withdrawErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -130,23 +180,276 @@ func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 }
 
 // @Summary List reviews
-// @Description Get a list of reviews with optional pagination
+// @Description Get a list of reviews with optional pagination and ordering
 // @Tags reviews
 // @Produce  json
-// @Param offset query int false "Offset"
-// @Param limit query int false "Limit"
+// @Param offset query int false "Offset" minimum(0)
+// @Param limit query int false "Limit" default(10) minimum(1) maximum(100)
+// @Param sort query string false "Sort order; relevant, the default, weighs helpfulness and the author's reputation" Enums(relevant, newest, most_helpful, verified)
+// @Param verified query bool false "Only verified (true) or unverified (false) purchases"
+// @Param product_id query int false "Only reviews of this product and its variants"
+// @Param status query string false "Moderation status; defaults to approved, other statuses require the admin role" Enums(pending, approved, rejected)
+// @Param sentiment query string false "Sentiment of the comment" Enums(positive, neutral, negative)
+// @Param sentiment_mismatch query bool false "Only reviews whose comment contradicts (true) or agrees with (false) their rating"
+// @Param lang query string false "Only reviews in this language, as a BCP 47 tag"
+// @Param published query bool false "Only reviews past (true) or within (false) their cooling-off period. Without the admin role only published reviews are listed"
+// @Param Accept-Language header string false "Reviews in the preferred languages are listed first"
 // @Success 200 {array} dto.ReviewDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /v1/reviews [get]
 func (h *ReviewHandler) ListReviews(c *gin.Context) {
-	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	var query dto.ListReviewsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.AcceptLanguage = c.GetHeader("Accept-Language")
 
-	reviews, err := h.reviewUseCase.List(c.Request.Context(), offset, limit)
+	reviews, err := h.reviewUseCase.List(c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(moderationErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, reviews)
-}
\ No newline at end of file
+}
+
+// @Summary Moderate a review
+// @Description Set the moderation status of a review. Only approved reviews are listed publicly; authors still see their own. Requires the admin role.
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param moderation body dto.ModerateReviewDTO true "Moderation decision"
+// @Success 200 {object} dto.ReviewDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/status [put]
+func (h *ReviewHandler) ModerateReview(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var moderateDTO dto.ModerateReviewDTO
+	if err := c.ShouldBindJSON(&moderateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	review, err := h.reviewUseCase.Moderate(c.Request.Context(), id, moderateDTO)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// @Summary List suspected originals of a review
+// @Description List the earlier reviews whose comment the review nearly copies, closest first. Comments are compared by SimHash fingerprint when written. Requires the admin role.
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Success 200 {array} dto.ReviewDuplicateDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/duplicates [get]
+func (h *ReviewHandler) ListReviewDuplicates(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	duplicates, err := h.reviewUseCase.ListDuplicates(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, duplicates)
+}
+
+// @Summary Vote on a review
+// @Description Mark a review as helpful or unhelpful. Voting again replaces the caller's previous vote.
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param vote body dto.ReviewVoteDTO true "Vote"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/votes [post]
+func (h *ReviewHandler) VoteReview(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var voteDTO dto.ReviewVoteDTO
+	if err := c.ShouldBindJSON(&voteDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	err = h.reviewUseCase.Vote(c.Request.Context(), id, principal.UserID, voteDTO)
+	if err != nil {
+		c.JSON(voteErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Remove a vote from a review
+// @Description Withdraw the caller's helpfulness vote on a review
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/votes [delete]
+func (h *ReviewHandler) RemoveReviewVote(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	err = h.reviewUseCase.RemoveVote(c.Request.Context(), id, principal.UserID)
+	if err != nil {
+		c.JSON(voteErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func createReviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrProductNotFound), errors.Is(err, domainerrors.ErrProductArchived),
+		errors.Is(err, domainerrors.ErrUnknownAspect), errors.Is(err, domainerrors.ErrMissingAspectRating),
+		errors.Is(err, domainerrors.ErrInvalidRating), errors.Is(err, domainerrors.ErrReviewPolicyViolation):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, domainerrors.ErrReviewRateLimited):
+		return http.StatusTooManyRequests
+	case errors.Is(err, domainerrors.ErrAuthenticationRequired):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func updateReviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrUnknownAspect), errors.Is(err, domainerrors.ErrMissingAspectRating),
+		errors.Is(err, domainerrors.ErrInvalidRating), errors.Is(err, domainerrors.ErrReviewPolicyViolation):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, domainerrors.ErrAuthenticationRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domainerrors.ErrNotReviewAuthor):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrReviewPublished):
+		return http.StatusConflict
+	default:
+		return http.StatusNotFound
+	}
+}
+
+func deleteReviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrAuthenticationRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domainerrors.ErrNotReviewAuthor):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// reviewErrorBody lists the rules broken by a review breaking its product's
+// review policy alongside the error.
+func reviewErrorBody(err error) gin.H {
+	var policyErr *domainerrors.PolicyViolationError
+	if !errors.As(err, &policyErr) {
+		return gin.H{"error": err.Error()}
+	}
+
+	violations := make([]dto.PolicyViolationDTO, 0, len(policyErr.Violations))
+	for _, violation := range policyErr.Violations {
+		violations = append(violations, dto.PolicyViolationDTO{Rule: violation.Rule, Message: violation.Message})
+	}
+	return gin.H{"error": err.Error(), "violations": violations}
+}
+
+func withdrawErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrAuthenticationRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domainerrors.ErrNotReviewAuthor):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrReviewPublished):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func moderationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrNotModerator):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func voteErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound), errors.Is(err, domainerrors.ErrReviewVoteNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrSelfVote):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewInvitationHandler struct {
+	invitationUseCase interfaces.ReviewInvitationUseCase
+}
+
+func NewReviewInvitationHandler(invitationUseCase interfaces.ReviewInvitationUseCase) *ReviewInvitationHandler {
+	return &ReviewInvitationHandler{
+		invitationUseCase: invitationUseCase,
+	}
+}
+
+// @Summary Issue a review invitation
+// @Description Invite the customer of an ingested order to review one of its products. The returned token goes into the invitation link and works without logging in. Issuing again for the same order returns the same invitation with its expiry extended. Requires the admin role.
+// @Tags review-invitations
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param invitation body dto.IssueReviewInvitationDTO true "Order line"
+// @Success 201 {object} dto.ReviewInvitationDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/review-invitations [post]
+func (h *ReviewInvitationHandler) IssueInvitation(c *gin.Context) {
+	var issueDTO dto.IssueReviewInvitationDTO
+	if err := c.ShouldBindJSON(&issueDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invitation, err := h.invitationUseCase.Issue(c.Request.Context(), issueDTO)
+	if err != nil {
+		c.JSON(invitationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// @Summary Open a review invitation
+// @Description Look up the invitation named by a token, recording the first visit of its link.
+// @Tags review-invitations
+// @Produce  json
+// @Param token path string true "Invitation token"
+// @Success 200 {object} dto.ReviewInvitationDTO
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 410 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/review-invitations/{token} [get]
+func (h *ReviewInvitationHandler) OpenInvitation(c *gin.Context) {
+	invitation, err := h.invitationUseCase.Open(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		c.JSON(invitationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, invitation)
+}
+
+// @Summary Review through an invitation
+// @Description Write the invited customer's review of the invitation's product, without logging in. The review is a verified purchase of the invitation's order and goes through the usual velocity limits and moderation. Each invitation can be used once.
+// @Tags review-invitations
+// @Accept json
+// @Produce  json
+// @Param token path string true "Invitation token"
+// @Param review body dto.InvitedReviewDTO true "Review"
+// @Success 201 {object} dto.ReviewDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 410 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.PolicyViolationResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/review-invitations/{token}/review [post]
+func (h *ReviewInvitationHandler) RedeemInvitation(c *gin.Context) {
+	var reviewDTO dto.InvitedReviewDTO
+	if err := c.ShouldBindJSON(&reviewDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	review, err := h.invitationUseCase.Redeem(c.Request.Context(), c.Param("token"), reviewDTO)
+	if err != nil {
+		c.JSON(invitationErrorStatus(err), reviewErrorBody(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, review)
+}
+
+// @Summary Review invitation conversion
+// @Description Count the invitations issued in a period that were opened, completed with a review, or left to expire. Requires the admin role.
+// @Tags review-invitations
+// @Produce  json
+// @Security BearerAuth
+// @Param product_id query int false "Only invitations to review this product"
+// @Param from query string false "Issued at or after, RFC 3339"
+// @Param to query string false "Issued before, RFC 3339"
+// @Success 200 {object} dto.InvitationStatsDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/review-invitations/stats [get]
+func (h *ReviewInvitationHandler) InvitationStats(c *gin.Context) {
+	var query dto.InvitationStatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stats, err := h.invitationUseCase.Stats(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(invitationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+func invitationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrInvitationNotFound), errors.Is(err, domainerrors.ErrInvitationsDisabled):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrInvitationExpired):
+		return http.StatusGone
+	case errors.Is(err, domainerrors.ErrInvitationRedeemed):
+		return http.StatusConflict
+	case errors.Is(err, domainerrors.ErrNotModerator):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrOrderNotFound):
+		return http.StatusUnprocessableEntity
+	default:
+		return createReviewErrorStatus(err)
+	}
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewPolicyHandler struct {
+	policyUseCase interfaces.ReviewPolicyUseCase
+}
+
+func NewReviewPolicyHandler(policyUseCase interfaces.ReviewPolicyUseCase) *ReviewPolicyHandler {
+	return &ReviewPolicyHandler{
+		policyUseCase: policyUseCase,
+	}
+}
+
+// @Summary Set a product's review policy
+// @Description Create or replace the review policy of a product, which also applies to the variants in its group. Rules left out are inherited from the group's policy, then the category's. Requires the admin role.
+// @Tags review-policies
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param policy body dto.ReviewPolicyInputDTO true "Review policy"
+// @Success 200 {object} dto.ReviewPolicyDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/review-policy [put]
+func (h *ReviewPolicyHandler) PutProductPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	var policyDTO dto.ReviewPolicyInputDTO
+	if err := c.ShouldBindJSON(&policyDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := h.policyUseCase.PutForProduct(c.Request.Context(), id, policyDTO)
+	if err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// @Summary Get a product's review policy
+// @Description Get the review policy set on a product itself. Requires the admin role.
+// @Tags review-policies
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Success 200 {object} dto.ReviewPolicyDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/review-policy [get]
+func (h *ReviewPolicyHandler) GetProductPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	policy, err := h.policyUseCase.GetForProduct(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// @Summary Delete a product's review policy
+// @Description Delete the review policy of a product, whose reviews then follow its group's and category's policies. Requires the admin role.
+// @Tags review-policies
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/review-policy [delete]
+func (h *ReviewPolicyHandler) DeleteProductPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	if err := h.policyUseCase.DeleteForProduct(c.Request.Context(), id); err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Set a category's review policy
+// @Description Create or replace the review policy of a category, which applies to its products unless their own policies override it. Requires the admin role.
+// @Tags review-policies
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Param policy body dto.ReviewPolicyInputDTO true "Review policy"
+// @Success 200 {object} dto.ReviewPolicyDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/categories/{id}/review-policy [put]
+func (h *ReviewPolicyHandler) PutCategoryPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category ID"})
+		return
+	}
+
+	var policyDTO dto.ReviewPolicyInputDTO
+	if err := c.ShouldBindJSON(&policyDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := h.policyUseCase.PutForCategory(c.Request.Context(), id, policyDTO)
+	if err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// @Summary Get a category's review policy
+// @Description Get the review policy of a category. Requires the admin role.
+// @Tags review-policies
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Success 200 {object} dto.ReviewPolicyDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/categories/{id}/review-policy [get]
+func (h *ReviewPolicyHandler) GetCategoryPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category ID"})
+		return
+	}
+
+	policy, err := h.policyUseCase.GetForCategory(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// @Summary Delete a category's review policy
+// @Description Delete the review policy of a category. Requires the admin role.
+// @Tags review-policies
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/categories/{id}/review-policy [delete]
+func (h *ReviewPolicyHandler) DeleteCategoryPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category ID"})
+		return
+	}
+
+	if err := h.policyUseCase.DeleteForCategory(c.Request.Context(), id); err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Get a product's review rules
+// @Description Get the rules reviews of a product are held to, resolved from its own, its group's and its category's review policies.
+// @Tags review-policies
+// @Produce  json
+// @Param id path int true "Product ID"
+// @Success 200 {object} dto.ReviewRulesDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/products/{id}/review-rules [get]
+func (h *ReviewPolicyHandler) GetProductRules(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	rules, err := h.policyUseCase.ProductRules(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(reviewPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+func reviewPolicyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewPolicyNotFound), errors.Is(err, domainerrors.ErrProductNotFound),
+		errors.Is(err, domainerrors.ErrCategoryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrInvalidReviewPolicy):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
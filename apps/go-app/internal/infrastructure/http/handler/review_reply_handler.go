@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewReplyHandler struct {
+	replyUseCase interfaces.ReviewReplyUseCase
+}
+
+func NewReviewReplyHandler(replyUseCase interfaces.ReviewReplyUseCase) *ReviewReplyHandler {
+	return &ReviewReplyHandler{
+		replyUseCase: replyUseCase,
+	}
+}
+
+// @Summary Reply to a review
+// @Description Publish the brand's public reply to a review. Only owners of the reviewed product may reply.
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param reply body dto.ReviewReplyInputDTO true "Reply"
+// @Success 201 {object} dto.ReviewReplyDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/reply [post]
+func (h *ReviewReplyHandler) CreateReply(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var replyDTO dto.ReviewReplyInputDTO
+	if err := c.ShouldBindJSON(&replyDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reply, err := h.replyUseCase.Create(c.Request.Context(), id, replyDTO)
+	if err != nil {
+		c.JSON(replyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, reply)
+}
+
+// @Summary Edit a review reply
+// @Description Replace the body of the brand's reply to a review
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param reply body dto.ReviewReplyInputDTO true "Reply"
+// @Success 200 {object} dto.ReviewReplyDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/reply [put]
+func (h *ReviewReplyHandler) UpdateReply(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var replyDTO dto.ReviewReplyInputDTO
+	if err := c.ShouldBindJSON(&replyDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reply, err := h.replyUseCase.Update(c.Request.Context(), id, replyDTO)
+	if err != nil {
+		c.JSON(replyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reply)
+}
+
+// @Summary Delete a review reply
+// @Description Remove the brand's reply to a review
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/reply [delete]
+func (h *ReviewReplyHandler) DeleteReply(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	if err := h.replyUseCase.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(replyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func replyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound), errors.Is(err, domainerrors.ErrReviewReplyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrNotProductOwner):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrReviewReplyExists):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
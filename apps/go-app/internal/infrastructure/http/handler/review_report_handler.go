@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/infrastructure/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewReportHandler struct {
+	reportUseCase interfaces.ReviewReportUseCase
+}
+
+func NewReviewReportHandler(reportUseCase interfaces.ReviewReportUseCase) *ReviewReportHandler {
+	return &ReviewReportHandler{
+		reportUseCase: reportUseCase,
+	}
+}
+
+// @Summary Report a review
+// @Description Report a review as spam, offensive, fake or otherwise abusive. Each caller may report a review once. An approved review with enough open reports goes back to moderation.
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param report body dto.ReviewReportInputDTO true "Report"
+// @Success 201 {object} dto.ReviewReportDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/reports [post]
+func (h *ReviewReportHandler) ReportReview(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var reportDTO dto.ReviewReportInputDTO
+	if err := c.ShouldBindJSON(&reportDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	report, err := h.reportUseCase.Report(c.Request.Context(), id, principal.UserID, reportDTO)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
+
+// @Summary List the reports of a review
+// @Description List every report of a review, oldest first, with its resolution. Requires the admin role.
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Success 200 {array} dto.ReviewReportDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/reports [get]
+func (h *ReviewReportHandler) ListReviewReports(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	reports, err := h.reportUseCase.ListByReview(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+// @Summary Resolve the reports of a review
+// @Description Uphold or dismiss every open report of a review. The review's status is not changed; set it with PUT /v1/reviews/{id}/status. Requires the admin role.
+// @Tags reviews
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param resolution body dto.ResolveReviewReportsDTO true "Resolution"
+// @Success 200 {array} dto.ReviewReportDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/{id}/reports [put]
+func (h *ReviewReportHandler) ResolveReviewReports(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var resolveDTO dto.ResolveReviewReportsDTO
+	if err := c.ShouldBindJSON(&resolveDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reports, err := h.reportUseCase.Resolve(c.Request.Context(), id, resolveDTO)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+// @Summary List reported reviews
+// @Description List reported reviews with their report counts per resolution and reason, most open reports first. Requires the admin role.
+// @Tags reviews
+// @Produce  json
+// @Security BearerAuth
+// @Param offset query int false "Offset" minimum(0)
+// @Param limit query int false "Limit" default(20) minimum(1) maximum(100)
+// @Param status query string false "open (default) lists reviews with open reports, all every reported review" Enums(open, all)
+// @Success 200 {array} dto.ReportedReviewDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviews/reported [get]
+func (h *ReviewReportHandler) ListReportedReviews(c *gin.Context) {
+	var query dto.ListReportedReviewsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reported, err := h.reportUseCase.ListReported(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reported)
+}
+
+func reportErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrSelfReport), errors.Is(err, domainerrors.ErrNotModerator):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrReviewAlreadyReported), errors.Is(err, domainerrors.ErrNoOpenReports):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
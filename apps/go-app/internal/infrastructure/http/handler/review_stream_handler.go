@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// streamKeepAlive is how often an idle stream sends a comment, so that proxies
+// do not close it and clients notice dead connections.
+const streamKeepAlive = 15 * time.Second
+
+// streamRetryMs tells clients how long to wait before reconnecting.
+const streamRetryMs = 3000
+
+type ReviewStreamHandler struct {
+	reviewStream interfaces.ReviewStream
+}
+
+func NewReviewStreamHandler(reviewStream interfaces.ReviewStream) *ReviewStreamHandler {
+	return &ReviewStreamHandler{
+		reviewStream: reviewStream,
+	}
+}
+
+// @Summary Stream review changes
+// @Description Server-sent events for reviews being created, updated, deleted and published, named review.created, review.updated, review.deleted and review.published. The event ID can be sent back as the Last-Event-ID header (or last_event_id parameter) to resume after a disconnect. Requires the admin role.
+// @Tags reviews
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param product_id query int false "Only reviews of this product and its variants"
+// @Param status query string false "Only reviews in this moderation status" Enums(pending, approved, rejected)
+// @Param last_event_id query int false "Resume after this event ID"
+// @Param Last-Event-ID header int false "Resume after this event ID"
+// @Success 200 {object} dto.ReviewDTO "Stream of events whose data is the review"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /v1/reviews/stream [get]
+func (h *ReviewStreamHandler) StreamReviews(c *gin.Context) {
+	var query dto.ReviewStreamQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if header := c.GetHeader("Last-Event-ID"); header != "" {
+		lastEventID, err := strconv.ParseInt(header, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid Last-Event-ID"})
+			return
+		}
+		query.LastEventID = &lastEventID
+	}
+
+	events, err := h.reviewStream.Subscribe(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(reviewStreamErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Keep reverse proxies such as nginx from buffering the stream
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	// Sent on its own, a retry field sets the reconnection delay without dispatching an event
+	_, _ = io.WriteString(c.Writer, "retry: "+strconv.Itoa(streamRetryMs)+"\n\n")
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.Render(-1, sse.Event{
+				Id:    strconv.FormatInt(event.ID, 10),
+				Event: event.Type,
+				Data:  event.Review,
+			})
+			return true
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		}
+	})
+}
+
+func reviewStreamErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrProductNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrNotModerator), errors.Is(err, domainerrors.ErrTenantRequired):
+		return http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrStreamClosed):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewerHandler struct {
+	reviewerUseCase interfaces.ReviewerUseCase
+}
+
+func NewReviewerHandler(reviewerUseCase interfaces.ReviewerUseCase) *ReviewerHandler {
+	return &ReviewerHandler{
+		reviewerUseCase: reviewerUseCase,
+	}
+}
+
+// @Summary Get a reviewer profile
+// @Description Get the totals of a user's live reviews, their reputation from 0 to 100 and the badges they earned. Reputation combines the helpful votes received, the share of verified purchases and the share of reviews passing moderation, and grows with the number of reviews. It ranks reviews in the default "relevant" order.
+// @Tags reviewers
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} dto.ReviewerProfileDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/reviewers/{id} [get]
+func (h *ReviewerHandler) GetReviewer(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	profile, err := h.reviewerUseCase.GetProfile(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(reviewerErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+func reviewerErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrReviewerNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
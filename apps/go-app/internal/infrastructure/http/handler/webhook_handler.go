@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user-review-ingest/internal/application/dto"
+	"user-review-ingest/internal/application/interfaces"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookUseCase interfaces.WebhookUseCase
+}
+
+func NewWebhookHandler(webhookUseCase interfaces.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// @Summary Create a webhook subscription
+// @Description Register an endpoint to receive review events. Deliveries are POSTed as JSON with an X-Webhook-Timestamp header and an X-Webhook-Signature header holding "sha256=" and the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret. The secret is only returned in this response. Requires the admin role.
+// @Tags webhooks
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param webhook body dto.CreateWebhookDTO true "Subscription"
+// @Success 201 {object} dto.WebhookDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /v1/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var webhookDTO dto.CreateWebhookDTO
+	if err := c.ShouldBindJSON(&webhookDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	webhook, err := h.webhookUseCase.Create(c.Request.Context(), webhookDTO)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// @Summary Get a webhook subscription by ID
+// @Description Get a single webhook subscription. Requires the admin role.
+// @Tags webhooks
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Success 200 {object} dto.WebhookDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook ID"})
+		return
+	}
+
+	webhook, err := h.webhookUseCase.Retrieve(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// @Summary Update a webhook subscription
+// @Description Replace a subscription's URL and event filter, or disable it. Re-activating a subscription that was disabled after repeated failures resets its failure count and resumes its pending deliveries. Requires the admin role.
+// @Tags webhooks
+// @Accept json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Param webhook body dto.UpdateWebhookDTO true "Subscription"
+// @Success 200 {object} dto.WebhookDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /v1/webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook ID"})
+		return
+	}
+
+	var webhookDTO dto.UpdateWebhookDTO
+	if err := c.ShouldBindJSON(&webhookDTO); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	webhook, err := h.webhookUseCase.Update(c.Request.Context(), id, webhookDTO)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// @Summary Delete a webhook subscription
+// @Description Remove a subscription together with its delivery log. Requires the admin role.
+// @Tags webhooks
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Success 204 {object} nil
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook ID"})
+		return
+	}
+
+	if err := h.webhookUseCase.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List webhook subscriptions
+// @Description List the tenant's webhook subscriptions. Requires the admin role.
+// @Tags webhooks
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {array} dto.WebhookDTO
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	webhooks, err := h.webhookUseCase.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+// @Summary List webhook deliveries
+// @Description Inspect the delivery log of a subscription, newest first, including response codes and errors of the last attempt. Requires the admin role.
+// @Tags webhooks
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Param offset query int false "Offset" minimum(0)
+// @Param limit query int false "Limit" default(50) minimum(1) maximum(100)
+// @Param status query string false "Delivery status" Enums(pending, succeeded, failed)
+// @Success 200 {array} dto.WebhookDeliveryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook ID"})
+		return
+	}
+
+	var query dto.ListWebhookDeliveriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deliveries, err := h.webhookUseCase.ListDeliveries(c.Request.Context(), id, query)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// @Summary Redeliver a webhook delivery
+// @Description Queue the payload of a past delivery again. The original entry stays in the log and a new pending delivery is returned. Requires the admin role.
+// @Tags webhooks
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Param deliveryId path int true "Delivery ID"
+// @Success 202 {object} dto.WebhookDeliveryDTO
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /v1/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook ID"})
+		return
+	}
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid delivery ID"})
+		return
+	}
+
+	delivery, err := h.webhookUseCase.Redeliver(c.Request.Context(), id, deliveryID)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+func webhookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrWebhookNotFound),
+		errors.Is(err, domainerrors.ErrWebhookDeliveryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrInvalidWebhookURL),
+		errors.Is(err, domainerrors.ErrInvalidEventFilter):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,10 +1,141 @@
 package middleware
 
-import "github.com/gin-gonic/gin"
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"user-review-ingest/internal/domain/entity"
 
+	"github.com/gin-gonic/gin"
+)
+
+var errInvalidToken = errors.New("invalid access token")
+
+type tokenClaims struct {
+	Subject   string   `json:"sub"`
+	Roles     []string `json:"roles"`
+	Tenant    string   `json:"tenant"`
+	ExpiresAt int64    `json:"exp"`
+	IssuedAt  int64    `json:"iat"`
+}
+
+// AuthMiddleware authenticates requests carrying an HS256-signed JWT bearer
+// token and stores the resulting principal on the request context. Requests
+// without a token pass through anonymously; an invalid token is rejected with 401.
 func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Implementation to follow
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "malformed authorization header"})
+			return
+		}
+
+		principal, err := parseToken(token, []byte(jwtSecret))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Request = c.Request.WithContext(entity.ContextWithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
+
+// RequireAuth rejects requests that AuthMiddleware did not authenticate.
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := entity.PrincipalFromContext(c.Request.Context()); !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireRole rejects requests whose principal was not granted the role.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := entity.PrincipalFromContext(c.Request.Context())
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			return
+		}
+		if !principal.HasRole(role) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+			return
+		}
 		c.Next()
 	}
 }
+
+// PrincipalFromContext returns the principal authenticated for the request, if any.
+func PrincipalFromContext(c *gin.Context) (*entity.Principal, bool) {
+	return entity.PrincipalFromContext(c.Request.Context())
+}
+
+func parseToken(token string, secret []byte) (*entity.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, errInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errInvalidToken
+	}
+
+	var claims tokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, errInvalidToken
+	}
+	if claims.ExpiresAt != 0 && time.Now().Unix() >= claims.ExpiresAt {
+		return nil, errors.New("access token expired")
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+
+	principal := &entity.Principal{
+		UserID: userID,
+		Roles:  claims.Roles,
+		Tenant: claims.Tenant,
+	}
+	if claims.IssuedAt != 0 {
+		principal.IssuedAt = time.Unix(claims.IssuedAt, 0)
+	}
+	return principal, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
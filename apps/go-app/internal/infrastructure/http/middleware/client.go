@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"user-review-ingest/internal/domain/entity"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClientMiddleware puts the client's address on the request context. Forwarded
+// addresses are only believed from the engine's trusted proxies.
+func ClientMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(entity.ContextWithClientIP(c.Request.Context(), c.ClientIP()))
+		c.Next()
+	}
+}
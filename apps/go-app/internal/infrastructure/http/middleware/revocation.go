@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"user-review-ingest/internal/application/interfaces"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RevocationMiddleware rejects access tokens revoked after they were issued.
+// It must run after TenantMiddleware, as revocations are kept per tenant.
+// Anonymous requests pass through.
+func RevocationMiddleware(checker interfaces.TokenRevocationChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := entity.PrincipalFromContext(c.Request.Context())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if err := checker.Check(c.Request.Context(), principal); err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, domainerrors.ErrTokenRevoked) {
+				status = http.StatusUnauthorized
+			}
+			c.AbortWithStatusJSON(status, gin.H{"error": err.Error()})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of a webhook body as "sha256=<hex>".
+const SignatureHeader = "X-Webhook-Signature"
+
+const maxSignedBodyBytes = 4 << 20
+
+// RequireSignature verifies that the request body was signed with the shared
+// secret. The body is buffered so handlers can still bind it. An empty secret
+// disables the endpoint altogether.
+func RequireSignature(secret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if secret == "" {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "webhook is not configured"})
+			return
+		}
+
+		signature, found := strings.CutPrefix(c.GetHeader(SignatureHeader), "sha256=")
+		expected, err := hex.DecodeString(signature)
+		if !found || err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing or malformed signature"})
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxSignedBodyBytes))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body too large"})
+			return
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if !hmac.Equal(expected, mac.Sum(nil)) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid signature"})
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
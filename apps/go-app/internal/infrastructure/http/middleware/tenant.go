@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"user-review-ingest/internal/application/interfaces"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TenantHeader names the tenant, by slug, that a request acts for.
+const TenantHeader = "X-Tenant"
+
+// TenantMiddleware scopes the request context to the tenant resolved from the
+// X-Tenant header, the request hostname and the access token's tenant claim.
+// It must run after AuthMiddleware. Requests whose tenant cannot be determined,
+// or whose token belongs to another tenant, are rejected.
+func TenantMiddleware(resolver interfaces.TenantResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, _ := entity.PrincipalFromContext(c.Request.Context())
+
+		tenant, err := resolver.Resolve(
+			c.Request.Context(),
+			requestHostname(c.Request),
+			strings.ToLower(strings.TrimSpace(c.GetHeader(TenantHeader))),
+			principal,
+		)
+		if err != nil {
+			c.AbortWithStatusJSON(tenantErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Request = c.Request.WithContext(entity.ContextWithTenant(c.Request.Context(), tenant))
+		c.Next()
+	}
+}
+
+// requestHostname returns the lower-cased request host without its port.
+func requestHostname(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+func tenantErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domainerrors.ErrTenantRequired):
+		return http.StatusBadRequest
+	case errors.Is(err, domainerrors.ErrTenantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrTenantMismatch):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,10 +1,15 @@
 package router
 
 import (
+	"strings"
+
+	"user-review-ingest/internal/application/interfaces"
 	"user-review-ingest/internal/application/modules"
 	"user-review-ingest/internal/infrastructure/config"
 	"user-review-ingest/internal/infrastructure/http/handler"
 	"user-review-ingest/internal/infrastructure/http/middleware"
+	"user-review-ingest/internal/infrastructure/storage"
+	"user-review-ingest/internal/infrastructure/storage/local"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -13,26 +18,63 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetupRouter(db *pgxpool.Pool, logger *zerolog.Logger, cfg *config.Config) *gin.Engine {
+func SetupRouter(db *pgxpool.Pool, blobStore, exportStore storage.BlobStore, reviewStream interfaces.ReviewStream, changeFeed interfaces.ChangeFeed, velocityLimiter interfaces.VelocityLimiter, logger *zerolog.Logger, cfg *config.Config) *gin.Engine {
 	r := gin.New()
 
+	// Forwarded client addresses are only believed from the configured proxies
+	if err := r.SetTrustedProxies(trustedProxies(cfg.TrustedProxies)); err != nil {
+		logger.Fatal().Err(err).Msg("Invalid TRUSTED_PROXIES")
+	}
+
 	// Global Middlewares
 	r.Use(middleware.LoggingMiddleware(logger))
 	r.Use(gin.Recovery())
 	r.Use(middleware.CORSMiddleware())
+	r.Use(middleware.ClientMiddleware())
 
 	// Health Check and Swagger
 	healthHandler := handler.NewHealthHandler()
 	r.GET("/health", healthHandler.HealthCheck)
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	modules.RegisterOAuthModule(r, db, logger)
+	// Review media is served straight from disk when stored locally
+	if localStore, ok := blobStore.(*local.Store); ok {
+		r.Static("/media", localStore.Root())
+	}
+
+	// Everything below acts for a single tenant
+	tenantMiddleware := modules.NewTenantMiddleware(db, cfg)
+
+	modules.RegisterOAuthModule(r.Group("", tenantMiddleware), db, logger)
 
 	// Versioned API Group
 	v1RouterGroup := r.Group("/v1")
+	v1RouterGroup.Use(middleware.AuthMiddleware(cfg.JWTSecret), tenantMiddleware, modules.NewRevocationMiddleware(db))
 	{
-		modules.RegisterReviewModule(v1RouterGroup, db)
+		modules.RegisterReviewModule(v1RouterGroup, db, blobStore, reviewStream, velocityLimiter, cfg)
+		modules.RegisterReviewExportModule(v1RouterGroup, db, exportStore)
+		modules.RegisterChangeFeedModule(v1RouterGroup, changeFeed)
+		modules.RegisterProductModule(v1RouterGroup, db)
+		modules.RegisterProductQuestionModule(v1RouterGroup, db)
+		modules.RegisterCategoryModule(v1RouterGroup, db)
+		modules.RegisterReviewPolicyModule(v1RouterGroup, db)
+		modules.RegisterRatingAlertModule(v1RouterGroup, db)
+		modules.RegisterOrderModule(v1RouterGroup, db, cfg)
+		modules.RegisterWebhookModule(v1RouterGroup, db)
+		modules.RegisterDataSubjectModule(v1RouterGroup, db, blobStore)
 	}
 
 	return r
 }
+
+// trustedProxies splits the comma-separated TRUSTED_PROXIES setting; none
+// trusts no proxy, so that client addresses come from the connection.
+func trustedProxies(setting string) []string {
+	var proxies []string
+	for _, proxy := range strings.Split(setting, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
@@ -0,0 +1,183 @@
+package langdetect
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// minWords is the shortest text whose language is guessed at.
+	minWords = 3
+	// minStopwords is how many function words of a Latin-script language a
+	// text must contain to be attributed to it.
+	minStopwords = 2
+)
+
+// stopwords are frequent function words, chosen to overlap little between
+// languages.
+var stopwords = map[string][]string{
+	"en": {"the", "and", "is", "it", "this", "was", "for", "with", "that", "not", "but", "very", "have", "are", "you", "my", "of", "to", "would", "after", "they", "what", "too", "just", "really"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ich", "es", "mit", "sehr", "auch", "ein", "eine", "für", "auf", "sich", "dem", "den", "aber", "war", "nach", "wie", "noch", "gut", "schon"},
+	"fr": {"le", "la", "les", "et", "est", "je", "il", "pas", "très", "pour", "une", "des", "que", "qui", "dans", "avec", "mais", "sur", "ce", "du", "au", "bien", "été", "vous", "plus"},
+	"es": {"el", "los", "las", "y", "es", "muy", "pero", "para", "con", "que", "una", "del", "por", "lo", "se", "mi", "como", "está", "bien", "más", "fue", "al", "todo", "sin", "este"},
+	"it": {"il", "lo", "gli", "e", "è", "molto", "non", "che", "per", "una", "con", "del", "della", "ma", "sono", "ho", "questo", "anche", "più", "come", "bene", "nel", "alla", "dopo", "tutto"},
+	"pt": {"o", "os", "e", "é", "muito", "não", "que", "para", "com", "uma", "um", "do", "da", "mas", "em", "no", "na", "meu", "bem", "foi", "está", "produto", "mais", "isso", "ótimo"},
+	"nl": {"de", "het", "en", "is", "een", "niet", "ik", "van", "met", "zeer", "heel", "maar", "voor", "op", "dat", "die", "ook", "goed", "na", "wel", "nog", "erg", "mijn", "zijn", "naar"},
+	"pl": {"i", "jest", "nie", "się", "na", "bardzo", "to", "że", "w", "z", "do", "ale", "jak", "po", "dla", "tak", "mi", "już", "tylko", "był", "polecam", "jestem", "ten", "co", "od"},
+	"sv": {"och", "är", "det", "inte", "jag", "en", "ett", "med", "mycket", "för", "som", "på", "av", "men", "har", "till", "var", "bra", "den", "om", "efter", "också", "så", "min", "kan"},
+	"tr": {"ve", "bir", "bu", "çok", "için", "ile", "da", "de", "ama", "gibi", "değil", "var", "yok", "ben", "daha", "iyi", "olarak", "sonra", "kadar", "güzel", "ürün", "en", "mi", "her", "hiç"},
+}
+
+// distinctive letters that only occur in some of the languages above.
+var distinctive = map[rune]string{
+	'ß': "de", 'ñ': "es", 'ã': "pt", 'õ': "pt",
+	'ł': "pl", 'ą': "pl", 'ę': "pl", 'ś': "pl", 'ź': "pl", 'ż': "pl", 'ć': "pl", 'ń': "pl",
+	'å': "sv", 'ı': "tr", 'ğ': "tr", 'ş': "tr",
+}
+
+// Detector guesses the language of short texts such as review comments. It
+// tells languages with a script of their own apart by Unicode ranges, and
+// ten Latin-script languages by their function words. Other languages are
+// left undetected.
+type Detector struct {
+	stopwords map[string]map[string]bool
+}
+
+func NewDetector() *Detector {
+	d := &Detector{stopwords: make(map[string]map[string]bool, len(stopwords))}
+	for lang, words := range stopwords {
+		d.stopwords[lang] = make(map[string]bool, len(words))
+		for _, word := range words {
+			d.stopwords[lang][word] = true
+		}
+	}
+	return d
+}
+
+// Detect returns the ISO 639-1 code of the language text is written in, or
+// false when it cannot tell.
+func (d *Detector) Detect(text string) (string, bool) {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	})
+
+	scripts := make(map[string]int)
+	var letters int
+	for _, r := range text {
+		if script := scriptOf(r); script != "" {
+			scripts[script]++
+			letters++
+		}
+	}
+	if letters == 0 {
+		return "", false
+	}
+
+	script, count := "", 0
+	for s, c := range scripts {
+		if c > count || (c == count && s < script) {
+			script, count = s, c
+		}
+	}
+	// Japanese mixes kana with Han characters
+	if scripts["kana"] > 0 && script == "han" {
+		script = "kana"
+	}
+
+	switch script {
+	case "latin":
+		return d.detectLatin(words, text)
+	case "cyrillic":
+		return detectCyrillic(text), true
+	case "han":
+		return "zh", true
+	}
+	if lang, ok := scriptLanguages[script]; ok {
+		return lang, true
+	}
+	return "", false
+}
+
+// scriptLanguages maps scripts used by a single common language to it.
+var scriptLanguages = map[string]string{
+	"kana":       "ja",
+	"hangul":     "ko",
+	"arabic":     "ar",
+	"hebrew":     "he",
+	"greek":      "el",
+	"thai":       "th",
+	"devanagari": "hi",
+}
+
+func scriptOf(r rune) string {
+	switch {
+	case unicode.Is(unicode.Latin, r):
+		return "latin"
+	case unicode.Is(unicode.Cyrillic, r):
+		return "cyrillic"
+	case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+		return "kana"
+	case unicode.Is(unicode.Han, r):
+		return "han"
+	case unicode.Is(unicode.Hangul, r):
+		return "hangul"
+	case unicode.Is(unicode.Arabic, r):
+		return "arabic"
+	case unicode.Is(unicode.Hebrew, r):
+		return "hebrew"
+	case unicode.Is(unicode.Greek, r):
+		return "greek"
+	case unicode.Is(unicode.Thai, r):
+		return "thai"
+	case unicode.Is(unicode.Devanagari, r):
+		return "devanagari"
+	}
+	return ""
+}
+
+func (d *Detector) detectLatin(words []string, text string) (string, bool) {
+	if len(words) < minWords {
+		return "", false
+	}
+
+	scores := make(map[string]int)
+	for _, word := range words {
+		for lang, set := range d.stopwords {
+			if set[word] {
+				scores[lang]++
+			}
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		if lang := distinctive[r]; lang != "" {
+			scores[lang]++
+		}
+	}
+
+	best, second := "", 0
+	for lang, score := range scores {
+		switch {
+		case best == "" || score > scores[best] || (score == scores[best] && lang < best):
+			if best != "" && scores[best] > second {
+				second = scores[best]
+			}
+			best = lang
+		case score > second:
+			second = score
+		}
+	}
+	// Ties are too close to call
+	if best == "" || scores[best] < minStopwords || scores[best] == second {
+		return "", false
+	}
+	return best, true
+}
+
+// detectCyrillic tells Ukrainian from Russian by the letters only Ukrainian
+// uses, and defaults to Russian.
+func detectCyrillic(text string) string {
+	if strings.ContainsAny(strings.ToLower(text), "іїєґ") {
+		return "uk"
+	}
+	return "ru"
+}
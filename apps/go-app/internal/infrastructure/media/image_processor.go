@@ -0,0 +1,135 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	domainerrors "user-review-ingest/internal/domain/errors"
+)
+
+const (
+	jpegQuality = 85
+	// maxPixels guards against decompression bombs: small files that decode
+	// into enormous bitmaps.
+	maxPixels = 40_000_000
+)
+
+// ProcessedImage is an upload re-encoded without metadata, plus its thumbnail.
+type ProcessedImage struct {
+	ContentType string
+	Extension   string
+	Data        []byte
+	Width       int
+	Height      int
+
+	ThumbnailContentType string
+	ThumbnailExtension   string
+	Thumbnail            []byte
+}
+
+// ImageProcessor validates uploaded images and prepares them for publishing.
+type ImageProcessor struct {
+	thumbnailSize int
+}
+
+func NewImageProcessor(thumbnailSize int) *ImageProcessor {
+	return &ImageProcessor{thumbnailSize: thumbnailSize}
+}
+
+// Process sniffs the content type of data, rejects anything that is not a
+// JPEG, PNG or GIF, and re-encodes the image. Re-encoding drops EXIF and all
+// other embedded metadata; JPEG orientation is applied to the pixels first so
+// photos keep displaying upright.
+func (p *ImageProcessor) Process(data []byte) (*ProcessedImage, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+	default:
+		return nil, domainerrors.ErrUnsupportedMediaType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, domainerrors.ErrInvalidImage
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, domainerrors.ErrImageTooLarge
+	}
+
+	result := &ProcessedImage{ContentType: contentType}
+	var frame image.Image
+	var buf bytes.Buffer
+
+	switch contentType {
+	case "image/jpeg":
+		img, err := jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, domainerrors.ErrInvalidImage
+		}
+		frame = applyOrientation(img, jpegOrientation(data))
+		if err := jpeg.Encode(&buf, frame, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+		result.Extension = "jpg"
+
+	case "image/png":
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, domainerrors.ErrInvalidImage
+		}
+		frame = img
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+		result.Extension = "png"
+
+	case "image/gif":
+		// Keep animations intact; re-encoding discards comment and application extensions.
+		anim, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil || len(anim.Image) == 0 {
+			return nil, domainerrors.ErrInvalidImage
+		}
+		frame = anim.Image[0]
+		if err := gif.EncodeAll(&buf, anim); err != nil {
+			return nil, err
+		}
+		result.Extension = "gif"
+	}
+
+	result.Data = buf.Bytes()
+	result.Width = frame.Bounds().Dx()
+	result.Height = frame.Bounds().Dy()
+
+	thumbnail, err := p.thumbnail(frame, contentType)
+	if err != nil {
+		return nil, err
+	}
+	result.Thumbnail = thumbnail
+	if contentType == "image/jpeg" {
+		result.ThumbnailContentType, result.ThumbnailExtension = "image/jpeg", "jpg"
+	} else {
+		// PNG keeps the transparency GIFs and PNGs may carry.
+		result.ThumbnailContentType, result.ThumbnailExtension = "image/png", "png"
+	}
+
+	return result, nil
+}
+
+func (p *ImageProcessor) thumbnail(img image.Image, contentType string) ([]byte, error) {
+	thumb := resizeToFit(img, p.thumbnailSize)
+
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/jpeg" {
+		err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&buf, thumb)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
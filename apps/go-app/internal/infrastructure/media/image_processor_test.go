@@ -0,0 +1,161 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	domainerrors "user-review-ingest/internal/domain/errors"
+)
+
+func testImage(width, height int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 40), G: uint8(y * 40), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+// jpegWithGPS encodes img as a JPEG carrying an EXIF segment with an
+// orientation tag and a GPS IFD, the way phone cameras write them.
+func jpegWithGPS(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+
+	be := binary.BigEndian
+	tiff := []byte("MM\x00\x2a")
+	tiff = be.AppendUint32(tiff, 8)
+	// IFD0: orientation and a pointer to the GPS IFD at offset 38.
+	tiff = be.AppendUint16(tiff, 2)
+	tiff = be.AppendUint16(tiff, 0x0112)
+	tiff = be.AppendUint16(tiff, 3)
+	tiff = be.AppendUint32(tiff, 1)
+	tiff = be.AppendUint16(tiff, orientation)
+	tiff = be.AppendUint16(tiff, 0)
+	tiff = be.AppendUint16(tiff, 0x8825)
+	tiff = be.AppendUint16(tiff, 4)
+	tiff = be.AppendUint32(tiff, 1)
+	tiff = be.AppendUint32(tiff, 38)
+	tiff = be.AppendUint32(tiff, 0)
+	// GPS IFD: latitude 52°N.
+	tiff = be.AppendUint16(tiff, 2)
+	tiff = be.AppendUint16(tiff, 0x0001)
+	tiff = be.AppendUint16(tiff, 2)
+	tiff = be.AppendUint32(tiff, 2)
+	tiff = append(tiff, 'N', 0, 0, 0)
+	tiff = be.AppendUint16(tiff, 0x0002)
+	tiff = be.AppendUint16(tiff, 5)
+	tiff = be.AppendUint32(tiff, 3)
+	tiff = be.AppendUint32(tiff, 68)
+	tiff = be.AppendUint32(tiff, 0)
+	for _, v := range []uint32{52, 1, 0, 1, 0, 1} {
+		tiff = be.AppendUint32(tiff, v)
+	}
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = be.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	data := encoded.Bytes()
+	out := append([]byte{}, data[:2]...) // SOI
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+// hasAPP1 reports whether a JPEG still carries an APP1 (EXIF/XMP) segment.
+func hasAPP1(data []byte) bool {
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return false
+		}
+		marker := data[i+1]
+		if marker == 0xE1 {
+			return true
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			return false
+		}
+		i += 2 + int(binary.BigEndian.Uint16(data[i+2:]))
+	}
+	return false
+}
+
+func TestImageProcessorProcess(t *testing.T) {
+	// A GIF header claiming 10000x10000 pixels: tiny on disk, 100 megapixels decoded.
+	gifBomb := []byte("GIF89a\x10\x27\x10\x27\x00\x00\x00")
+
+	// A valid PNG with an HTML payload appended, which browsers may sniff
+	// and render if the original bytes were served back.
+	polyglot := append(encodePNG(t, testImage(3, 3)), []byte("<html><script>alert(document.cookie)</script></html>")...)
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantErr    error
+		wantType   string
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "jpeg with gps exif", data: jpegWithGPS(t, testImage(4, 2), 6), wantType: "image/jpeg", wantWidth: 2, wantHeight: 4},
+		{name: "png", data: encodePNG(t, testImage(5, 3)), wantType: "image/png", wantWidth: 5, wantHeight: 3},
+		{name: "png with appended html", data: polyglot, wantType: "image/png", wantWidth: 3, wantHeight: 3},
+		{name: "oversized", data: gifBomb, wantErr: domainerrors.ErrImageTooLarge},
+		{name: "html named as image", data: []byte("<!DOCTYPE html><html><body>hi</body></html>"), wantErr: domainerrors.ErrUnsupportedMediaType},
+		{name: "svg with script", data: []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), wantErr: domainerrors.ErrUnsupportedMediaType},
+		{name: "jpeg magic followed by script", data: append([]byte{0xFF, 0xD8, 0xFF, 0xE0}, []byte("<script>alert(1)</script>")...), wantErr: domainerrors.ErrInvalidImage},
+		{name: "empty", data: nil, wantErr: domainerrors.ErrUnsupportedMediaType},
+	}
+
+	processor := NewImageProcessor(2)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := processor.Process(tt.data)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Process() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+
+			if got.ContentType != tt.wantType {
+				t.Errorf("ContentType = %q, want %q", got.ContentType, tt.wantType)
+			}
+			if got.Width != tt.wantWidth || got.Height != tt.wantHeight {
+				t.Errorf("size = %dx%d, want %dx%d", got.Width, got.Height, tt.wantWidth, tt.wantHeight)
+			}
+			if got.ContentType == "image/jpeg" && hasAPP1(got.Data) {
+				t.Error("re-encoded JPEG still carries an APP1 segment")
+			}
+			for _, leak := range []string{"Exif", "<script"} {
+				if bytes.Contains(got.Data, []byte(leak)) || bytes.Contains(got.Thumbnail, []byte(leak)) {
+					t.Errorf("output still contains %q", leak)
+				}
+			}
+			if _, _, err := image.Decode(bytes.NewReader(got.Thumbnail)); err != nil {
+				t.Errorf("thumbnail does not decode: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package logging
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+
+	"github.com/rs/zerolog"
+)
+
+// Publisher writes every event to the structured log, where a log shipper can
+// pick it up. It never fails.
+type Publisher struct {
+	logger *zerolog.Logger
+}
+
+func NewPublisher(logger *zerolog.Logger) *Publisher {
+	return &Publisher{logger: logger}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	p.logger.Info().
+		Int64("event_id", event.ID).
+		Int64("tenant_id", event.TenantID).
+		Str("event_type", event.EventType).
+		Str("aggregate_type", event.AggregateType).
+		Int64("aggregate_id", event.AggregateID).
+		RawJSON("payload", event.Payload).
+		Msg("domain event")
+	return nil
+}
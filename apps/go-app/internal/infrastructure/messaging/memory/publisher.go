@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// Publisher keeps published events in memory, for tests and local runs.
+type Publisher struct {
+	mu     sync.Mutex
+	events []*entity.OutboxEvent
+	err    error
+}
+
+func NewPublisher() *Publisher {
+	return &Publisher{}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+
+	published := *event
+	p.events = append(p.events, &published)
+	return nil
+}
+
+// Events returns the events published so far, oldest first.
+func (p *Publisher) Events() []*entity.OutboxEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*entity.OutboxEvent(nil), p.events...)
+}
+
+// FailWith makes every following Publish call return err; nil restores success.
+func (p *Publisher) FailWith(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
+
+// Reset forgets the published events.
+func (p *Publisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = nil
+}
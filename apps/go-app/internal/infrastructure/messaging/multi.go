@@ -0,0 +1,26 @@
+package messaging
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// MultiPublisher hands every event to several publishers in turn. It stops at
+// the first failure; the event is then retried as a whole, so the publishers
+// before the failing one see it again.
+type MultiPublisher struct {
+	publishers []Publisher
+}
+
+func NewMultiPublisher(publishers ...Publisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package pgnotify
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+)
+
+// Reconnection delays grow exponentially up to maxReconnectDelay.
+const (
+	baseReconnectDelay = time.Second
+	maxReconnectDelay  = 30 * time.Second
+)
+
+// Listener receives Postgres notifications on one channel over a dedicated
+// connection, outside the pool, so that every instance of the service hears
+// what any of them committed.
+type Listener struct {
+	databaseURL string
+	channel     string
+	logger      *zerolog.Logger
+}
+
+func NewListener(databaseURL, channel string, logger *zerolog.Logger) *Listener {
+	return &Listener{
+		databaseURL: databaseURL,
+		channel:     channel,
+		logger:      logger,
+	}
+}
+
+// Listen passes the payload of every notification to handle until ctx is
+// cancelled, reconnecting whenever the connection is lost. Notifications sent
+// while disconnected are lost; onConnect runs after every (re)connection so
+// that callers can catch up on what they missed.
+func (l *Listener) Listen(ctx context.Context, handle func(payload string), onConnect func()) {
+	delay := baseReconnectDelay
+	for {
+		err := l.listen(ctx, handle, func() {
+			delay = baseReconnectDelay
+			onConnect()
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		l.logger.Warn().Err(err).Str("channel", l.channel).Dur("retry_in", delay).Msg("Notification listener disconnected")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context, handle func(payload string), onConnect func()) error {
+	conn, err := pgx.Connect(ctx, l.databaseURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return err
+	}
+	onConnect()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		handle(notification.Payload)
+	}
+}
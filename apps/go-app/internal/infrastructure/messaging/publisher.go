@@ -0,0 +1,13 @@
+package messaging
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+)
+
+// Publisher delivers domain events to downstream consumers. Delivery is
+// at-least-once, so consumers must tolerate duplicates, recognisable by the
+// event ID.
+type Publisher interface {
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuditRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditRepositoryImpl(db *pgxpool.Pool) repository.AuditRepository {
+	return &AuditRepositoryImpl{db: db}
+}
+
+func (r *AuditRepositoryImpl) Record(ctx context.Context, entry *entity.AuditEntry) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).CreateAuditEntry(ctx, sqlc.CreateAuditEntryParams{
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Action:     entry.Action,
+		Actor:      entry.Actor,
+		Before:     entry.Before,
+		After:      entry.After,
+		TenantID:   tenantID,
+	})
+}
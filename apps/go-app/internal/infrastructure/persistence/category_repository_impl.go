@@ -0,0 +1,213 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CategoryRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewCategoryRepositoryImpl(db *pgxpool.Pool) repository.CategoryRepository {
+	return &CategoryRepositoryImpl{db: db}
+}
+
+func (r *CategoryRepositoryImpl) Create(ctx context.Context, category *entity.Category) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	scaleMin, scaleMax, scaleStep := optionalRatingScale(category.RatingScale)
+	created, err := queriesFor(ctx, r.db).CreateCategory(ctx, sqlc.CreateCategoryParams{
+		Slug:            category.Slug,
+		Name:            category.Name,
+		RatingScaleMin:  scaleMin,
+		RatingScaleMax:  scaleMax,
+		RatingScaleStep: scaleStep,
+		TenantID:        tenantID,
+	})
+	if err != nil {
+		return categoryWriteError(err)
+	}
+
+	category.ID = created.ID
+	category.CreatedAt = created.CreatedAt.Time
+	category.UpdatedAt = created.UpdatedAt.Time
+	return nil
+}
+
+func (r *CategoryRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.Category, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	row, err := queries.GetCategory(ctx, sqlc.GetCategoryParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	aspects, err := queries.ListCategoryAspects(ctx, sqlc.ListCategoryAspectsParams{
+		CategoryID: id,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	category := toCategoryEntity(row)
+	for _, aspect := range aspects {
+		category.Aspects = append(category.Aspects, toCategoryAspectEntity(aspect))
+	}
+	return category, nil
+}
+
+func (r *CategoryRepositoryImpl) List(ctx context.Context, offset, limit int) ([]*entity.Category, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListCategories(ctx, sqlc.ListCategoriesParams{
+		TenantID: tenantID,
+		Offset:   int32(offset),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Category, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toCategoryEntity(row))
+	}
+	return result, nil
+}
+
+func (r *CategoryRepositoryImpl) Update(ctx context.Context, category *entity.Category) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	scaleMin, scaleMax, scaleStep := optionalRatingScale(category.RatingScale)
+	updated, err := queriesFor(ctx, r.db).UpdateCategory(ctx, sqlc.UpdateCategoryParams{
+		Slug:            category.Slug,
+		Name:            category.Name,
+		RatingScaleMin:  scaleMin,
+		RatingScaleMax:  scaleMax,
+		RatingScaleStep: scaleStep,
+		ID:              category.ID,
+		TenantID:        tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrCategoryNotFound
+		}
+		return categoryWriteError(err)
+	}
+
+	category.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
+func (r *CategoryRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := queriesFor(ctx, r.db).DeleteCategory(ctx, sqlc.DeleteCategoryParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domainerrors.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (r *CategoryRepositoryImpl) ReplaceAspects(ctx context.Context, category *entity.Category) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	// Never nil: key <> ALL(NULL) would match no aspect at all
+	keys := make([]string, 0, len(category.Aspects))
+	for i, aspect := range category.Aspects {
+		saved, err := queries.UpsertCategoryAspect(ctx, sqlc.UpsertCategoryAspectParams{
+			CategoryID: category.ID,
+			Key:        aspect.Key,
+			Name:       aspect.Name,
+			Required:   aspect.Required,
+			Position:   int32(i),
+			TenantID:   tenantID,
+		})
+		if err != nil {
+			return err
+		}
+		category.Aspects[i] = toCategoryAspectEntity(saved)
+		keys = append(keys, aspect.Key)
+	}
+
+	return queries.DeleteCategoryAspectsNotInKeys(ctx, sqlc.DeleteCategoryAspectsNotInKeysParams{
+		CategoryID: category.ID,
+		TenantID:   tenantID,
+		Keys:       keys,
+	})
+}
+
+// categoryWriteError maps constraint violations to domain errors.
+func categoryWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domainerrors.ErrCategoryExists
+	}
+	return err
+}
+
+func toCategoryEntity(category sqlc.Category) *entity.Category {
+	return &entity.Category{
+		ID:        category.ID,
+		Slug:      category.Slug,
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt.Time,
+		UpdatedAt: category.UpdatedAt.Time,
+		RatingScale: ratingScalePtr(
+			category.RatingScaleMin, category.RatingScaleMax, category.RatingScaleStep,
+		),
+	}
+}
+
+func toCategoryAspectEntity(aspect sqlc.CategoryAspect) *entity.CategoryAspect {
+	return &entity.CategoryAspect{
+		ID:         aspect.ID,
+		CategoryID: aspect.CategoryID,
+		Key:        aspect.Key,
+		Name:       aspect.Name,
+		Required:   aspect.Required,
+		Position:   int(aspect.Position),
+	}
+}
@@ -0,0 +1,508 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DataSubjectRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewDataSubjectRepositoryImpl(db *pgxpool.Pool) repository.DataSubjectRepository {
+	return &DataSubjectRepositoryImpl{db: db}
+}
+
+func (r *DataSubjectRepositoryImpl) Collect(ctx context.Context, userID int64, accountID *string) (*entity.PersonalData, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	data := &entity.PersonalData{}
+
+	reviews, err := queries.ListReviewsByUser(ctx, sqlc.ListReviewsByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		entityReview, err := toReviewEntity(review)
+		if err != nil {
+			return nil, err
+		}
+		data.Reviews = append(data.Reviews, entityReview)
+	}
+
+	votes, err := queries.ListReviewVotesByUser(ctx, sqlc.ListReviewVotesByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		data.Votes = append(data.Votes, &entity.ReviewVote{
+			ReviewID:  vote.ReviewID,
+			UserID:    vote.UserID,
+			Helpful:   vote.Helpful,
+			CreatedAt: vote.CreatedAt.Time,
+			UpdatedAt: vote.UpdatedAt.Time,
+		})
+	}
+
+	reports, err := queries.ListReviewReportsByReporter(ctx, sqlc.ListReviewReportsByReporterParams{TenantID: tenantID, ReporterID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range reports {
+		data.Reports = append(data.Reports, toReviewReportEntity(report))
+	}
+
+	replies, err := queries.ListReviewRepliesByAuthor(ctx, sqlc.ListReviewRepliesByAuthorParams{TenantID: tenantID, AuthorID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, reply := range replies {
+		data.Replies = append(data.Replies, toReviewReplyEntity(reply))
+	}
+
+	questions, err := queries.ListProductQuestionsByUser(ctx, sqlc.ListProductQuestionsByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, question := range questions {
+		data.Questions = append(data.Questions, toProductQuestionEntity(question))
+	}
+
+	answers, err := queries.ListProductAnswersByUser(ctx, sqlc.ListProductAnswersByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, answer := range answers {
+		data.Answers = append(data.Answers, toProductAnswerEntity(answer))
+	}
+
+	answerVotes, err := queries.ListProductAnswerVotesByUser(ctx, sqlc.ListProductAnswerVotesByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range answerVotes {
+		data.AnswerVotes = append(data.AnswerVotes, &entity.ProductAnswerVote{
+			AnswerID:  vote.AnswerID,
+			UserID:    vote.UserID,
+			Helpful:   vote.Helpful,
+			CreatedAt: vote.CreatedAt.Time,
+			UpdatedAt: vote.UpdatedAt.Time,
+		})
+	}
+
+	attachments, err := queries.ListReviewAttachmentsByUser(ctx, sqlc.ListReviewAttachmentsByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		data.Attachments = append(data.Attachments, toReviewAttachmentEntity(attachment))
+	}
+
+	orders, err := queries.ListOrdersByCustomer(ctx, sqlc.ListOrdersByCustomerParams{TenantID: tenantID, CustomerID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range orders {
+		data.Orders = append(data.Orders, toOrderEntity(order))
+	}
+
+	invitations, err := queries.ListReviewInvitationsByCustomer(ctx, sqlc.ListReviewInvitationsByCustomerParams{TenantID: tenantID, CustomerID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range invitations {
+		data.Invitations = append(data.Invitations, toReviewInvitationEntity(invitation))
+	}
+
+	stats, err := queries.GetReviewerStatsByUser(ctx, sqlc.GetReviewerStatsByUserParams{TenantID: tenantID, UserID: userID})
+	switch {
+	case err == nil:
+		data.Reviewer = toReviewerProfileEntity(stats)
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, err
+	}
+
+	ownerships, err := queries.ListProductOwnershipsByUser(ctx, sqlc.ListProductOwnershipsByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, ownership := range ownerships {
+		data.OwnedProductIDs = append(data.OwnedProductIDs, ownership.ProductID)
+	}
+
+	jobs, err := queries.ListExportJobsByRequester(ctx, sqlc.ListExportJobsByRequesterParams{TenantID: tenantID, RequestedBy: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		entityJob, err := toExportJobEntity(job)
+		if err != nil {
+			return nil, err
+		}
+		data.ExportJobs = append(data.ExportJobs, entityJob)
+	}
+
+	if accountID != nil {
+		if data.Account, err = r.collectAccount(ctx, queries, tenantID, *accountID); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+func (r *DataSubjectRepositoryImpl) collectAccount(ctx context.Context, queries *sqlc.Queries, tenantID int64, accountID string) (*entity.UserAccount, error) {
+	var id pgtype.UUID
+	if err := id.Scan(accountID); err != nil {
+		return nil, err
+	}
+
+	auth, err := queries.GetAuthAccount(ctx, sqlc.GetAuthAccountParams{ID: id, TenantID: tenantID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	account := &entity.UserAccount{
+		Auth: &entity.UserAuth{
+			ID:        auth.ID.String(),
+			Email:     auth.Email.String,
+			Status:    auth.Status,
+			CreatedAt: auth.CreatedAt.Time,
+			UpdatedAt: auth.UpdatedAt.Time,
+			DeletedAt: timestamptzPtr(auth.DeletedAt),
+		},
+	}
+
+	if auth.Email.Valid {
+		profiles, err := queries.ListUserProfilesByEmail(ctx, sqlc.ListUserProfilesByEmailParams{TenantID: tenantID, Email: auth.Email.String})
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range profiles {
+			account.Profiles = append(account.Profiles, &entity.UserProfile{
+				ID:        profile.ID.String(),
+				Email:     profile.Email,
+				Name:      profile.Name.String,
+				CreatedAt: profile.CreatedAt.Time,
+				UpdatedAt: profile.UpdatedAt.Time,
+			})
+		}
+	}
+
+	connections, err := queries.ListOAuthProvidersByUser(ctx, sqlc.ListOAuthProvidersByUserParams{TenantID: tenantID, UserID: id})
+	if err != nil {
+		return nil, err
+	}
+	for _, connection := range connections {
+		account.Connections = append(account.Connections, &entity.OAuthConnection{
+			ID:             connection.ID.String(),
+			UserID:         connection.UserID.String(),
+			ProviderName:   connection.ProviderName,
+			ProviderUserID: connection.ProviderUserID,
+			Email:          connection.Email.String,
+			Name:           connection.Name.String,
+			AccessToken:    connection.AccessToken.String,
+			RefreshToken:   connection.RefreshToken.String,
+			ExpiresAt:      connection.ExpiresAt.Time,
+			Scopes:         connection.Scopes,
+			CreatedAt:      connection.CreatedAt.Time,
+			UpdatedAt:      connection.UpdatedAt.Time,
+		})
+	}
+
+	return account, nil
+}
+
+func (r *DataSubjectRepositoryImpl) Erase(ctx context.Context, userID int64, accountID *string, reviewPolicy string) (*entity.Erasure, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	summary := make(map[string]int64)
+	erasure := &entity.Erasure{Summary: summary}
+
+	// Look the account up first, so that nothing is erased for an account
+	// of another tenant
+	var account *sqlc.Auth
+	if accountID != nil {
+		var id pgtype.UUID
+		if err := id.Scan(*accountID); err != nil {
+			return nil, err
+		}
+		auth, err := queries.GetAuthAccount(ctx, sqlc.GetAuthAccountParams{ID: id, TenantID: tenantID})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, domainerrors.ErrUserNotFound
+			}
+			return nil, err
+		}
+		account = &auth
+	}
+
+	// Copies of the user's content kept elsewhere are redacted first, while
+	// the content still names the user
+	if summary["audit_snapshots_redacted"], err = queries.RedactAuditSnapshotsByUser(ctx, sqlc.RedactAuditSnapshotsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+		return nil, err
+	}
+	if summary["audit_actors_redacted"], err = queries.RedactAuditActor(ctx, sqlc.RedactAuditActorParams{TenantID: tenantID, Actor: entity.UserActor(userID)}); err != nil {
+		return nil, err
+	}
+	eventIDs, err := queries.RedactOutboxEventsByUser(ctx, sqlc.RedactOutboxEventsByUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	summary["events_redacted"] = int64(len(eventIDs))
+	if summary["webhook_deliveries_redacted"], err = queries.RedactWebhookDeliveriesByEvents(ctx, sqlc.RedactWebhookDeliveriesByEventsParams{TenantID: tenantID, EventIds: eventIDs}); err != nil {
+		return nil, err
+	}
+	if summary["velocity_counters"], err = queries.DeleteVelocityCountersByUser(ctx, sqlc.DeleteVelocityCountersByUserParams{TenantID: tenantID, Subject: strconv.FormatInt(userID, 10)}); err != nil {
+		return nil, err
+	}
+
+	// Votes go first, so that they come off the counts of reviews that remain
+	if summary["votes"], err = queries.EraseReviewVotesByUser(ctx, sqlc.EraseReviewVotesByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	if summary["answer_votes"], err = queries.EraseProductAnswerVotesByUser(ctx, sqlc.EraseProductAnswerVotesByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	if summary["reports"], err = queries.DeleteReviewReportsByReporter(ctx, sqlc.DeleteReviewReportsByReporterParams{TenantID: tenantID, ReporterID: userID}); err != nil {
+		return nil, err
+	}
+
+	var reviews []sqlc.Review
+	if reviewPolicy == entity.ReviewErasureDelete {
+		if reviews, err = queries.DeleteReviewsByUser(ctx, sqlc.DeleteReviewsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+			return nil, err
+		}
+		summary["reviews_deleted"] = int64(len(reviews))
+	} else {
+		if reviews, err = queries.AnonymizeReviewsByUser(ctx, sqlc.AnonymizeReviewsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+			return nil, err
+		}
+		summary["reviews_anonymized"] = int64(len(reviews))
+	}
+	for _, review := range reviews {
+		if review.DeletedAt.Valid {
+			continue
+		}
+		entityReview, err := toReviewEntity(review)
+		if err != nil {
+			return nil, err
+		}
+		erasure.Reviews = append(erasure.Reviews, entityReview)
+	}
+
+	if summary["replies_anonymized"], err = queries.AnonymizeReviewRepliesByAuthor(ctx, sqlc.AnonymizeReviewRepliesByAuthorParams{TenantID: tenantID, AuthorID: userID}); err != nil {
+		return nil, err
+	}
+
+	// Questions and answers follow the review policy, except brand answers,
+	// which are anonymized like replies
+	if reviewPolicy == entity.ReviewErasureDelete {
+		if summary["questions_deleted"], err = queries.DeleteProductQuestionsByUser(ctx, sqlc.DeleteProductQuestionsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+			return nil, err
+		}
+		if summary["answers_deleted"], err = queries.DeleteProductAnswersByUser(ctx, sqlc.DeleteProductAnswersByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+			return nil, err
+		}
+	} else {
+		if summary["questions_anonymized"], err = queries.AnonymizeProductQuestionsByUser(ctx, sqlc.AnonymizeProductQuestionsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+			return nil, err
+		}
+	}
+	if summary["answers_anonymized"], err = queries.AnonymizeProductAnswersByUser(ctx, sqlc.AnonymizeProductAnswersByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+		return nil, err
+	}
+	if summary["reviewer_stats"], err = queries.DeleteReviewerStatsByUser(ctx, sqlc.DeleteReviewerStatsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+		return nil, err
+	}
+	if summary["review_invitations"], err = queries.DeleteReviewInvitationsByCustomer(ctx, sqlc.DeleteReviewInvitationsByCustomerParams{TenantID: tenantID, CustomerID: userID}); err != nil {
+		return nil, err
+	}
+	if summary["orders"], err = queries.DeleteOrdersByCustomer(ctx, sqlc.DeleteOrdersByCustomerParams{TenantID: tenantID, CustomerID: userID}); err != nil {
+		return nil, err
+	}
+	if summary["product_ownerships"], err = queries.DeleteProductOwnershipsByUser(ctx, sqlc.DeleteProductOwnershipsByUserParams{TenantID: tenantID, UserID: userID}); err != nil {
+		return nil, err
+	}
+	if summary["export_jobs"], err = queries.DeleteExportJobsByRequester(ctx, sqlc.DeleteExportJobsByRequesterParams{TenantID: tenantID, RequestedBy: userID}); err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return erasure, nil
+	}
+
+	if summary["oauth_connections"], err = queries.DeleteOAuthProvidersByUser(ctx, sqlc.DeleteOAuthProvidersByUserParams{TenantID: tenantID, UserID: account.ID}); err != nil {
+		return nil, err
+	}
+	if account.Email.Valid {
+		if summary["profiles"], err = queries.DeleteUserProfilesByEmail(ctx, sqlc.DeleteUserProfilesByEmailParams{TenantID: tenantID, Email: account.Email.String}); err != nil {
+			return nil, err
+		}
+	}
+	if summary["accounts"], err = queries.DeleteAuthAccount(ctx, sqlc.DeleteAuthAccountParams{ID: account.ID, TenantID: tenantID}); err != nil {
+		return nil, err
+	}
+
+	return erasure, nil
+}
+
+type DataSubjectRequestRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewDataSubjectRequestRepositoryImpl(db *pgxpool.Pool) repository.DataSubjectRequestRepository {
+	return &DataSubjectRequestRepositoryImpl{db: db}
+}
+
+func (r *DataSubjectRequestRepositoryImpl) Create(ctx context.Context, request *entity.DataSubjectRequest) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	summary, err := json.Marshal(request.Summary)
+	if err != nil {
+		return err
+	}
+	var accountID pgtype.UUID
+	if request.AccountID != nil {
+		if err := accountID.Scan(*request.AccountID); err != nil {
+			return err
+		}
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateDataSubjectRequest(ctx, sqlc.CreateDataSubjectRequestParams{
+		Kind:          request.Kind,
+		UserID:        request.UserID,
+		AccountID:     accountID,
+		RequestedBy:   request.RequestedBy,
+		ReviewPolicy:  optionalText(request.ReviewPolicy),
+		Summary:       summary,
+		ArchiveSha256: optionalText(request.ArchiveSHA256),
+		TenantID:      tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	createdRequest, err := toDataSubjectRequestEntity(created)
+	if err != nil {
+		return err
+	}
+	*request = *createdRequest
+	return nil
+}
+
+func (r *DataSubjectRequestRepositoryImpl) ListByUser(ctx context.Context, userID int64) ([]*entity.DataSubjectRequest, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := queriesFor(ctx, r.db).ListDataSubjectRequests(ctx, sqlc.ListDataSubjectRequestsParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.DataSubjectRequest, 0, len(requests))
+	for _, request := range requests {
+		entityRequest, err := toDataSubjectRequestEntity(request)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entityRequest)
+	}
+	return result, nil
+}
+
+func toDataSubjectRequestEntity(request sqlc.DataSubjectRequest) (*entity.DataSubjectRequest, error) {
+	var summary map[string]int64
+	if err := json.Unmarshal(request.Summary, &summary); err != nil {
+		return nil, err
+	}
+
+	var accountID *string
+	if request.AccountID.Valid {
+		id := request.AccountID.String()
+		accountID = &id
+	}
+
+	return &entity.DataSubjectRequest{
+		ID:            request.ID,
+		Kind:          request.Kind,
+		UserID:        request.UserID,
+		AccountID:     accountID,
+		RequestedBy:   request.RequestedBy,
+		ReviewPolicy:  textPtr(request.ReviewPolicy),
+		Summary:       summary,
+		ArchiveSHA256: textPtr(request.ArchiveSha256),
+		FulfilledAt:   request.FulfilledAt.Time,
+	}, nil
+}
+
+type TokenRevocationRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewTokenRevocationRepositoryImpl(db *pgxpool.Pool) repository.TokenRevocationRepository {
+	return &TokenRevocationRepositoryImpl{db: db}
+}
+
+func (r *TokenRevocationRepositoryImpl) Revoke(ctx context.Context, userID int64, before time.Time) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).RevokeTokens(ctx, sqlc.RevokeTokensParams{
+		UserID:        userID,
+		RevokedBefore: pgtype.Timestamptz{Time: before, Valid: true},
+		TenantID:      tenantID,
+	})
+}
+
+func (r *TokenRevocationRepositoryImpl) RevokedBefore(ctx context.Context, userID int64) (*time.Time, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revokedBefore, err := queriesFor(ctx, r.db).GetTokenRevocation(ctx, sqlc.GetTokenRevocationParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &revokedBefore.Time, nil
+}
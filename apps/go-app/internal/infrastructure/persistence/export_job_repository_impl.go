@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExportJobRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewExportJobRepositoryImpl(db *pgxpool.Pool) repository.ExportJobRepository {
+	return &ExportJobRepositoryImpl{db: db}
+}
+
+func (r *ExportJobRepositoryImpl) Create(ctx context.Context, job *entity.ExportJob) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	filters, err := json.Marshal(job.Filters)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateExportJob(ctx, sqlc.CreateExportJobParams{
+		RequestedBy: job.RequestedBy,
+		Format:      job.Format,
+		Gzip:        job.Gzip,
+		Filters:     filters,
+		TenantID:    tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	createdJob, err := toExportJobEntity(created)
+	if err != nil {
+		return err
+	}
+	*job = *createdJob
+	return nil
+}
+
+func (r *ExportJobRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.ExportJob, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := queriesFor(ctx, r.db).GetExportJob(ctx, sqlc.GetExportJobParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrExportJobNotFound
+		}
+		return nil, err
+	}
+
+	return toExportJobEntity(job)
+}
+
+func (r *ExportJobRepositoryImpl) Claim(ctx context.Context, lease time.Duration) (*entity.ExportJob, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := queriesFor(ctx, r.db).ClaimExportJob(ctx, sqlc.ClaimExportJobParams{
+		TenantID:     tenantID,
+		LeaseSeconds: int32(lease.Seconds()),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toExportJobEntity(job)
+}
+
+func (r *ExportJobRepositoryImpl) Complete(ctx context.Context, id int64, blobKey string, rowCount, sizeBytes int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).CompleteExportJob(ctx, sqlc.CompleteExportJobParams{
+		BlobKey:   pgtype.Text{String: blobKey, Valid: true},
+		RowCount:  pgtype.Int8{Int64: rowCount, Valid: true},
+		SizeBytes: pgtype.Int8{Int64: sizeBytes, Valid: true},
+		ID:        id,
+		TenantID:  tenantID,
+	})
+}
+
+func (r *ExportJobRepositoryImpl) Fail(ctx context.Context, id int64, reason string) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).FailExportJob(ctx, sqlc.FailExportJobParams{
+		Error:    pgtype.Text{String: reason, Valid: true},
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func toExportJobEntity(job sqlc.ExportJob) (*entity.ExportJob, error) {
+	var filters entity.ReviewExportFilters
+	if err := json.Unmarshal(job.Filters, &filters); err != nil {
+		return nil, err
+	}
+
+	return &entity.ExportJob{
+		ID:          job.ID,
+		RequestedBy: job.RequestedBy,
+		Format:      job.Format,
+		Gzip:        job.Gzip,
+		Filters:     filters,
+		Status:      job.Status,
+		BlobKey:     textPtr(job.BlobKey),
+		RowCount:    int8Ptr(job.RowCount),
+		SizeBytes:   int8Ptr(job.SizeBytes),
+		Error:       textPtr(job.Error),
+		CreatedAt:   job.CreatedAt.Time,
+		StartedAt:   timestamptzPtr(job.StartedAt),
+		FinishedAt:  timestamptzPtr(job.FinishedAt),
+	}, nil
+}
@@ -25,7 +25,15 @@ func NewOAuthRepositoryImpl(db *pgxpool.Pool) repository.OAuthRepository {
 }
 
 func (r *OAuthRepositoryImpl) FindUserAuthByEmail(ctx context.Context, email string) (*entity.UserAuth, error) {
-	user, err := r.queries.GetAuthUserByEmail(ctx, pgtype.Text{String: email, Valid: true})
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.queries.GetAuthUserByEmail(ctx, sqlc.GetAuthUserByEmailParams{
+		Email:    pgtype.Text{String: email, Valid: true},
+		TenantID: tenantID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -47,14 +55,22 @@ func (r *OAuthRepositoryImpl) FindUserAuthByEmail(ctx context.Context, email str
 }
 
 func (r *OAuthRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.UserAuth, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert string ID to UUID
 	var userUUID pgtype.UUID
-	err := userUUID.Scan(id)
+	err = userUUID.Scan(id)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := r.queries.GetAuthUserByID(ctx, userUUID)
+	user, err := r.queries.GetAuthUserByID(ctx, sqlc.GetAuthUserByIDParams{
+		ID:       userUUID,
+		TenantID: tenantID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -76,9 +92,15 @@ func (r *OAuthRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.
 }
 
 func (r *OAuthRepositoryImpl) FindOAuthConnectionByProviderID(ctx context.Context, provider, providerID string) (*entity.OAuthConnection, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	oauthConnection, err := r.queries.GetOAuthProviderByProviderID(ctx, sqlc.GetOAuthProviderByProviderIDParams{
 		ProviderName:   provider,
 		ProviderUserID: providerID,
+		TenantID:       tenantID,
 	})
 	if err != nil {
 		return nil, err
@@ -100,9 +122,14 @@ func (r *OAuthRepositoryImpl) FindOAuthConnectionByProviderID(ctx context.Contex
 }
 
 func (r *OAuthRepositoryImpl) CreateUserAuth(ctx context.Context, userAuth *entity.UserAuth) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert string ID to UUID
 	var idUUID pgtype.UUID
-	err := idUUID.Scan(userAuth.ID)
+	err = idUUID.Scan(userAuth.ID)
 	if err != nil {
 		// If ID is not set, generate a new one
 		idUUID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
@@ -113,14 +140,20 @@ func (r *OAuthRepositoryImpl) CreateUserAuth(ctx context.Context, userAuth *enti
 		Email:        pgtype.Text{String: userAuth.Email, Valid: userAuth.Email != ""},
 		PasswordHash: pgtype.Text{String: userAuth.PasswordHash, Valid: userAuth.PasswordHash != ""},
 		Status:       userAuth.Status,
+		TenantID:     tenantID,
 	})
 	return err
 }
 
 func (r *OAuthRepositoryImpl) UpdateUserAuth(ctx context.Context, userAuth *entity.UserAuth) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert string ID to UUID
 	var idUUID pgtype.UUID
-	err := idUUID.Scan(userAuth.ID)
+	err = idUUID.Scan(userAuth.ID)
 	if err != nil {
 		return err
 	}
@@ -130,14 +163,20 @@ func (r *OAuthRepositoryImpl) UpdateUserAuth(ctx context.Context, userAuth *enti
 		Email:     pgtype.Text{String: userAuth.Email, Valid: userAuth.Email != ""},
 		Status:    userAuth.Status,
 		UpdatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		TenantID:  tenantID,
 	})
 	return err
 }
 
 func (r *OAuthRepositoryImpl) CreateUserProfile(ctx context.Context, profile *entity.UserProfile) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert string ID to UUID
 	var idUUID pgtype.UUID
-	err := idUUID.Scan(profile.ID)
+	err = idUUID.Scan(profile.ID)
 	if err != nil {
 		// If ID is not set, generate a new one
 		idUUID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
@@ -149,14 +188,20 @@ func (r *OAuthRepositoryImpl) CreateUserProfile(ctx context.Context, profile *en
 		Name:      pgtype.Text{String: profile.Name, Valid: profile.Name != ""},
 		CreatedAt: pgtype.Timestamptz{Time: profile.CreatedAt, Valid: true},
 		UpdatedAt: pgtype.Timestamptz{Time: profile.UpdatedAt, Valid: true},
+		TenantID:  tenantID,
 	})
 	return err
 }
 
 func (r *OAuthRepositoryImpl) UpdateUserProfile(ctx context.Context, profile *entity.UserProfile) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert string ID to UUID
 	var idUUID pgtype.UUID
-	err := idUUID.Scan(profile.ID)
+	err = idUUID.Scan(profile.ID)
 	if err != nil {
 		return err
 	}
@@ -166,12 +211,21 @@ func (r *OAuthRepositoryImpl) UpdateUserProfile(ctx context.Context, profile *en
 		Email:     profile.Email, // Use string directly instead of pgtype.Text
 		Name:      pgtype.Text{String: profile.Name, Valid: profile.Name != ""},
 		UpdatedAt: pgtype.Timestamptz{Time: profile.UpdatedAt, Valid: true},
+		TenantID:  tenantID,
 	})
 	return err
 }
 
 func (r *OAuthRepositoryImpl) FindUserProfileByEmail(ctx context.Context, email string) (*entity.UserProfile, error) {
-	profile, err := r.queries.GetUserProfileByEmail(ctx, email) // Use string directly instead of pgtype.Text
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := r.queries.GetUserProfileByEmail(ctx, sqlc.GetUserProfileByEmailParams{
+		Email:    email, // Use string directly instead of pgtype.Text
+		TenantID: tenantID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -186,10 +240,15 @@ func (r *OAuthRepositoryImpl) FindUserProfileByEmail(ctx context.Context, email
 }
 
 func (r *OAuthRepositoryImpl) CreateOAuthConnection(ctx context.Context, connection *entity.OAuthConnection) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert string IDs to UUIDs
 	var userIDUUID, idUUID pgtype.UUID
 
-	err := userIDUUID.Scan(connection.UserID)
+	err = userIDUUID.Scan(connection.UserID)
 	if err != nil {
 		return err
 	}
@@ -211,14 +270,20 @@ func (r *OAuthRepositoryImpl) CreateOAuthConnection(ctx context.Context, connect
 		RefreshToken:   pgtype.Text{String: connection.RefreshToken, Valid: connection.RefreshToken != ""},
 		ExpiresAt:      pgtype.Timestamptz{Time: connection.ExpiresAt, Valid: true},
 		Scopes:         nil, // Scopes can be passed if needed
+		TenantID:       tenantID,
 	})
 	return err
 }
 
 func (r *OAuthRepositoryImpl) UpdateOAuthConnection(ctx context.Context, connection *entity.OAuthConnection) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert string ID to UUID
 	var idUUID pgtype.UUID
-	err := idUUID.Scan(connection.ID)
+	err = idUUID.Scan(connection.ID)
 	if err != nil {
 		return err
 	}
@@ -228,7 +293,7 @@ func (r *OAuthRepositoryImpl) UpdateOAuthConnection(ctx context.Context, connect
 		AccessToken:  pgtype.Text{String: connection.AccessToken, Valid: connection.AccessToken != ""},
 		RefreshToken: pgtype.Text{String: connection.RefreshToken, Valid: connection.RefreshToken != ""},
 		ExpiresAt:    pgtype.Timestamptz{Time: connection.ExpiresAt, Valid: true},
+		TenantID:     tenantID,
 	})
 	return err
 }
-
@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrderRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewOrderRepositoryImpl(db *pgxpool.Pool) repository.OrderRepository {
+	return &OrderRepositoryImpl{db: db}
+}
+
+func (r *OrderRepositoryImpl) Upsert(ctx context.Context, order *entity.Order) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	saved, err := queriesFor(ctx, r.db).UpsertOrder(ctx, sqlc.UpsertOrderParams{
+		ExternalID:  order.ExternalID,
+		CustomerID:  order.CustomerID,
+		ProductID:   order.ProductID,
+		PurchasedAt: pgtype.Timestamptz{Time: order.PurchasedAt, Valid: true},
+		TenantID:    tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*order = *toOrderEntity(saved)
+	return nil
+}
+
+func (r *OrderRepositoryImpl) GetByExternalID(ctx context.Context, externalID string, productID int64) (*entity.Order, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := queriesFor(ctx, r.db).GetOrderByExternalID(ctx, sqlc.GetOrderByExternalIDParams{
+		ExternalID: externalID,
+		ProductID:  productID,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	return toOrderEntity(order), nil
+}
+
+func (r *OrderRepositoryImpl) FindLatestPurchase(ctx context.Context, customerID, productID int64) (*entity.Order, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := queriesFor(ctx, r.db).GetLatestOrderForCustomerProduct(ctx, sqlc.GetLatestOrderForCustomerProductParams{
+		CustomerID: customerID,
+		ProductID:  productID,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	return toOrderEntity(order), nil
+}
+
+func (r *OrderRepositoryImpl) VerifyReviews(ctx context.Context, order *entity.Order) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return queriesFor(ctx, r.db).MarkReviewsVerifiedByOrder(ctx, sqlc.MarkReviewsVerifiedByOrderParams{
+		OrderID:     pgtype.Int8{Int64: order.ID, Valid: true},
+		CustomerID:  order.CustomerID,
+		ProductID:   order.ProductID,
+		PurchasedAt: pgtype.Timestamptz{Time: order.PurchasedAt, Valid: true},
+		TenantID:    tenantID,
+	})
+}
+
+func toOrderEntity(order sqlc.Order) *entity.Order {
+	return &entity.Order{
+		ID:          order.ID,
+		ExternalID:  order.ExternalID,
+		CustomerID:  order.CustomerID,
+		ProductID:   order.ProductID,
+		PurchasedAt: order.PurchasedAt.Time,
+		CreatedAt:   order.CreatedAt.Time,
+		UpdatedAt:   order.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,188 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OutboxRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepositoryImpl(db *pgxpool.Pool) repository.OutboxRepository {
+	return &OutboxRepositoryImpl{db: db}
+}
+
+func (r *OutboxRepositoryImpl) Append(ctx context.Context, event *entity.OutboxEvent) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateOutboxEvent(ctx, sqlc.CreateOutboxEventParams{
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.EventType,
+		Payload:       event.Payload,
+		TenantID:      tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*event = *toOutboxEventEntity(created)
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.OutboxEvent, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := queriesFor(ctx, r.db).GetOutboxEvent(ctx, sqlc.GetOutboxEventParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrEventNotFound
+		}
+		return nil, err
+	}
+
+	return toOutboxEventEntity(event), nil
+}
+
+func (r *OutboxRepositoryImpl) Sequence(ctx context.Context) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if err := queries.LockOutboxSequencer(ctx, int32(tenantID)); err != nil {
+		return 0, err
+	}
+	// A statement of its own, so that it sees everything committed before the
+	// lock was granted
+	return queries.SequenceOutboxEvents(ctx, tenantID)
+}
+
+func (r *OutboxRepositoryImpl) LatestSeq(ctx context.Context, aggregateType string) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return queriesFor(ctx, r.db).GetLatestOutboxSeq(ctx, sqlc.GetLatestOutboxSeqParams{
+		TenantID:      tenantID,
+		AggregateType: aggregateType,
+	})
+}
+
+func (r *OutboxRepositoryImpl) ListAfter(ctx context.Context, aggregateType string, afterSeq int64, limit int) ([]*entity.OutboxEvent, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := queriesFor(ctx, r.db).ListOutboxEventsAfter(ctx, sqlc.ListOutboxEventsAfterParams{
+		TenantID:      tenantID,
+		AggregateType: aggregateType,
+		AfterSeq:      afterSeq,
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*entity.OutboxEvent
+	for _, event := range events {
+		result = append(result, toOutboxEventEntity(event))
+	}
+	return result, nil
+}
+
+func (r *OutboxRepositoryImpl) ClaimPending(ctx context.Context, limit int) ([]*entity.OutboxEvent, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := queriesFor(ctx, r.db).ClaimOutboxEvents(ctx, sqlc.ClaimOutboxEventsParams{
+		TenantID: tenantID,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*entity.OutboxEvent
+	for _, event := range events {
+		result = append(result, toOutboxEventEntity(event))
+	}
+	return result, nil
+}
+
+func (r *OutboxRepositoryImpl) MarkPublished(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).MarkOutboxEventPublished(ctx, sqlc.MarkOutboxEventPublishedParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).MarkOutboxEventFailed(ctx, sqlc.MarkOutboxEventFailedParams{
+		ID:            id,
+		TenantID:      tenantID,
+		LastError:     pgtype.Text{String: lastError, Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+	})
+}
+
+func (r *OutboxRepositoryImpl) DeadLetter(ctx context.Context, id int64, lastError string) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).DeadLetterOutboxEvent(ctx, sqlc.DeadLetterOutboxEventParams{
+		ID:        id,
+		TenantID:  tenantID,
+		LastError: pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+func toOutboxEventEntity(event sqlc.Outbox) *entity.OutboxEvent {
+	return &entity.OutboxEvent{
+		ID:            event.ID,
+		TenantID:      event.TenantID,
+		Seq:           event.Seq.Int64,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.EventType,
+		Payload:       event.Payload,
+		Attempts:      int(event.Attempts),
+		CreatedAt:     event.CreatedAt.Time,
+	}
+}
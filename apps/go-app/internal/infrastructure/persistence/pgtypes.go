@@ -0,0 +1,100 @@
+package persistence
+
+import (
+	"time"
+	"user-review-ingest/internal/domain/valueobject"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Helpers converting between optional Go values and nullable pgtype values.
+
+func optionalInt8(v *int64) pgtype.Int8 {
+	if v == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *v, Valid: true}
+}
+
+func int8Ptr(v pgtype.Int8) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+func optionalInt4(v *int) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*v), Valid: true}
+}
+
+func int4Ptr(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int32)
+	return &n
+}
+
+func optionalBool(v *bool) pgtype.Bool {
+	if v == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *v, Valid: true}
+}
+
+func boolPtr(v pgtype.Bool) *bool {
+	if !v.Valid {
+		return nil
+	}
+	b := v.Bool
+	return &b
+}
+
+func optionalText(v *string) pgtype.Text {
+	if v == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *v, Valid: true}
+}
+
+func textPtr(v pgtype.Text) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
+
+func optionalTimestamptz(v *time.Time) pgtype.Timestamptz {
+	if v == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *v, Valid: true}
+}
+
+func timestamptzPtr(v pgtype.Timestamptz) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Time
+}
+
+// optionalRatingScale splits a rating scale into its nullable min, max and
+// step columns.
+func optionalRatingScale(scale *valueobject.RatingScale) (min, max, step pgtype.Float8) {
+	if scale == nil {
+		return pgtype.Float8{}, pgtype.Float8{}, pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: scale.Min, Valid: true},
+		pgtype.Float8{Float64: scale.Max, Valid: true},
+		pgtype.Float8{Float64: scale.Step, Valid: true}
+}
+
+func ratingScalePtr(min, max, step pgtype.Float8) *valueobject.RatingScale {
+	if !min.Valid || !max.Valid || !step.Valid {
+		return nil
+	}
+	return &valueobject.RatingScale{Min: min.Float64, Max: max.Float64, Step: step.Float64}
+}
@@ -0,0 +1,278 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductAnswerRepositoryImpl struct {
+	db        *pgxpool.Pool
+	txManager repository.TxManager
+}
+
+func NewProductAnswerRepositoryImpl(db *pgxpool.Pool) repository.ProductAnswerRepository {
+	return &ProductAnswerRepositoryImpl{
+		db:        db,
+		txManager: NewTxManagerImpl(db),
+	}
+}
+
+func (r *ProductAnswerRepositoryImpl) Create(ctx context.Context, answer *entity.ProductAnswer) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateProductAnswer(ctx, sqlc.CreateProductAnswerParams{
+		QuestionID: answer.QuestionID,
+		UserID:     answer.UserID,
+		Body:       answer.Body,
+		Brand:      answer.Brand,
+		Status:     answer.Status,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*answer = *toProductAnswerEntity(created)
+	return nil
+}
+
+func (r *ProductAnswerRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.ProductAnswer, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := queriesFor(ctx, r.db).GetProductAnswer(ctx, sqlc.GetProductAnswerParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrAnswerNotFound
+		}
+		return nil, err
+	}
+
+	return toProductAnswerEntity(answer), nil
+}
+
+func (r *ProductAnswerRepositoryImpl) ListByQuestionIDs(ctx context.Context, questionIDs []int64, status *string) (map[int64][]*entity.ProductAnswer, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListProductAnswersByQuestionIDs(ctx, sqlc.ListProductAnswersByQuestionIDsParams{
+		TenantID:    tenantID,
+		QuestionIds: questionIDs,
+		Status:      optionalText(status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64][]*entity.ProductAnswer)
+	for _, row := range rows {
+		result[row.QuestionID] = append(result[row.QuestionID], toProductAnswerEntity(row))
+	}
+	return result, nil
+}
+
+func (r *ProductAnswerRepositoryImpl) Update(ctx context.Context, answer *entity.ProductAnswer) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).UpdateProductAnswer(ctx, sqlc.UpdateProductAnswerParams{
+		Body:     answer.Body,
+		ID:       answer.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrAnswerNotFound
+		}
+		return err
+	}
+
+	answer.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
+func (r *ProductAnswerRepositoryImpl) SetStatus(ctx context.Context, answer *entity.ProductAnswer) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).SetProductAnswerStatus(ctx, sqlc.SetProductAnswerStatusParams{
+		Status:   answer.Status,
+		ID:       answer.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrAnswerNotFound
+		}
+		return err
+	}
+
+	answer.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
+func (r *ProductAnswerRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := queriesFor(ctx, r.db).DeleteProductAnswer(ctx, sqlc.DeleteProductAnswerParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domainerrors.ErrAnswerNotFound
+	}
+	return nil
+}
+
+// UpsertVote records the vote, replacing any earlier vote by the same user,
+// and moves the answer's counters accordingly. The answer row is locked for
+// the duration so concurrent votes cannot skew the counters.
+func (r *ProductAnswerRepositoryImpl) UpsertVote(ctx context.Context, vote *entity.ProductAnswerVote) error {
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return r.upsertVote(ctx, vote)
+	})
+}
+
+func (r *ProductAnswerRepositoryImpl) upsertVote(ctx context.Context, vote *entity.ProductAnswerVote) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if _, err := queries.LockProductAnswer(ctx, sqlc.LockProductAnswerParams{ID: vote.AnswerID, TenantID: tenantID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrAnswerNotFound
+		}
+		return err
+	}
+
+	var helpfulDelta, unhelpfulDelta int32
+	previous, err := queries.GetProductAnswerVote(ctx, sqlc.GetProductAnswerVoteParams{
+		AnswerID: vote.AnswerID,
+		UserID:   vote.UserID,
+		TenantID: tenantID,
+	})
+	switch {
+	case err == nil:
+		helpfulDelta, unhelpfulDelta = voteDeltas(previous.Helpful, -1)
+	case !errors.Is(err, pgx.ErrNoRows):
+		return err
+	}
+
+	saved, err := queries.UpsertProductAnswerVote(ctx, sqlc.UpsertProductAnswerVoteParams{
+		AnswerID: vote.AnswerID,
+		UserID:   vote.UserID,
+		Helpful:  vote.Helpful,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	h, u := voteDeltas(vote.Helpful, 1)
+	helpfulDelta += h
+	unhelpfulDelta += u
+	if helpfulDelta != 0 || unhelpfulDelta != 0 {
+		err = queries.AdjustProductAnswerVoteCounts(ctx, sqlc.AdjustProductAnswerVoteCountsParams{
+			ID:             vote.AnswerID,
+			TenantID:       tenantID,
+			HelpfulDelta:   helpfulDelta,
+			UnhelpfulDelta: unhelpfulDelta,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	vote.CreatedAt = saved.CreatedAt.Time
+	vote.UpdatedAt = saved.UpdatedAt.Time
+	return nil
+}
+
+// DeleteVote withdraws the user's vote on the answer and reverts its effect
+// on the counters.
+func (r *ProductAnswerRepositoryImpl) DeleteVote(ctx context.Context, answerID, userID int64) error {
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return r.deleteVote(ctx, answerID, userID)
+	})
+}
+
+func (r *ProductAnswerRepositoryImpl) deleteVote(ctx context.Context, answerID, userID int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if _, err := queries.LockProductAnswer(ctx, sqlc.LockProductAnswerParams{ID: answerID, TenantID: tenantID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrAnswerNotFound
+		}
+		return err
+	}
+
+	deleted, err := queries.DeleteProductAnswerVote(ctx, sqlc.DeleteProductAnswerVoteParams{
+		AnswerID: answerID,
+		UserID:   userID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrAnswerVoteNotFound
+		}
+		return err
+	}
+
+	helpfulDelta, unhelpfulDelta := voteDeltas(deleted.Helpful, -1)
+	return queries.AdjustProductAnswerVoteCounts(ctx, sqlc.AdjustProductAnswerVoteCountsParams{
+		ID:             answerID,
+		TenantID:       tenantID,
+		HelpfulDelta:   helpfulDelta,
+		UnhelpfulDelta: unhelpfulDelta,
+	})
+}
+
+func toProductAnswerEntity(answer sqlc.ProductAnswer) *entity.ProductAnswer {
+	return &entity.ProductAnswer{
+		ID:             answer.ID,
+		QuestionID:     answer.QuestionID,
+		UserID:         answer.UserID,
+		Body:           answer.Body,
+		Brand:          answer.Brand,
+		Status:         answer.Status,
+		HelpfulCount:   int(answer.HelpfulCount),
+		UnhelpfulCount: int(answer.UnhelpfulCount),
+		HelpfulScore:   answer.HelpfulScore,
+		CreatedAt:      answer.CreatedAt.Time,
+		UpdatedAt:      answer.UpdatedAt.Time,
+		DeletedAt:      timestamptzPtr(answer.DeletedAt),
+	}
+}
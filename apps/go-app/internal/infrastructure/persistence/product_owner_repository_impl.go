@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductOwnerRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewProductOwnerRepositoryImpl(db *pgxpool.Pool) repository.ProductOwnerRepository {
+	return &ProductOwnerRepositoryImpl{db: db}
+}
+
+func (r *ProductOwnerRepositoryImpl) IsOwner(ctx context.Context, productID, userID int64) (bool, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return queriesFor(ctx, r.db).IsProductOwner(ctx, sqlc.IsProductOwnerParams{
+		ProductID: productID,
+		UserID:    userID,
+		TenantID:  tenantID,
+	})
+}
+
+func (r *ProductOwnerRepositoryImpl) List(ctx context.Context, productID int64) ([]*entity.ProductOwner, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := queriesFor(ctx, r.db).ListProductOwners(ctx, sqlc.ListProductOwnersParams{
+		ProductID: productID,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ProductOwner, 0, len(owners))
+	for _, owner := range owners {
+		result = append(result, &entity.ProductOwner{
+			ProductID: owner.ProductID,
+			UserID:    owner.UserID,
+			CreatedAt: owner.CreatedAt.Time,
+		})
+	}
+	return result, nil
+}
+
+func (r *ProductOwnerRepositoryImpl) Add(ctx context.Context, productID, userID int64) (bool, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	added, err := queriesFor(ctx, r.db).AddProductOwner(ctx, sqlc.AddProductOwnerParams{
+		ProductID: productID,
+		UserID:    userID,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return added > 0, nil
+}
+
+func (r *ProductOwnerRepositoryImpl) Remove(ctx context.Context, productID, userID int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	removed, err := queriesFor(ctx, r.db).RemoveProductOwner(ctx, sqlc.RemoveProductOwnerParams{
+		ProductID: productID,
+		UserID:    userID,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return domainerrors.ErrProductOwnerNotFound
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductQuestionRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewProductQuestionRepositoryImpl(db *pgxpool.Pool) repository.ProductQuestionRepository {
+	return &ProductQuestionRepositoryImpl{db: db}
+}
+
+func (r *ProductQuestionRepositoryImpl) Create(ctx context.Context, question *entity.ProductQuestion) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateProductQuestion(ctx, sqlc.CreateProductQuestionParams{
+		ProductID: question.ProductID,
+		UserID:    question.UserID,
+		Body:      question.Body,
+		Status:    question.Status,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*question = *toProductQuestionEntity(created)
+	return nil
+}
+
+func (r *ProductQuestionRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.ProductQuestion, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := queriesFor(ctx, r.db).GetProductQuestion(ctx, sqlc.GetProductQuestionParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrQuestionNotFound
+		}
+		return nil, err
+	}
+
+	return toProductQuestionEntity(question), nil
+}
+
+func (r *ProductQuestionRepositoryImpl) List(ctx context.Context, filter repository.ProductQuestionFilter) ([]*entity.ProductQuestion, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListProductQuestions(ctx, sqlc.ListProductQuestionsParams{
+		TenantID:  tenantID,
+		Status:    optionalText(filter.Status),
+		ProductID: filter.ProductID,
+		Offset:    int32(filter.Offset),
+		Limit:     int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]*entity.ProductQuestion, 0, len(rows))
+	for _, row := range rows {
+		questions = append(questions, toProductQuestionEntity(row))
+	}
+	return questions, nil
+}
+
+func (r *ProductQuestionRepositoryImpl) Update(ctx context.Context, question *entity.ProductQuestion) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).UpdateProductQuestion(ctx, sqlc.UpdateProductQuestionParams{
+		Body:     question.Body,
+		ID:       question.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrQuestionNotFound
+		}
+		return err
+	}
+
+	question.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
+func (r *ProductQuestionRepositoryImpl) SetStatus(ctx context.Context, question *entity.ProductQuestion) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).SetProductQuestionStatus(ctx, sqlc.SetProductQuestionStatusParams{
+		Status:   question.Status,
+		ID:       question.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrQuestionNotFound
+		}
+		return err
+	}
+
+	question.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
+// Delete soft-deletes the question and its answers. Call it within a
+// transaction.
+func (r *ProductQuestionRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	deleted, err := queries.DeleteProductQuestion(ctx, sqlc.DeleteProductQuestionParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domainerrors.ErrQuestionNotFound
+	}
+
+	return queries.DeleteProductAnswersByQuestion(ctx, sqlc.DeleteProductAnswersByQuestionParams{
+		QuestionID: id,
+		TenantID:   tenantID,
+	})
+}
+
+func toProductQuestionEntity(question sqlc.ProductQuestion) *entity.ProductQuestion {
+	return &entity.ProductQuestion{
+		ID:        question.ID,
+		ProductID: question.ProductID,
+		UserID:    question.UserID,
+		Body:      question.Body,
+		Status:    question.Status,
+		CreatedAt: question.CreatedAt.Time,
+		UpdatedAt: question.UpdatedAt.Time,
+		DeletedAt: timestamptzPtr(question.DeletedAt),
+	}
+}
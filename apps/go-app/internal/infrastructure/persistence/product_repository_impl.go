@@ -0,0 +1,229 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const foreignKeyViolation = "23503"
+
+type ProductRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewProductRepositoryImpl(db *pgxpool.Pool) repository.ProductRepository {
+	return &ProductRepositoryImpl{db: db}
+}
+
+func (r *ProductRepositoryImpl) Create(ctx context.Context, product *entity.Product) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateProduct(ctx, sqlc.CreateProductParams{
+		Sku:        product.SKU,
+		ExternalID: optionalText(product.ExternalID),
+		Name:       product.Name,
+		GroupID:    optionalInt8(product.GroupID),
+		CategoryID: optionalInt8(product.CategoryID),
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return productWriteError(err)
+	}
+
+	*product = *toProductEntity(created)
+	return nil
+}
+
+func (r *ProductRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.Product, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := queriesFor(ctx, r.db).GetProduct(ctx, sqlc.GetProductParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	return toProductEntity(product), nil
+}
+
+func (r *ProductRepositoryImpl) GetBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := queriesFor(ctx, r.db).GetProductBySKU(ctx, sqlc.GetProductBySKUParams{
+		Sku:      sku,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	return toProductEntity(product), nil
+}
+
+func (r *ProductRepositoryImpl) List(ctx context.Context, opts repository.ProductListOptions) ([]*entity.Product, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := queriesFor(ctx, r.db).ListProducts(ctx, sqlc.ListProductsParams{
+		TenantID:        tenantID,
+		IncludeArchived: opts.IncludeArchived,
+		GroupID:         optionalInt8(opts.GroupID),
+		Offset:          int32(opts.Offset),
+		Limit:           int32(opts.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*entity.Product
+	for _, product := range products {
+		result = append(result, toProductEntity(product))
+	}
+
+	return result, nil
+}
+
+func (r *ProductRepositoryImpl) Update(ctx context.Context, product *entity.Product) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).UpdateProduct(ctx, sqlc.UpdateProductParams{
+		ID:             product.ID,
+		TenantID:       tenantID,
+		Sku:            product.SKU,
+		ExternalID:     optionalText(product.ExternalID),
+		Name:           product.Name,
+		GroupID:        optionalInt8(product.GroupID),
+		CategoryID:     optionalInt8(product.CategoryID),
+		ArchivedAt:     optionalTimestamptz(product.ArchivedAt),
+		HoldNewReviews: product.HoldNewReviews,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrProductNotFound
+		}
+		return productWriteError(err)
+	}
+
+	*product = *toProductEntity(updated)
+	return nil
+}
+
+func (r *ProductRepositoryImpl) UpsertBySKU(ctx context.Context, product *entity.Product) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	saved, err := queriesFor(ctx, r.db).UpsertProductBySKU(ctx, sqlc.UpsertProductBySKUParams{
+		Sku:        product.SKU,
+		ExternalID: optionalText(product.ExternalID),
+		Name:       product.Name,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return productWriteError(err)
+	}
+
+	*product = *toProductEntity(saved)
+	return nil
+}
+
+func (r *ProductRepositoryImpl) ArchiveMissing(ctx context.Context, skus []string) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return queriesFor(ctx, r.db).ArchiveProductsNotInSKUs(ctx, sqlc.ArchiveProductsNotInSKUsParams{
+		Skus:     skus,
+		TenantID: tenantID,
+	})
+}
+
+func (r *ProductRepositoryImpl) Regroup(ctx context.Context, fromGroupID, toGroupID int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).RegroupProducts(ctx, sqlc.RegroupProductsParams{
+		TenantID:    tenantID,
+		FromGroupID: pgtype.Int8{Int64: fromGroupID, Valid: true},
+		ToGroupID:   pgtype.Int8{Int64: toGroupID, Valid: true},
+	})
+}
+
+func (r *ProductRepositoryImpl) HoldReviews(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).HoldProductReviews(ctx, sqlc.HoldProductReviewsParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+// productWriteError maps constraint violations to domain errors.
+func productWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return domainerrors.ErrProductExists
+		case foreignKeyViolation:
+			if pgErr.ConstraintName == "products_category_id_fkey" {
+				return domainerrors.ErrInvalidCategory
+			}
+			return domainerrors.ErrInvalidProductGroup
+		}
+	}
+	return err
+}
+
+func toProductEntity(product sqlc.Product) *entity.Product {
+	return &entity.Product{
+		ID:             product.ID,
+		SKU:            product.Sku,
+		ExternalID:     textPtr(product.ExternalID),
+		Name:           product.Name,
+		GroupID:        int8Ptr(product.GroupID),
+		CategoryID:     int8Ptr(product.CategoryID),
+		ArchivedAt:     timestamptzPtr(product.ArchivedAt),
+		HoldNewReviews: product.HoldNewReviews,
+		CreatedAt:      product.CreatedAt.Time,
+		UpdatedAt:      product.UpdatedAt.Time,
+	}
+}
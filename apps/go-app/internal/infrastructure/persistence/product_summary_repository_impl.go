@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductSummaryRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewProductSummaryRepositoryImpl(db *pgxpool.Pool) repository.ProductSummaryRepository {
+	return &ProductSummaryRepositoryImpl{db: db}
+}
+
+func (r *ProductSummaryRepositoryImpl) Summarize(ctx context.Context, product *entity.Product) (*entity.ProductSummary, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	ratings, err := queries.SummarizeProductRatings(ctx, sqlc.SummarizeProductRatingsParams{
+		TenantID:  tenantID,
+		ProductID: product.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &entity.ProductSummary{
+		ProductID:    product.ID,
+		Distribution: make(map[int]int64),
+	}
+	var total float64
+	for _, row := range ratings {
+		summary.Distribution[int(row.Stars)] = row.ReviewCount
+		summary.ReviewCount += row.ReviewCount
+		total += row.RatingSum
+	}
+	if summary.ReviewCount > 0 {
+		summary.AverageRating = total / float64(summary.ReviewCount)
+	}
+
+	questions, err := queries.SummarizeProductQuestions(ctx, sqlc.SummarizeProductQuestionsParams{
+		TenantID:  tenantID,
+		ProductID: product.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	summary.QuestionCount = questions.QuestionCount
+	summary.AnsweredQuestionCount = questions.AnsweredCount
+	summary.AnswerCount = questions.AnswerCount
+
+	if product.CategoryID == nil {
+		return summary, nil
+	}
+	aspects, err := queries.SummarizeProductAspects(ctx, sqlc.SummarizeProductAspectsParams{
+		TenantID:   tenantID,
+		ProductID:  product.ID,
+		CategoryID: *product.CategoryID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range aspects {
+		summary.Aspects = append(summary.Aspects, &entity.AspectSummary{
+			Key:           row.Key,
+			Name:          row.Name,
+			RatingCount:   row.RatingCount,
+			AverageRating: row.AverageRating,
+		})
+	}
+	return summary, nil
+}
@@ -0,0 +1,170 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RatingAlertRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewRatingAlertRepositoryImpl(db *pgxpool.Pool) repository.RatingAlertRepository {
+	return &RatingAlertRepositoryImpl{db: db}
+}
+
+func (r *RatingAlertRepositoryImpl) WindowStats(ctx context.Context, windowStart, baselineStart time.Time, minReviews int) ([]*entity.RatingWindowStats, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListRatingWindowStats(ctx, sqlc.ListRatingWindowStatsParams{
+		TenantID:      tenantID,
+		WindowStart:   pgtype.Timestamptz{Time: windowStart, Valid: true},
+		BaselineStart: pgtype.Timestamptz{Time: baselineStart, Valid: true},
+		MinReviews:    int64(minReviews),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*entity.RatingWindowStats, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, &entity.RatingWindowStats{
+			ProductID:      row.ProductID,
+			WindowCount:    row.WindowCount,
+			WindowMean:     row.WindowMean,
+			BaselineCount:  row.BaselineCount,
+			BaselineMean:   row.BaselineMean,
+			BaselineStddev: row.BaselineStddev,
+		})
+	}
+	return stats, nil
+}
+
+func (r *RatingAlertRepositoryImpl) Create(ctx context.Context, alert *entity.RatingAlert) (bool, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateRatingAlert(ctx, sqlc.CreateRatingAlertParams{
+		ProductID:      alert.ProductID,
+		Kind:           alert.Kind,
+		WindowStart:    pgtype.Timestamptz{Time: alert.WindowStart, Valid: true},
+		WindowEnd:      pgtype.Timestamptz{Time: alert.WindowEnd, Valid: true},
+		ReviewCount:    int32(alert.ReviewCount),
+		MeanRating:     alert.MeanRating,
+		ExpectedCount:  alert.ExpectedCount,
+		BaselineMean:   alert.BaselineMean,
+		BaselineStddev: alert.BaselineStddev,
+		Score:          alert.Score,
+		ProductHeld:    alert.ProductHeld,
+		TenantID:       tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	*alert = *toRatingAlertEntity(created)
+	return true, nil
+}
+
+func (r *RatingAlertRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.RatingAlert, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := queriesFor(ctx, r.db).GetRatingAlert(ctx, sqlc.GetRatingAlertParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrRatingAlertNotFound
+		}
+		return nil, err
+	}
+
+	return toRatingAlertEntity(alert), nil
+}
+
+func (r *RatingAlertRepositoryImpl) List(ctx context.Context, opts repository.RatingAlertListOptions) ([]*entity.RatingAlert, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := queriesFor(ctx, r.db).ListRatingAlerts(ctx, sqlc.ListRatingAlertsParams{
+		TenantID:  tenantID,
+		Status:    optionalText(opts.Status),
+		ProductID: optionalInt8(opts.ProductID),
+		Offset:    int32(opts.Offset),
+		Limit:     int32(opts.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.RatingAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		result = append(result, toRatingAlertEntity(alert))
+	}
+	return result, nil
+}
+
+func (r *RatingAlertRepositoryImpl) SetStatus(ctx context.Context, alert *entity.RatingAlert) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).UpdateRatingAlertStatus(ctx, sqlc.UpdateRatingAlertStatusParams{
+		Status:   alert.Status,
+		ID:       alert.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrRatingAlertNotFound
+		}
+		return err
+	}
+
+	*alert = *toRatingAlertEntity(updated)
+	return nil
+}
+
+func toRatingAlertEntity(alert sqlc.RatingAlert) *entity.RatingAlert {
+	return &entity.RatingAlert{
+		ID:             alert.ID,
+		ProductID:      alert.ProductID,
+		Kind:           alert.Kind,
+		Status:         alert.Status,
+		WindowStart:    alert.WindowStart.Time,
+		WindowEnd:      alert.WindowEnd.Time,
+		ReviewCount:    int(alert.ReviewCount),
+		MeanRating:     alert.MeanRating,
+		ExpectedCount:  alert.ExpectedCount,
+		BaselineMean:   alert.BaselineMean,
+		BaselineStddev: alert.BaselineStddev,
+		Score:          alert.Score,
+		ProductHeld:    alert.ProductHeld,
+		CreatedAt:      alert.CreatedAt.Time,
+		UpdatedAt:      alert.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/domain/valueobject"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewAspectRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewAspectRepositoryImpl(db *pgxpool.Pool) repository.ReviewAspectRepository {
+	return &ReviewAspectRepositoryImpl{db: db}
+}
+
+func (r *ReviewAspectRepositoryImpl) Replace(ctx context.Context, reviewID int64, ratings []*entity.AspectRating) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if err := queries.DeleteReviewAspectRatings(ctx, sqlc.DeleteReviewAspectRatingsParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	}); err != nil {
+		return err
+	}
+	for _, rating := range ratings {
+		if err := queries.CreateReviewAspectRating(ctx, sqlc.CreateReviewAspectRatingParams{
+			ReviewID:    reviewID,
+			AspectID:    rating.AspectID,
+			Rating:      rating.Rating.Normalized(),
+			RatingValue: rating.Rating.Value(),
+			TenantID:    tenantID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ReviewAspectRepositoryImpl) ListByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64][]*entity.AspectRating, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListReviewAspectRatings(ctx, sqlc.ListReviewAspectRatingsParams{
+		TenantID:  tenantID,
+		ReviewIds: reviewIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64][]*entity.AspectRating)
+	for _, row := range rows {
+		scale := valueobject.RatingScale{
+			Min:  row.RatingScaleMin,
+			Max:  row.RatingScaleMax,
+			Step: row.RatingScaleStep,
+		}
+		rating, err := scale.Rate(row.RatingValue)
+		if err != nil {
+			return nil, err
+		}
+		result[row.ReviewID] = append(result[row.ReviewID], &entity.AspectRating{
+			AspectID: row.AspectID,
+			Key:      row.Key,
+			Rating:   rating,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewAttachmentRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewAttachmentRepositoryImpl(db *pgxpool.Pool) repository.ReviewAttachmentRepository {
+	return &ReviewAttachmentRepositoryImpl{db: db}
+}
+
+func (r *ReviewAttachmentRepositoryImpl) Create(ctx context.Context, attachment *entity.ReviewAttachment) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateReviewAttachment(ctx, sqlc.CreateReviewAttachmentParams{
+		ReviewID:     attachment.ReviewID,
+		BlobKey:      attachment.BlobKey,
+		ThumbnailKey: attachment.ThumbnailKey,
+		ContentType:  attachment.ContentType,
+		SizeBytes:    attachment.SizeBytes,
+		Width:        int32(attachment.Width),
+		Height:       int32(attachment.Height),
+		TenantID:     tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	attachment.ID = created.ID
+	attachment.CreatedAt = created.CreatedAt.Time
+	return nil
+}
+
+func (r *ReviewAttachmentRepositoryImpl) GetByID(ctx context.Context, reviewID, id int64) (*entity.ReviewAttachment, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := queriesFor(ctx, r.db).GetReviewAttachment(ctx, sqlc.GetReviewAttachmentParams{
+		ID:       id,
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return toReviewAttachmentEntity(attachment), nil
+}
+
+// ListByReviewIDs returns the live attachments of the given reviews keyed by review ID.
+func (r *ReviewAttachmentRepositoryImpl) ListByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64][]*entity.ReviewAttachment, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := queriesFor(ctx, r.db).ListReviewAttachmentsByReviewIDs(ctx, sqlc.ListReviewAttachmentsByReviewIDsParams{
+		ReviewIds: reviewIDs,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64][]*entity.ReviewAttachment)
+	for _, attachment := range attachments {
+		result[attachment.ReviewID] = append(result[attachment.ReviewID], toReviewAttachmentEntity(attachment))
+	}
+	return result, nil
+}
+
+func (r *ReviewAttachmentRepositoryImpl) CountByReviewID(ctx context.Context, reviewID int64) (int, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := queriesFor(ctx, r.db).CountReviewAttachments(ctx, sqlc.CountReviewAttachmentsParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	})
+	return int(count), err
+}
+
+func (r *ReviewAttachmentRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).DeleteReviewAttachment(ctx, sqlc.DeleteReviewAttachmentParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func toReviewAttachmentEntity(attachment sqlc.ReviewAttachment) *entity.ReviewAttachment {
+	var deletedAt *time.Time
+	if attachment.DeletedAt.Valid {
+		deletedAt = &attachment.DeletedAt.Time
+	}
+
+	return &entity.ReviewAttachment{
+		ID:           attachment.ID,
+		ReviewID:     attachment.ReviewID,
+		BlobKey:      attachment.BlobKey,
+		ThumbnailKey: attachment.ThumbnailKey,
+		ContentType:  attachment.ContentType,
+		SizeBytes:    attachment.SizeBytes,
+		Width:        int(attachment.Width),
+		Height:       int(attachment.Height),
+		CreatedAt:    attachment.CreatedAt.Time,
+		DeletedAt:    deletedAt,
+	}
+}
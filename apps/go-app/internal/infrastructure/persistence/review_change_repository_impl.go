@@ -0,0 +1,60 @@
+package persistence
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewChangeRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewChangeRepositoryImpl(db *pgxpool.Pool) repository.ReviewChangeRepository {
+	return &ReviewChangeRepositoryImpl{db: db}
+}
+
+func (r *ReviewChangeRepositoryImpl) Sequence(ctx context.Context) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if err := queries.LockReviewChangeSequencer(ctx, int32(tenantID)); err != nil {
+		return 0, err
+	}
+	// A statement of its own, so that it sees everything committed before the
+	// lock was granted
+	return queries.SequenceReviewChanges(ctx, tenantID)
+}
+
+func (r *ReviewChangeRepositoryImpl) ListAfter(ctx context.Context, afterSeq int64, limit int) ([]*entity.ReviewChange, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := queriesFor(ctx, r.db).ListReviewChangesAfter(ctx, sqlc.ListReviewChangesAfterParams{
+		TenantID: tenantID,
+		AfterSeq: afterSeq,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ReviewChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, &entity.ReviewChange{
+			Seq:       change.Seq.Int64,
+			ReviewID:  change.ReviewID,
+			Operation: change.Operation,
+			ChangedAt: change.ChangedAt.Time,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/domain/valueobject"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// simHashCandidateLimit caps how many reviews sharing a band are compared.
+// Common texts can share bands with many reviews; the most recent are kept.
+const simHashCandidateLimit = 500
+
+type ReviewDuplicateRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewDuplicateRepositoryImpl(db *pgxpool.Pool) repository.ReviewDuplicateRepository {
+	return &ReviewDuplicateRepositoryImpl{db: db}
+}
+
+func (r *ReviewDuplicateRepositoryImpl) FindNear(ctx context.Context, reviewID int64, fingerprint valueobject.SimHash, maxDistance int, since time.Time) ([]*entity.ReviewDuplicate, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := queriesFor(ctx, r.db).ListSimHashCandidates(ctx, sqlc.ListSimHashCandidatesParams{
+		TenantID: tenantID,
+		ReviewID: reviewID,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Band0:    int64(fingerprint.Band(0)),
+		Band1:    int64(fingerprint.Band(1)),
+		Band2:    int64(fingerprint.Band(2)),
+		Band3:    int64(fingerprint.Band(3)),
+		Limit:    simHashCandidateLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []*entity.ReviewDuplicate
+	for _, candidate := range candidates {
+		distance := fingerprint.Distance(valueobject.SimHash(candidate.CommentSimhash))
+		if distance <= maxDistance {
+			duplicates = append(duplicates, &entity.ReviewDuplicate{
+				ReviewID:   reviewID,
+				OriginalID: candidate.ID,
+				Distance:   distance,
+			})
+		}
+	}
+	return duplicates, nil
+}
+
+func (r *ReviewDuplicateRepositoryImpl) Replace(ctx context.Context, reviewID int64, duplicates []*entity.ReviewDuplicate) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if err := queries.DeleteReviewDuplicates(ctx, sqlc.DeleteReviewDuplicatesParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	}); err != nil {
+		return err
+	}
+	for _, duplicate := range duplicates {
+		if err := queries.CreateReviewDuplicate(ctx, sqlc.CreateReviewDuplicateParams{
+			ReviewID:   reviewID,
+			OriginalID: duplicate.OriginalID,
+			Distance:   int16(duplicate.Distance),
+			TenantID:   tenantID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ReviewDuplicateRepositoryImpl) ListByReview(ctx context.Context, reviewID int64) ([]*entity.ReviewDuplicate, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates, err := queriesFor(ctx, r.db).ListReviewDuplicates(ctx, sqlc.ListReviewDuplicatesParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ReviewDuplicate, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		result = append(result, &entity.ReviewDuplicate{
+			ReviewID:   duplicate.ReviewID,
+			OriginalID: duplicate.OriginalID,
+			Distance:   int(duplicate.Distance),
+			CreatedAt:  duplicate.CreatedAt.Time,
+		})
+	}
+	return result, nil
+}
+
+func optionalSimHash(v *valueobject.SimHash) pgtype.Int8 {
+	if v == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: int64(*v), Valid: true}
+}
+
+func simHashPtr(v pgtype.Int8) *valueobject.SimHash {
+	if !v.Valid {
+		return nil
+	}
+	fingerprint := valueobject.SimHash(v.Int64)
+	return &fingerprint
+}
@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewInvitationRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewInvitationRepositoryImpl(db *pgxpool.Pool) repository.ReviewInvitationRepository {
+	return &ReviewInvitationRepositoryImpl{db: db}
+}
+
+func (r *ReviewInvitationRepositoryImpl) Issue(ctx context.Context, invitation *entity.ReviewInvitation) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	issued, err := queriesFor(ctx, r.db).IssueReviewInvitation(ctx, sqlc.IssueReviewInvitationParams{
+		OrderID:    invitation.OrderID,
+		CustomerID: invitation.CustomerID,
+		ProductID:  invitation.ProductID,
+		ExpiresAt:  pgtype.Timestamptz{Time: invitation.ExpiresAt, Valid: true},
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*invitation = *toReviewInvitationEntity(issued)
+	return nil
+}
+
+func (r *ReviewInvitationRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.ReviewInvitation, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := queriesFor(ctx, r.db).GetReviewInvitation(ctx, sqlc.GetReviewInvitationParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrInvitationNotFound
+		}
+		return nil, err
+	}
+
+	return toReviewInvitationEntity(invitation), nil
+}
+
+func (r *ReviewInvitationRepositoryImpl) Open(ctx context.Context, id int64) (*entity.ReviewInvitation, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := queriesFor(ctx, r.db).OpenReviewInvitation(ctx, sqlc.OpenReviewInvitationParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrInvitationNotFound
+		}
+		return nil, err
+	}
+
+	return toReviewInvitationEntity(invitation), nil
+}
+
+func (r *ReviewInvitationRepositoryImpl) Complete(ctx context.Context, id, reviewID int64) (*entity.ReviewInvitation, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := queriesFor(ctx, r.db).CompleteReviewInvitation(ctx, sqlc.CompleteReviewInvitationParams{
+		ReviewID: optionalInt8(&reviewID),
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err == nil {
+		return toReviewInvitationEntity(completed), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	// Tell the caller why the invitation could not be redeemed
+	invitation, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.Status(time.Now()) == entity.InvitationStatusExpired {
+		return nil, domainerrors.ErrInvitationExpired
+	}
+	return nil, domainerrors.ErrInvitationRedeemed
+}
+
+func (r *ReviewInvitationRepositoryImpl) Stats(ctx context.Context, filter repository.InvitationStatsFilter) (*entity.InvitationStats, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFor(ctx, r.db).SummarizeReviewInvitations(ctx, sqlc.SummarizeReviewInvitationsParams{
+		TenantID:   tenantID,
+		ProductID:  optionalInt8(filter.ProductID),
+		IssuedFrom: optionalTimestamptz(filter.IssuedFrom),
+		IssuedTo:   optionalTimestamptz(filter.IssuedTo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.InvitationStats{
+		Issued:    row.Issued,
+		Opened:    row.Opened,
+		Completed: row.Completed,
+		Expired:   row.Expired,
+	}, nil
+}
+
+func toReviewInvitationEntity(invitation sqlc.ReviewInvitation) *entity.ReviewInvitation {
+	return &entity.ReviewInvitation{
+		ID:          invitation.ID,
+		OrderID:     invitation.OrderID,
+		CustomerID:  invitation.CustomerID,
+		ProductID:   invitation.ProductID,
+		ExpiresAt:   invitation.ExpiresAt.Time,
+		OpenedAt:    timestamptzPtr(invitation.OpenedAt),
+		CompletedAt: timestamptzPtr(invitation.CompletedAt),
+		ReviewID:    int8Ptr(invitation.ReviewID),
+		CreatedAt:   invitation.CreatedAt.Time,
+	}
+}
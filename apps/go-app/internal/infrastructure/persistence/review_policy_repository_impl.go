@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewPolicyRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewPolicyRepositoryImpl(db *pgxpool.Pool) repository.ReviewPolicyRepository {
+	return &ReviewPolicyRepositoryImpl{db: db}
+}
+
+func (r *ReviewPolicyRepositoryImpl) Put(ctx context.Context, policy *entity.ReviewPolicy) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	var stored sqlc.ReviewPolicy
+	if policy.ProductID != nil {
+		stored, err = queries.UpsertProductReviewPolicy(ctx, sqlc.UpsertProductReviewPolicyParams{
+			ProductID:        optionalInt8(policy.ProductID),
+			MinCommentLength: optionalInt4(policy.MinCommentLength),
+			CommentRequired:  optionalBool(policy.CommentRequired),
+			VerifiedOnly:     optionalBool(policy.VerifiedOnly),
+			Moderation:       optionalText(policy.Moderation),
+			Closed:           optionalBool(policy.Closed),
+			TenantID:         tenantID,
+		})
+	} else {
+		stored, err = queries.UpsertCategoryReviewPolicy(ctx, sqlc.UpsertCategoryReviewPolicyParams{
+			CategoryID:       optionalInt8(policy.CategoryID),
+			MinCommentLength: optionalInt4(policy.MinCommentLength),
+			CommentRequired:  optionalBool(policy.CommentRequired),
+			VerifiedOnly:     optionalBool(policy.VerifiedOnly),
+			Moderation:       optionalText(policy.Moderation),
+			Closed:           optionalBool(policy.Closed),
+			TenantID:         tenantID,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	*policy = *toReviewPolicyEntity(stored)
+	return nil
+}
+
+func (r *ReviewPolicyRepositoryImpl) GetForProduct(ctx context.Context, productID int64) (*entity.ReviewPolicy, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := queriesFor(ctx, r.db).GetProductReviewPolicy(ctx, sqlc.GetProductReviewPolicyParams{
+		ProductID: pgtype.Int8{Int64: productID, Valid: true},
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrReviewPolicyNotFound
+		}
+		return nil, err
+	}
+	return toReviewPolicyEntity(policy), nil
+}
+
+func (r *ReviewPolicyRepositoryImpl) GetForCategory(ctx context.Context, categoryID int64) (*entity.ReviewPolicy, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := queriesFor(ctx, r.db).GetCategoryReviewPolicy(ctx, sqlc.GetCategoryReviewPolicyParams{
+		CategoryID: pgtype.Int8{Int64: categoryID, Valid: true},
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrReviewPolicyNotFound
+		}
+		return nil, err
+	}
+	return toReviewPolicyEntity(policy), nil
+}
+
+func (r *ReviewPolicyRepositoryImpl) DeleteForProduct(ctx context.Context, productID int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := queriesFor(ctx, r.db).DeleteProductReviewPolicy(ctx, sqlc.DeleteProductReviewPolicyParams{
+		ProductID: pgtype.Int8{Int64: productID, Valid: true},
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domainerrors.ErrReviewPolicyNotFound
+	}
+	return nil
+}
+
+func (r *ReviewPolicyRepositoryImpl) DeleteForCategory(ctx context.Context, categoryID int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := queriesFor(ctx, r.db).DeleteCategoryReviewPolicy(ctx, sqlc.DeleteCategoryReviewPolicyParams{
+		CategoryID: pgtype.Int8{Int64: categoryID, Valid: true},
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domainerrors.ErrReviewPolicyNotFound
+	}
+	return nil
+}
+
+func (r *ReviewPolicyRepositoryImpl) ListApplicable(ctx context.Context, product *entity.Product) ([]*entity.ReviewPolicy, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := queriesFor(ctx, r.db).ListApplicableReviewPolicies(ctx, sqlc.ListApplicableReviewPoliciesParams{
+		TenantID:   tenantID,
+		ProductID:  pgtype.Int8{Int64: product.ID, Valid: true},
+		GroupID:    pgtype.Int8{Int64: product.GroupRootID(), Valid: true},
+		CategoryID: optionalInt8(product.CategoryID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ReviewPolicy, 0, len(policies))
+	for _, policy := range policies {
+		result = append(result, toReviewPolicyEntity(policy))
+	}
+	return result, nil
+}
+
+func toReviewPolicyEntity(policy sqlc.ReviewPolicy) *entity.ReviewPolicy {
+	return &entity.ReviewPolicy{
+		ID:               policy.ID,
+		ProductID:        int8Ptr(policy.ProductID),
+		CategoryID:       int8Ptr(policy.CategoryID),
+		MinCommentLength: int4Ptr(policy.MinCommentLength),
+		CommentRequired:  boolPtr(policy.CommentRequired),
+		VerifiedOnly:     boolPtr(policy.VerifiedOnly),
+		Moderation:       textPtr(policy.Moderation),
+		Closed:           boolPtr(policy.Closed),
+		CreatedAt:        policy.CreatedAt.Time,
+		UpdatedAt:        policy.UpdatedAt.Time,
+	}
+}
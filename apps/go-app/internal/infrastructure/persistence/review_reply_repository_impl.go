@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const uniqueViolation = "23505"
+
+type ReviewReplyRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewReplyRepositoryImpl(db *pgxpool.Pool) repository.ReviewReplyRepository {
+	return &ReviewReplyRepositoryImpl{db: db}
+}
+
+func (r *ReviewReplyRepositoryImpl) Create(ctx context.Context, reply *entity.ReviewReply) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateReviewReply(ctx, sqlc.CreateReviewReplyParams{
+		ReviewID: reply.ReviewID,
+		AuthorID: reply.AuthorID,
+		Body:     reply.Body,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domainerrors.ErrReviewReplyExists
+		}
+		return err
+	}
+
+	*reply = *toReviewReplyEntity(created)
+	return nil
+}
+
+func (r *ReviewReplyRepositoryImpl) GetByReviewID(ctx context.Context, reviewID int64) (*entity.ReviewReply, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := queriesFor(ctx, r.db).GetReviewReplyByReviewID(ctx, sqlc.GetReviewReplyByReviewIDParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrReviewReplyNotFound
+		}
+		return nil, err
+	}
+
+	return toReviewReplyEntity(reply), nil
+}
+
+// ListByReviewIDs returns the live replies to the given reviews keyed by review ID.
+func (r *ReviewReplyRepositoryImpl) ListByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64]*entity.ReviewReply, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := queriesFor(ctx, r.db).ListReviewRepliesByReviewIDs(ctx, sqlc.ListReviewRepliesByReviewIDsParams{
+		ReviewIds: reviewIDs,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]*entity.ReviewReply, len(replies))
+	for _, reply := range replies {
+		result[reply.ReviewID] = toReviewReplyEntity(reply)
+	}
+	return result, nil
+}
+
+func (r *ReviewReplyRepositoryImpl) Update(ctx context.Context, reply *entity.ReviewReply) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).UpdateReviewReply(ctx, sqlc.UpdateReviewReplyParams{
+		ID:       reply.ID,
+		Body:     reply.Body,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrReviewReplyNotFound
+		}
+		return err
+	}
+
+	reply.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
+func (r *ReviewReplyRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).DeleteReviewReply(ctx, sqlc.DeleteReviewReplyParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func toReviewReplyEntity(reply sqlc.ReviewReply) *entity.ReviewReply {
+	var deletedAt *time.Time
+	if reply.DeletedAt.Valid {
+		deletedAt = &reply.DeletedAt.Time
+	}
+
+	return &entity.ReviewReply{
+		ID:        reply.ID,
+		ReviewID:  reply.ReviewID,
+		AuthorID:  reply.AuthorID,
+		Body:      reply.Body,
+		CreatedAt: reply.CreatedAt.Time,
+		UpdatedAt: reply.UpdatedAt.Time,
+		DeletedAt: deletedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewReportRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewReportRepositoryImpl(db *pgxpool.Pool) repository.ReviewReportRepository {
+	return &ReviewReportRepositoryImpl{db: db}
+}
+
+func (r *ReviewReportRepositoryImpl) Create(ctx context.Context, report *entity.ReviewReport) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateReviewReport(ctx, sqlc.CreateReviewReportParams{
+		ReviewID:   report.ReviewID,
+		ReporterID: report.ReporterID,
+		Reason:     report.Reason,
+		Details:    optionalText(report.Details),
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domainerrors.ErrReviewAlreadyReported
+		}
+		return err
+	}
+
+	*report = *toReviewReportEntity(created)
+	return nil
+}
+
+func (r *ReviewReportRepositoryImpl) CountOpen(ctx context.Context, reviewID int64) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return queriesFor(ctx, r.db).CountOpenReviewReports(ctx, sqlc.CountOpenReviewReportsParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	})
+}
+
+func (r *ReviewReportRepositoryImpl) ListByReview(ctx context.Context, reviewID int64) ([]*entity.ReviewReport, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListReviewReports(ctx, sqlc.ListReviewReportsParams{
+		ReviewID: reviewID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*entity.ReviewReport, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, toReviewReportEntity(row))
+	}
+	return reports, nil
+}
+
+func (r *ReviewReportRepositoryImpl) ListReported(ctx context.Context, openOnly bool, offset, limit int) ([]*entity.ReportedReview, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ListReportedReviews(ctx, sqlc.ListReportedReviewsParams{
+		TenantID: tenantID,
+		OpenOnly: openOnly,
+		Offset:   int32(offset),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reported := make([]*entity.ReportedReview, 0, len(rows))
+	for _, row := range rows {
+		reported = append(reported, &entity.ReportedReview{
+			ReviewID:       row.ReviewID,
+			OpenCount:      row.OpenCount,
+			UpheldCount:    row.UpheldCount,
+			DismissedCount: row.DismissedCount,
+			ReasonCounts: map[string]int64{
+				entity.ReviewReportReasonSpam:      row.SpamCount,
+				entity.ReviewReportReasonOffensive: row.OffensiveCount,
+				entity.ReviewReportReasonFake:      row.FakeCount,
+				entity.ReviewReportReasonOther:     row.OtherCount,
+			},
+			LastReportedAt: row.LastReportedAt.Time,
+		})
+	}
+	return reported, nil
+}
+
+func (r *ReviewReportRepositoryImpl) Resolve(ctx context.Context, reviewID int64, status string, resolvedBy *int64) ([]*entity.ReviewReport, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFor(ctx, r.db).ResolveReviewReports(ctx, sqlc.ResolveReviewReportsParams{
+		Status:     status,
+		ResolvedBy: optionalInt8(resolvedBy),
+		ReviewID:   reviewID,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*entity.ReviewReport, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, toReviewReportEntity(row))
+	}
+	return reports, nil
+}
+
+func toReviewReportEntity(report sqlc.ReviewReport) *entity.ReviewReport {
+	return &entity.ReviewReport{
+		ID:         report.ID,
+		ReviewID:   report.ReviewID,
+		ReporterID: report.ReporterID,
+		Reason:     report.Reason,
+		Details:    textPtr(report.Details),
+		Status:     report.Status,
+		ResolvedBy: int8Ptr(report.ResolvedBy),
+		ResolvedAt: timestamptzPtr(report.ResolvedAt),
+		CreatedAt:  report.CreatedAt.Time,
+	}
+}
@@ -3,7 +3,9 @@ package persistence
 import (
 	"context"
 	"errors"
+	"fmt"
 	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
 	"user-review-ingest/internal/domain/repository"
 	"user-review-ingest/internal/domain/valueobject"
 	"user-review-ingest/internal/infrastructure/persistence/sqlc"
@@ -14,104 +16,407 @@ import (
 )
 
 type ReviewRepositoryImpl struct {
-	db      *pgxpool.Pool
-	queries sqlc.Querier
+	db *pgxpool.Pool
 }
 
 func NewReviewRepositoryImpl(db *pgxpool.Pool) repository.ReviewRepository {
-	return &ReviewRepositoryImpl{
-		db:      db,
-		queries: sqlc.New(db),
-	}
+	return &ReviewRepositoryImpl{db: db}
 }
 
 func (r *ReviewRepositoryImpl) Create(ctx context.Context, review *entity.Review) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	params := sqlc.CreateReviewParams{
-		UserID:    review.UserID,
-		ProductID: review.ProductID,
-		Rating:    int32(review.Rating.Int()),
-		Comment:   pgtype.Text{String: review.Comment, Valid: review.Comment != ""},
-		CreatedBy: pgtype.Text{String: review.CreatedBy, Valid: review.CreatedBy != ""},
+		TenantID:          tenantID,
+		UserID:            review.UserID,
+		ProductID:         review.ProductID,
+		Rating:            review.Rating.Normalized(),
+		RatingValue:       review.Rating.Value(),
+		RatingScaleMin:    review.Rating.Scale().Min,
+		RatingScaleMax:    review.Rating.Scale().Max,
+		RatingScaleStep:   review.Rating.Scale().Step,
+		Comment:           pgtype.Text{String: review.Comment, Valid: review.Comment != ""},
+		CreatedBy:         pgtype.Text{String: review.CreatedBy, Valid: review.CreatedBy != ""},
+		VerifiedPurchase:  review.VerifiedPurchase,
+		OrderID:           optionalInt8(review.OrderID),
+		Status:            review.Status,
+		CommentSimhash:    optionalSimHash(review.Fingerprint),
+		SentimentScore:    sentimentScore(review.Sentiment),
+		SentimentLabel:    sentimentLabel(review.Sentiment),
+		SentimentMismatch: review.SentimentMismatch,
+		DetectedLanguage:  optionalText(review.DetectedLanguage),
+		Locale:            optionalLocale(review.Locale),
+		Language:          optionalText(review.Language),
+		PublishAt:         pgtype.Timestamptz{Time: review.PublishAt, Valid: true},
+		PublishedAt:       optionalTimestamptz(review.PublishedAt),
 	}
-	createdReview, err := r.queries.CreateReview(ctx, params)
+	createdReview, err := queriesFor(ctx, r.db).CreateReview(ctx, params)
 	if err != nil {
 		return err
 	}
 	review.ID = createdReview.ID
 	review.CreatedAt = createdReview.CreatedAt.Time
+	review.Status = createdReview.Status
 	return nil
 }
 
 func (r *ReviewRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.Review, error) {
-	review, err := r.queries.GetReview(ctx, id)
+	tenantID, err := tenantID(ctx)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil // Or a custom not found error
-		}
 		return nil, err
 	}
 
-	rating, err := valueobject.NewRating(int(review.Rating))
+	review, err := queriesFor(ctx, r.db).GetReview(ctx, sqlc.GetReviewParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrReviewNotFound
+		}
 		return nil, err
 	}
 
-	return &entity.Review{
-		ID:        review.ID,
-		UserID:    review.UserID,
-		ProductID: review.ProductID,
-		Rating:    rating,
-		Comment:   review.Comment.String,
-		CreatedAt: review.CreatedAt.Time,
-		UpdatedAt: review.UpdatedAt.Time,
-		CreatedBy: review.CreatedBy.String,
-	}, nil
+	return toReviewEntity(review)
 }
 
 func (r *ReviewRepositoryImpl) Update(ctx context.Context, review *entity.Review) error {
-	// The sqlc generated code for UpdateReview expects pgtype.Int4 and pgtype.Text
+	// The sqlc generated code for UpdateReview expects pgtype.Float8 and pgtype.Text
 	// for the rating and comment fields, respectively. The linter might complain
 	// about this, but this is the correct way to handle nullable fields with
 	// sqlc.narg() and pgx/v5.
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
 	params := sqlc.UpdateReviewParams{
-		ID:      review.ID,
-		Rating:  pgtype.Int4{Int32: int32(review.Rating.Int()), Valid: true},
-		Comment: pgtype.Text{String: review.Comment, Valid: true},
+		ID:          review.ID,
+		TenantID:    tenantID,
+		Rating:      pgtype.Float8{Float64: review.Rating.Normalized(), Valid: true},
+		RatingValue: pgtype.Float8{Float64: review.Rating.Value(), Valid: true},
+		Comment:     pgtype.Text{String: review.Comment, Valid: true},
+		// Always replaced, as a changed comment may be too short for one
+		CommentSimhash:    optionalSimHash(review.Fingerprint),
+		SentimentScore:    sentimentScore(review.Sentiment),
+		SentimentLabel:    sentimentLabel(review.Sentiment),
+		SentimentMismatch: review.SentimentMismatch,
+		DetectedLanguage:  optionalText(review.DetectedLanguage),
+		Locale:            optionalLocale(review.Locale),
+		Language:          optionalText(review.Language),
 	}
-	_, err := r.queries.UpdateReview(ctx, params)
+	_, err = queriesFor(ctx, r.db).UpdateReview(ctx, params)
 	return err
 }
 
+func (r *ReviewRepositoryImpl) SetStatus(ctx context.Context, review *entity.Review) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).SetReviewStatus(ctx, sqlc.SetReviewStatusParams{
+		Status:   review.Status,
+		ID:       review.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrReviewNotFound
+		}
+		return err
+	}
+
+	review.UpdatedAt = updated.UpdatedAt.Time
+	return nil
+}
+
 func (r *ReviewRepositoryImpl) Delete(ctx context.Context, id int64) error {
-	return r.queries.DeleteReview(ctx, id)
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).DeleteReview(ctx, sqlc.DeleteReviewParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
 }
 
-func (r *ReviewRepositoryImpl) List(ctx context.Context, offset, limit int) ([]*entity.Review, error) {
+func (r *ReviewRepositoryImpl) List(ctx context.Context, opts repository.ReviewListOptions) ([]*entity.Review, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	params := sqlc.ListReviewsParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		TenantID:           tenantID,
+		Sort:               opts.Sort,
+		Limit:              int32(opts.Limit),
+		Offset:             int32(opts.Offset),
+		VerifiedPurchase:   optionalBool(opts.VerifiedPurchase),
+		ProductID:          optionalInt8(opts.ProductID),
+		Status:             optionalText(opts.Status),
+		SentimentLabel:     optionalText(opts.SentimentLabel),
+		SentimentMismatch:  optionalBool(opts.SentimentMismatch),
+		Language:           optionalText(opts.Language),
+		Published:          optionalBool(opts.Published),
+		PreferredLanguages: opts.PreferredLanguages,
 	}
-	reviews, err := r.queries.ListReviews(ctx, params)
+	reviews, err := queriesFor(ctx, r.db).ListReviews(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result []*entity.Review
 	for _, review := range reviews {
-		rating, err := valueobject.NewRating(int(review.Rating))
+		entityReview, err := toReviewEntity(review)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entityReview)
+	}
+	return result, nil
+}
+
+func (r *ReviewRepositoryImpl) ListByIDs(ctx context.Context, ids []int64) ([]*entity.Review, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := queriesFor(ctx, r.db).ListReviewsByIDs(ctx, sqlc.ListReviewsByIDsParams{
+		TenantID: tenantID,
+		Ids:      ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Review, 0, len(reviews))
+	for _, review := range reviews {
+		entityReview, err := toReviewEntity(review)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, &entity.Review{
-			ID:        review.ID,
-			UserID:    review.UserID,
-			ProductID: review.ProductID,
-			Rating:    rating,
-			Comment:   review.Comment.String,
-			CreatedAt: review.CreatedAt.Time,
-			UpdatedAt: review.UpdatedAt.Time,
-			CreatedBy: review.CreatedBy.String,
-		})
+		result = append(result, entityReview)
 	}
 	return result, nil
 }
+
+// exportFetchSize is the number of rows pulled from the export cursor per round trip.
+const exportFetchSize = 500
+
+// declareReviewExport opens a cursor over the same rows ListReviews filters,
+// in id order. sqlc cannot generate cursors, hence the hand-written query. Its
+// columns are those of sqlc.Review, which rows are scanned into by name.
+const declareReviewExport = `DECLARE review_export NO SCROLL CURSOR FOR
+SELECT
+    reviews.id,
+    reviews.user_id,
+    reviews.product_id,
+    reviews.rating,
+    reviews.comment,
+    reviews.created_at,
+    reviews.updated_at,
+    reviews.deleted_at,
+    reviews.created_by,
+    reviews.helpful_count,
+    reviews.unhelpful_count,
+    reviews.helpful_score,
+    reviews.verified_purchase,
+    reviews.order_id,
+    reviews.tenant_id,
+    reviews.status,
+    reviews.comment_simhash,
+    reviews.sentiment_score,
+    reviews.sentiment_label,
+    reviews.sentiment_mismatch,
+    reviews.detected_language,
+    reviews.locale,
+    reviews.language,
+    reviews.rating_value,
+    reviews.rating_scale_min,
+    reviews.rating_scale_max,
+    reviews.rating_scale_step,
+    reviews.publish_at,
+    reviews.published_at
+FROM reviews
+WHERE reviews.tenant_id = $1
+AND reviews.deleted_at IS NULL
+AND ($2::text IS NULL OR reviews.status = $2)
+AND ($3::boolean IS NULL OR reviews.verified_purchase = $3)
+AND ($4::bigint IS NULL OR reviews.product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = $4
+    AND requested.tenant_id = $1
+    AND variant.tenant_id = $1
+))
+AND ($5::text IS NULL OR reviews.sentiment_label = $5)
+AND ($6::boolean IS NULL OR reviews.sentiment_mismatch = $6)
+AND ($7::text IS NULL OR reviews.language = $7)
+AND ($8::boolean IS NULL OR (reviews.published_at IS NOT NULL) = $8)
+ORDER BY reviews.id`
+
+func (r *ReviewRepositoryImpl) Export(ctx context.Context, opts repository.ReviewListOptions, fn func(*entity.Review) error) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Cursors only live as long as their transaction. Outside one, read from a
+	// repeatable-read snapshot so a long export sees a consistent table.
+	tx, ok := txFor(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+	}
+
+	_, err = tx.Exec(ctx, declareReviewExport,
+		tenantID,
+		optionalText(opts.Status),
+		optionalBool(opts.VerifiedPurchase),
+		optionalInt8(opts.ProductID),
+		optionalText(opts.SentimentLabel),
+		optionalBool(opts.SentimentMismatch),
+		optionalText(opts.Language),
+		optionalBool(opts.Published),
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		rows, err := tx.Query(ctx, fmt.Sprintf("FETCH FORWARD %d FROM review_export", exportFetchSize))
+		if err != nil {
+			return err
+		}
+		batch, err := pgx.CollectRows(rows, pgx.RowToStructByName[sqlc.Review])
+		if err != nil {
+			return err
+		}
+
+		for _, row := range batch {
+			review, err := toReviewEntity(row)
+			if err != nil {
+				return err
+			}
+			if err := fn(review); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < exportFetchSize {
+			break
+		}
+	}
+
+	_, err = tx.Exec(ctx, "CLOSE review_export")
+	return err
+}
+
+func (r *ReviewRepositoryImpl) PublishDue(ctx context.Context, limit int) ([]*entity.Review, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := queriesFor(ctx, r.db).PublishDueReviews(ctx, sqlc.PublishDueReviewsParams{
+		TenantID: tenantID,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Review, 0, len(reviews))
+	for _, review := range reviews {
+		entityReview, err := toReviewEntity(review)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entityReview)
+	}
+	return result, nil
+}
+
+func toReviewEntity(review sqlc.Review) (*entity.Review, error) {
+	scale := valueobject.RatingScale{
+		Min:  review.RatingScaleMin,
+		Max:  review.RatingScaleMax,
+		Step: review.RatingScaleStep,
+	}
+	rating, err := scale.Rate(review.RatingValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Review{
+		ID:                review.ID,
+		UserID:            review.UserID,
+		ProductID:         review.ProductID,
+		Rating:            rating,
+		Comment:           review.Comment.String,
+		HelpfulCount:      int(review.HelpfulCount),
+		UnhelpfulCount:    int(review.UnhelpfulCount),
+		HelpfulScore:      review.HelpfulScore,
+		CreatedAt:         review.CreatedAt.Time,
+		UpdatedAt:         review.UpdatedAt.Time,
+		DeletedAt:         timestamptzPtr(review.DeletedAt),
+		CreatedBy:         review.CreatedBy.String,
+		VerifiedPurchase:  review.VerifiedPurchase,
+		OrderID:           int8Ptr(review.OrderID),
+		Status:            review.Status,
+		Fingerprint:       simHashPtr(review.CommentSimhash),
+		Sentiment:         toSentiment(review.SentimentScore, review.SentimentLabel),
+		SentimentMismatch: review.SentimentMismatch,
+		DetectedLanguage:  textPtr(review.DetectedLanguage),
+		Locale:            localePtr(review.Locale),
+		Language:          textPtr(review.Language),
+		PublishAt:         review.PublishAt.Time,
+		PublishedAt:       timestamptzPtr(review.PublishedAt),
+	}, nil
+}
+
+func optionalLocale(locale *valueobject.Locale) pgtype.Text {
+	if locale == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: string(*locale), Valid: true}
+}
+
+func localePtr(v pgtype.Text) *valueobject.Locale {
+	if !v.Valid {
+		return nil
+	}
+	locale := valueobject.Locale(v.String)
+	return &locale
+}
+
+func sentimentScore(sentiment *valueobject.Sentiment) pgtype.Float8 {
+	if sentiment == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: sentiment.Score, Valid: true}
+}
+
+func sentimentLabel(sentiment *valueobject.Sentiment) pgtype.Text {
+	if sentiment == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: sentiment.Label, Valid: true}
+}
+
+func toSentiment(score pgtype.Float8, label pgtype.Text) *valueobject.Sentiment {
+	if !score.Valid || !label.Valid {
+		return nil
+	}
+	return &valueobject.Sentiment{Score: score.Float64, Label: label.String}
+}
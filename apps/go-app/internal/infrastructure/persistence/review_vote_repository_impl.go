@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewVoteRepositoryImpl struct {
+	db        *pgxpool.Pool
+	txManager repository.TxManager
+}
+
+func NewReviewVoteRepositoryImpl(db *pgxpool.Pool) repository.ReviewVoteRepository {
+	return &ReviewVoteRepositoryImpl{
+		db:        db,
+		txManager: NewTxManagerImpl(db),
+	}
+}
+
+// Upsert records the vote, replacing any earlier vote by the same user, and
+// moves the review's helpful/unhelpful counters accordingly. The review row is
+// locked for the duration so concurrent votes cannot skew the counters.
+func (r *ReviewVoteRepositoryImpl) Upsert(ctx context.Context, vote *entity.ReviewVote) error {
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return r.upsert(ctx, vote)
+	})
+}
+
+func (r *ReviewVoteRepositoryImpl) upsert(ctx context.Context, vote *entity.ReviewVote) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if _, err := queries.LockReview(ctx, sqlc.LockReviewParams{ID: vote.ReviewID, TenantID: tenantID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrReviewNotFound
+		}
+		return err
+	}
+
+	var helpfulDelta, unhelpfulDelta int32
+	previous, err := queries.GetReviewVote(ctx, sqlc.GetReviewVoteParams{
+		ReviewID: vote.ReviewID,
+		UserID:   vote.UserID,
+		TenantID: tenantID,
+	})
+	switch {
+	case err == nil:
+		helpfulDelta, unhelpfulDelta = voteDeltas(previous.Helpful, -1)
+	case !errors.Is(err, pgx.ErrNoRows):
+		return err
+	}
+
+	saved, err := queries.UpsertReviewVote(ctx, sqlc.UpsertReviewVoteParams{
+		ReviewID: vote.ReviewID,
+		UserID:   vote.UserID,
+		Helpful:  vote.Helpful,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	h, u := voteDeltas(vote.Helpful, 1)
+	helpfulDelta += h
+	unhelpfulDelta += u
+	if helpfulDelta != 0 || unhelpfulDelta != 0 {
+		err = queries.AdjustReviewVoteCounts(ctx, sqlc.AdjustReviewVoteCountsParams{
+			ID:             vote.ReviewID,
+			TenantID:       tenantID,
+			HelpfulDelta:   helpfulDelta,
+			UnhelpfulDelta: unhelpfulDelta,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	vote.CreatedAt = saved.CreatedAt.Time
+	vote.UpdatedAt = saved.UpdatedAt.Time
+	return nil
+}
+
+// Delete withdraws the user's vote on the review and reverts its effect on the counters.
+func (r *ReviewVoteRepositoryImpl) Delete(ctx context.Context, reviewID, userID int64) error {
+	return r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return r.delete(ctx, reviewID, userID)
+	})
+}
+
+func (r *ReviewVoteRepositoryImpl) delete(ctx context.Context, reviewID, userID int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFor(ctx, r.db)
+	if _, err := queries.LockReview(ctx, sqlc.LockReviewParams{ID: reviewID, TenantID: tenantID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrReviewNotFound
+		}
+		return err
+	}
+
+	deleted, err := queries.DeleteReviewVote(ctx, sqlc.DeleteReviewVoteParams{
+		ReviewID: reviewID,
+		UserID:   userID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrReviewVoteNotFound
+		}
+		return err
+	}
+
+	helpfulDelta, unhelpfulDelta := voteDeltas(deleted.Helpful, -1)
+	return queries.AdjustReviewVoteCounts(ctx, sqlc.AdjustReviewVoteCountsParams{
+		ID:             reviewID,
+		TenantID:       tenantID,
+		HelpfulDelta:   helpfulDelta,
+		UnhelpfulDelta: unhelpfulDelta,
+	})
+}
+
+// voteDeltas returns the counter changes caused by adding (sign 1) or removing
+// (sign -1) a vote.
+func voteDeltas(helpful bool, sign int32) (helpfulDelta, unhelpfulDelta int32) {
+	if helpful {
+		return sign, 0
+	}
+	return 0, sign
+}
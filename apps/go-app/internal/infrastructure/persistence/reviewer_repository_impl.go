@@ -0,0 +1,54 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewerRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewerRepositoryImpl(db *pgxpool.Pool) repository.ReviewerRepository {
+	return &ReviewerRepositoryImpl{db: db}
+}
+
+func (r *ReviewerRepositoryImpl) GetProfile(ctx context.Context, userID int64) (*entity.ReviewerProfile, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := queriesFor(ctx, r.db).GetReviewerStats(ctx, sqlc.GetReviewerStatsParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrReviewerNotFound
+		}
+		return nil, err
+	}
+	return toReviewerProfileEntity(stats), nil
+}
+
+func toReviewerProfileEntity(stats sqlc.ReviewerStat) *entity.ReviewerProfile {
+	return &entity.ReviewerProfile{
+		UserID:         stats.UserID,
+		ReviewCount:    int(stats.ReviewCount),
+		ApprovedCount:  int(stats.ApprovedCount),
+		RejectedCount:  int(stats.RejectedCount),
+		VerifiedCount:  int(stats.VerifiedCount),
+		HelpfulVotes:   int(stats.HelpfulVotes),
+		UnhelpfulVotes: int(stats.UnhelpfulVotes),
+		Reputation:     stats.Reputation,
+		UpdatedAt:      stats.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: audit.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const createAuditEntry = `-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (
+    entity_type,
+    entity_id,
+    action,
+    actor,
+    before,
+    after,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type CreateAuditEntryParams struct {
+	EntityType string `json:"entityType"`
+	EntityID   int64  `json:"entityId"`
+	Action     string `json:"action"`
+	Actor      string `json:"actor"`
+	Before     []byte `json:"before"`
+	After      []byte `json:"after"`
+	TenantID   int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, createAuditEntry,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Action,
+		arg.Actor,
+		arg.Before,
+		arg.After,
+		arg.TenantID,
+	)
+	return err
+}
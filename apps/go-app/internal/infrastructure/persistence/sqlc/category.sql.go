@@ -0,0 +1,305 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: category.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCategory = `-- name: CreateCategory :one
+INSERT INTO categories (
+    slug,
+    name,
+    rating_scale_min,
+    rating_scale_max,
+    rating_scale_step,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, tenant_id, slug, name, created_at, updated_at, rating_scale_min, rating_scale_max, rating_scale_step
+`
+
+type CreateCategoryParams struct {
+	Slug            string        `json:"slug"`
+	Name            string        `json:"name"`
+	RatingScaleMin  pgtype.Float8 `json:"ratingScaleMin"`
+	RatingScaleMax  pgtype.Float8 `json:"ratingScaleMax"`
+	RatingScaleStep pgtype.Float8 `json:"ratingScaleStep"`
+	TenantID        int64         `json:"tenantId"`
+}
+
+func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
+	row := q.db.QueryRow(ctx, createCategory,
+		arg.Slug,
+		arg.Name,
+		arg.RatingScaleMin,
+		arg.RatingScaleMax,
+		arg.RatingScaleStep,
+		arg.TenantID,
+	)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Slug,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+	)
+	return i, err
+}
+
+const deleteCategory = `-- name: DeleteCategory :execrows
+DELETE FROM categories
+WHERE id = $1 AND tenant_id = $2
+`
+
+type DeleteCategoryParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteCategory(ctx context.Context, arg DeleteCategoryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCategory, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCategoryAspectsNotInKeys = `-- name: DeleteCategoryAspectsNotInKeys :exec
+DELETE FROM category_aspects
+WHERE category_id = $1
+AND tenant_id = $2
+AND key <> ALL($3::text[])
+`
+
+type DeleteCategoryAspectsNotInKeysParams struct {
+	CategoryID int64    `json:"categoryId"`
+	TenantID   int64    `json:"tenantId"`
+	Keys       []string `json:"keys"`
+}
+
+// Ratings of the deleted aspects go with them.
+func (q *Queries) DeleteCategoryAspectsNotInKeys(ctx context.Context, arg DeleteCategoryAspectsNotInKeysParams) error {
+	_, err := q.db.Exec(ctx, deleteCategoryAspectsNotInKeys, arg.CategoryID, arg.TenantID, arg.Keys)
+	return err
+}
+
+const getCategory = `-- name: GetCategory :one
+SELECT id, tenant_id, slug, name, created_at, updated_at, rating_scale_min, rating_scale_max, rating_scale_step FROM categories
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetCategoryParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetCategory(ctx context.Context, arg GetCategoryParams) (Category, error) {
+	row := q.db.QueryRow(ctx, getCategory, arg.ID, arg.TenantID)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Slug,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+	)
+	return i, err
+}
+
+const listCategories = `-- name: ListCategories :many
+SELECT id, tenant_id, slug, name, created_at, updated_at, rating_scale_min, rating_scale_max, rating_scale_step FROM categories
+WHERE tenant_id = $1
+ORDER BY slug
+LIMIT $3
+OFFSET $2
+`
+
+type ListCategoriesParams struct {
+	TenantID int64 `json:"tenantId"`
+	Offset   int32 `json:"offset"`
+	Limit    int32 `json:"limit"`
+}
+
+func (q *Queries) ListCategories(ctx context.Context, arg ListCategoriesParams) ([]Category, error) {
+	rows, err := q.db.Query(ctx, listCategories, arg.TenantID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Category{}
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Slug,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryAspects = `-- name: ListCategoryAspects :many
+SELECT id, tenant_id, category_id, key, name, required, position, created_at FROM category_aspects
+WHERE category_id = $1 AND tenant_id = $2
+ORDER BY position, id
+`
+
+type ListCategoryAspectsParams struct {
+	CategoryID int64 `json:"categoryId"`
+	TenantID   int64 `json:"tenantId"`
+}
+
+func (q *Queries) ListCategoryAspects(ctx context.Context, arg ListCategoryAspectsParams) ([]CategoryAspect, error) {
+	rows, err := q.db.Query(ctx, listCategoryAspects, arg.CategoryID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CategoryAspect{}
+	for rows.Next() {
+		var i CategoryAspect
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.CategoryID,
+			&i.Key,
+			&i.Name,
+			&i.Required,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCategory = `-- name: UpdateCategory :one
+UPDATE categories
+SET
+    slug = $1,
+    name = $2,
+    rating_scale_min = $3,
+    rating_scale_max = $4,
+    rating_scale_step = $5,
+    updated_at = NOW()
+WHERE id = $6 AND tenant_id = $7
+RETURNING id, tenant_id, slug, name, created_at, updated_at, rating_scale_min, rating_scale_max, rating_scale_step
+`
+
+type UpdateCategoryParams struct {
+	Slug            string        `json:"slug"`
+	Name            string        `json:"name"`
+	RatingScaleMin  pgtype.Float8 `json:"ratingScaleMin"`
+	RatingScaleMax  pgtype.Float8 `json:"ratingScaleMax"`
+	RatingScaleStep pgtype.Float8 `json:"ratingScaleStep"`
+	ID              int64         `json:"id"`
+	TenantID        int64         `json:"tenantId"`
+}
+
+func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
+	row := q.db.QueryRow(ctx, updateCategory,
+		arg.Slug,
+		arg.Name,
+		arg.RatingScaleMin,
+		arg.RatingScaleMax,
+		arg.RatingScaleStep,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Slug,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+	)
+	return i, err
+}
+
+const upsertCategoryAspect = `-- name: UpsertCategoryAspect :one
+INSERT INTO category_aspects (
+    category_id,
+    key,
+    name,
+    required,
+    position,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (category_id, key) DO UPDATE
+SET
+    name = EXCLUDED.name,
+    required = EXCLUDED.required,
+    position = EXCLUDED.position
+RETURNING id, tenant_id, category_id, key, name, required, position, created_at
+`
+
+type UpsertCategoryAspectParams struct {
+	CategoryID int64  `json:"categoryId"`
+	Key        string `json:"key"`
+	Name       string `json:"name"`
+	Required   bool   `json:"required"`
+	Position   int32  `json:"position"`
+	TenantID   int64  `json:"tenantId"`
+}
+
+func (q *Queries) UpsertCategoryAspect(ctx context.Context, arg UpsertCategoryAspectParams) (CategoryAspect, error) {
+	row := q.db.QueryRow(ctx, upsertCategoryAspect,
+		arg.CategoryID,
+		arg.Key,
+		arg.Name,
+		arg.Required,
+		arg.Position,
+		arg.TenantID,
+	)
+	var i CategoryAspect
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.CategoryID,
+		&i.Key,
+		&i.Name,
+		&i.Required,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,1408 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: data_subject.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const anonymizeProductAnswersByUser = `-- name: AnonymizeProductAnswersByUser :execrows
+UPDATE product_answers
+SET user_id = 0
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type AnonymizeProductAnswersByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) AnonymizeProductAnswersByUser(ctx context.Context, arg AnonymizeProductAnswersByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeProductAnswersByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const anonymizeProductQuestionsByUser = `-- name: AnonymizeProductQuestionsByUser :execrows
+UPDATE product_questions
+SET user_id = 0
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type AnonymizeProductQuestionsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) AnonymizeProductQuestionsByUser(ctx context.Context, arg AnonymizeProductQuestionsByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeProductQuestionsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const anonymizeReviewRepliesByAuthor = `-- name: AnonymizeReviewRepliesByAuthor :execrows
+UPDATE review_replies
+SET author_id = 0
+WHERE tenant_id = $1 AND author_id = $2
+`
+
+type AnonymizeReviewRepliesByAuthorParams struct {
+	TenantID int64 `json:"tenantId"`
+	AuthorID int64 `json:"authorId"`
+}
+
+// Replies speak for the brand, so only their author is removed.
+func (q *Queries) AnonymizeReviewRepliesByAuthor(ctx context.Context, arg AnonymizeReviewRepliesByAuthorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeReviewRepliesByAuthor, arg.TenantID, arg.AuthorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const anonymizeReviewsByUser = `-- name: AnonymizeReviewsByUser :many
+UPDATE reviews
+SET
+    user_id = 0,
+    created_by = NULL,
+    updated_at = NOW()
+WHERE tenant_id = $1 AND user_id = $2
+RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at
+`
+
+type AnonymizeReviewsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Detaches the reviews from their author; their content stays published.
+func (q *Queries) AnonymizeReviewsByUser(ctx context.Context, arg AnonymizeReviewsByUserParams) ([]Review, error) {
+	rows, err := q.db.Query(ctx, anonymizeReviewsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Review{}
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.Rating,
+			&i.Comment,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.HelpfulScore,
+			&i.VerifiedPurchase,
+			&i.OrderID,
+			&i.TenantID,
+			&i.Status,
+			&i.CommentSimhash,
+			&i.SentimentScore,
+			&i.SentimentLabel,
+			&i.SentimentMismatch,
+			&i.DetectedLanguage,
+			&i.Locale,
+			&i.Language,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.PublishAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createDataSubjectRequest = `-- name: CreateDataSubjectRequest :one
+INSERT INTO data_subject_requests (
+    kind,
+    user_id,
+    account_id,
+    requested_by,
+    review_policy,
+    summary,
+    archive_sha256,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, tenant_id, kind, user_id, account_id, requested_by, review_policy, summary, archive_sha256, fulfilled_at
+`
+
+type CreateDataSubjectRequestParams struct {
+	Kind          string      `json:"kind"`
+	UserID        int64       `json:"userId"`
+	AccountID     pgtype.UUID `json:"accountId"`
+	RequestedBy   string      `json:"requestedBy"`
+	ReviewPolicy  pgtype.Text `json:"reviewPolicy"`
+	Summary       []byte      `json:"summary"`
+	ArchiveSha256 pgtype.Text `json:"archiveSha256"`
+	TenantID      int64       `json:"tenantId"`
+}
+
+func (q *Queries) CreateDataSubjectRequest(ctx context.Context, arg CreateDataSubjectRequestParams) (DataSubjectRequest, error) {
+	row := q.db.QueryRow(ctx, createDataSubjectRequest,
+		arg.Kind,
+		arg.UserID,
+		arg.AccountID,
+		arg.RequestedBy,
+		arg.ReviewPolicy,
+		arg.Summary,
+		arg.ArchiveSha256,
+		arg.TenantID,
+	)
+	var i DataSubjectRequest
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Kind,
+		&i.UserID,
+		&i.AccountID,
+		&i.RequestedBy,
+		&i.ReviewPolicy,
+		&i.Summary,
+		&i.ArchiveSha256,
+		&i.FulfilledAt,
+	)
+	return i, err
+}
+
+const deleteAuthAccount = `-- name: DeleteAuthAccount :execrows
+DELETE FROM auth
+WHERE id = $1 AND tenant_id = $2
+`
+
+type DeleteAuthAccountParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID int64       `json:"tenantId"`
+}
+
+func (q *Queries) DeleteAuthAccount(ctx context.Context, arg DeleteAuthAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAuthAccount, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExportJobsByRequester = `-- name: DeleteExportJobsByRequester :execrows
+DELETE FROM export_jobs
+WHERE tenant_id = $1 AND requested_by = $2
+`
+
+type DeleteExportJobsByRequesterParams struct {
+	TenantID    int64 `json:"tenantId"`
+	RequestedBy int64 `json:"requestedBy"`
+}
+
+func (q *Queries) DeleteExportJobsByRequester(ctx context.Context, arg DeleteExportJobsByRequesterParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExportJobsByRequester, arg.TenantID, arg.RequestedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOAuthProvidersByUser = `-- name: DeleteOAuthProvidersByUser :execrows
+DELETE FROM oauth_providers
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type DeleteOAuthProvidersByUserParams struct {
+	TenantID int64       `json:"tenantId"`
+	UserID   pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) DeleteOAuthProvidersByUser(ctx context.Context, arg DeleteOAuthProvidersByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOAuthProvidersByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOrdersByCustomer = `-- name: DeleteOrdersByCustomer :execrows
+DELETE FROM orders
+WHERE tenant_id = $1 AND customer_id = $2
+`
+
+type DeleteOrdersByCustomerParams struct {
+	TenantID   int64 `json:"tenantId"`
+	CustomerID int64 `json:"customerId"`
+}
+
+func (q *Queries) DeleteOrdersByCustomer(ctx context.Context, arg DeleteOrdersByCustomerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrdersByCustomer, arg.TenantID, arg.CustomerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteProductAnswersByUser = `-- name: DeleteProductAnswersByUser :execrows
+DELETE FROM product_answers
+WHERE tenant_id = $1 AND user_id = $2 AND NOT brand
+`
+
+type DeleteProductAnswersByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Brand answers speak for the brand and are anonymized instead.
+func (q *Queries) DeleteProductAnswersByUser(ctx context.Context, arg DeleteProductAnswersByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProductAnswersByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteProductOwnershipsByUser = `-- name: DeleteProductOwnershipsByUser :execrows
+DELETE FROM product_owners
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type DeleteProductOwnershipsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) DeleteProductOwnershipsByUser(ctx context.Context, arg DeleteProductOwnershipsByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProductOwnershipsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteProductQuestionsByUser = `-- name: DeleteProductQuestionsByUser :execrows
+DELETE FROM product_questions
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type DeleteProductQuestionsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Removes the questions together with their answers.
+func (q *Queries) DeleteProductQuestionsByUser(ctx context.Context, arg DeleteProductQuestionsByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProductQuestionsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewInvitationsByCustomer = `-- name: DeleteReviewInvitationsByCustomer :execrows
+DELETE FROM review_invitations
+WHERE tenant_id = $1 AND customer_id = $2
+`
+
+type DeleteReviewInvitationsByCustomerParams struct {
+	TenantID   int64 `json:"tenantId"`
+	CustomerID int64 `json:"customerId"`
+}
+
+func (q *Queries) DeleteReviewInvitationsByCustomer(ctx context.Context, arg DeleteReviewInvitationsByCustomerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteReviewInvitationsByCustomer, arg.TenantID, arg.CustomerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewReportsByReporter = `-- name: DeleteReviewReportsByReporter :execrows
+DELETE FROM review_reports
+WHERE tenant_id = $1 AND reporter_id = $2
+`
+
+type DeleteReviewReportsByReporterParams struct {
+	TenantID   int64 `json:"tenantId"`
+	ReporterID int64 `json:"reporterId"`
+}
+
+func (q *Queries) DeleteReviewReportsByReporter(ctx context.Context, arg DeleteReviewReportsByReporterParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteReviewReportsByReporter, arg.TenantID, arg.ReporterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewerStatsByUser = `-- name: DeleteReviewerStatsByUser :execrows
+DELETE FROM reviewer_stats
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type DeleteReviewerStatsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Runs after the user's reviews are deleted or anonymized, which empty the totals.
+func (q *Queries) DeleteReviewerStatsByUser(ctx context.Context, arg DeleteReviewerStatsByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteReviewerStatsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewsByUser = `-- name: DeleteReviewsByUser :many
+DELETE FROM reviews
+WHERE tenant_id = $1 AND user_id = $2
+RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at
+`
+
+type DeleteReviewsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Removes the reviews together with their votes, replies and attachments.
+func (q *Queries) DeleteReviewsByUser(ctx context.Context, arg DeleteReviewsByUserParams) ([]Review, error) {
+	rows, err := q.db.Query(ctx, deleteReviewsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Review{}
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.Rating,
+			&i.Comment,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.HelpfulScore,
+			&i.VerifiedPurchase,
+			&i.OrderID,
+			&i.TenantID,
+			&i.Status,
+			&i.CommentSimhash,
+			&i.SentimentScore,
+			&i.SentimentLabel,
+			&i.SentimentMismatch,
+			&i.DetectedLanguage,
+			&i.Locale,
+			&i.Language,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.PublishAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteUserProfilesByEmail = `-- name: DeleteUserProfilesByEmail :execrows
+DELETE FROM user_profiles
+WHERE tenant_id = $1 AND email = $2
+`
+
+type DeleteUserProfilesByEmailParams struct {
+	TenantID int64  `json:"tenantId"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) DeleteUserProfilesByEmail(ctx context.Context, arg DeleteUserProfilesByEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserProfilesByEmail, arg.TenantID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteVelocityCountersByUser = `-- name: DeleteVelocityCountersByUser :execrows
+DELETE FROM review_velocity_counters
+WHERE tenant_id = $1 AND scope = 'user' AND subject = $2
+`
+
+type DeleteVelocityCountersByUserParams struct {
+	TenantID int64  `json:"tenantId"`
+	Subject  string `json:"subject"`
+}
+
+func (q *Queries) DeleteVelocityCountersByUser(ctx context.Context, arg DeleteVelocityCountersByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteVelocityCountersByUser, arg.TenantID, arg.Subject)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const eraseProductAnswerVotesByUser = `-- name: EraseProductAnswerVotesByUser :one
+WITH removed AS (
+    DELETE FROM product_answer_votes
+    WHERE product_answer_votes.tenant_id = $1 AND product_answer_votes.user_id = $2
+    RETURNING product_answer_votes.answer_id, product_answer_votes.helpful
+),
+totals AS (
+    SELECT
+        removed.answer_id,
+        (COUNT(*) FILTER (WHERE removed.helpful))::int AS helpful,
+        (COUNT(*) FILTER (WHERE NOT removed.helpful))::int AS unhelpful
+    FROM removed
+    GROUP BY removed.answer_id
+),
+adjusted AS (
+    UPDATE product_answers
+    SET
+        helpful_count = product_answers.helpful_count - totals.helpful,
+        unhelpful_count = product_answers.unhelpful_count - totals.unhelpful
+    FROM totals
+    WHERE product_answers.id = totals.answer_id AND product_answers.tenant_id = $1
+    RETURNING product_answers.id
+)
+SELECT COUNT(*) FROM removed
+`
+
+type EraseProductAnswerVotesByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Removes the user's answer votes and takes them off the answers' vote counts.
+func (q *Queries) EraseProductAnswerVotesByUser(ctx context.Context, arg EraseProductAnswerVotesByUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, eraseProductAnswerVotesByUser, arg.TenantID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const eraseReviewVotesByUser = `-- name: EraseReviewVotesByUser :one
+WITH removed AS (
+    DELETE FROM review_votes
+    WHERE review_votes.tenant_id = $1 AND review_votes.user_id = $2
+    RETURNING review_votes.review_id, review_votes.helpful
+),
+totals AS (
+    SELECT
+        removed.review_id,
+        (COUNT(*) FILTER (WHERE removed.helpful))::int AS helpful,
+        (COUNT(*) FILTER (WHERE NOT removed.helpful))::int AS unhelpful
+    FROM removed
+    GROUP BY removed.review_id
+),
+adjusted AS (
+    UPDATE reviews
+    SET
+        helpful_count = reviews.helpful_count - totals.helpful,
+        unhelpful_count = reviews.unhelpful_count - totals.unhelpful
+    FROM totals
+    WHERE reviews.id = totals.review_id AND reviews.tenant_id = $1
+    RETURNING reviews.id
+)
+SELECT COUNT(*) FROM removed
+`
+
+type EraseReviewVotesByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Removes the user's votes and takes them off the reviews' vote counts.
+func (q *Queries) EraseReviewVotesByUser(ctx context.Context, arg EraseReviewVotesByUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, eraseReviewVotesByUser, arg.TenantID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getAuthAccount = `-- name: GetAuthAccount :one
+SELECT id, email, password_hash, status, created_at, updated_at, deleted_at, tenant_id FROM auth
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetAuthAccountParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID int64       `json:"tenantId"`
+}
+
+func (q *Queries) GetAuthAccount(ctx context.Context, arg GetAuthAccountParams) (Auth, error) {
+	row := q.db.QueryRow(ctx, getAuthAccount, arg.ID, arg.TenantID)
+	var i Auth
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const getReviewerStatsByUser = `-- name: GetReviewerStatsByUser :one
+SELECT tenant_id, user_id, review_count, approved_count, rejected_count, verified_count, helpful_votes, unhelpful_votes, updated_at, reputation FROM reviewer_stats
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type GetReviewerStatsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) GetReviewerStatsByUser(ctx context.Context, arg GetReviewerStatsByUserParams) (ReviewerStat, error) {
+	row := q.db.QueryRow(ctx, getReviewerStatsByUser, arg.TenantID, arg.UserID)
+	var i ReviewerStat
+	err := row.Scan(
+		&i.TenantID,
+		&i.UserID,
+		&i.ReviewCount,
+		&i.ApprovedCount,
+		&i.RejectedCount,
+		&i.VerifiedCount,
+		&i.HelpfulVotes,
+		&i.UnhelpfulVotes,
+		&i.UpdatedAt,
+		&i.Reputation,
+	)
+	return i, err
+}
+
+const getTokenRevocation = `-- name: GetTokenRevocation :one
+SELECT revoked_before FROM token_revocations
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type GetTokenRevocationParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) GetTokenRevocation(ctx context.Context, arg GetTokenRevocationParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getTokenRevocation, arg.TenantID, arg.UserID)
+	var revoked_before pgtype.Timestamptz
+	err := row.Scan(&revoked_before)
+	return revoked_before, err
+}
+
+const listDataSubjectRequests = `-- name: ListDataSubjectRequests :many
+SELECT id, tenant_id, kind, user_id, account_id, requested_by, review_policy, summary, archive_sha256, fulfilled_at FROM data_subject_requests
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY fulfilled_at DESC, id DESC
+`
+
+type ListDataSubjectRequestsParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListDataSubjectRequests(ctx context.Context, arg ListDataSubjectRequestsParams) ([]DataSubjectRequest, error) {
+	rows, err := q.db.Query(ctx, listDataSubjectRequests, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DataSubjectRequest{}
+	for rows.Next() {
+		var i DataSubjectRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Kind,
+			&i.UserID,
+			&i.AccountID,
+			&i.RequestedBy,
+			&i.ReviewPolicy,
+			&i.Summary,
+			&i.ArchiveSha256,
+			&i.FulfilledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExportJobsByRequester = `-- name: ListExportJobsByRequester :many
+SELECT id, tenant_id, requested_by, format, gzip, filters, status, blob_key, row_count, size_bytes, error, created_at, started_at, finished_at FROM export_jobs
+WHERE tenant_id = $1 AND requested_by = $2
+ORDER BY id
+`
+
+type ListExportJobsByRequesterParams struct {
+	TenantID    int64 `json:"tenantId"`
+	RequestedBy int64 `json:"requestedBy"`
+}
+
+func (q *Queries) ListExportJobsByRequester(ctx context.Context, arg ListExportJobsByRequesterParams) ([]ExportJob, error) {
+	rows, err := q.db.Query(ctx, listExportJobsByRequester, arg.TenantID, arg.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportJob{}
+	for rows.Next() {
+		var i ExportJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.RequestedBy,
+			&i.Format,
+			&i.Gzip,
+			&i.Filters,
+			&i.Status,
+			&i.BlobKey,
+			&i.RowCount,
+			&i.SizeBytes,
+			&i.Error,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOAuthProvidersByUser = `-- name: ListOAuthProvidersByUser :many
+SELECT id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, scopes, created_at, updated_at, tenant_id FROM oauth_providers
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY created_at
+`
+
+type ListOAuthProvidersByUserParams struct {
+	TenantID int64       `json:"tenantId"`
+	UserID   pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) ListOAuthProvidersByUser(ctx context.Context, arg ListOAuthProvidersByUserParams) ([]OauthProvider, error) {
+	rows, err := q.db.Query(ctx, listOAuthProvidersByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OauthProvider{}
+	for rows.Next() {
+		var i OauthProvider
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProviderName,
+			&i.ProviderUserID,
+			&i.Email,
+			&i.Name,
+			&i.AccessToken,
+			&i.RefreshToken,
+			&i.ExpiresAt,
+			&i.Scopes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrdersByCustomer = `-- name: ListOrdersByCustomer :many
+SELECT id, external_id, customer_id, product_id, purchased_at, created_at, updated_at, tenant_id FROM orders
+WHERE tenant_id = $1 AND customer_id = $2
+ORDER BY id
+`
+
+type ListOrdersByCustomerParams struct {
+	TenantID   int64 `json:"tenantId"`
+	CustomerID int64 `json:"customerId"`
+}
+
+func (q *Queries) ListOrdersByCustomer(ctx context.Context, arg ListOrdersByCustomerParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, listOrdersByCustomer, arg.TenantID, arg.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.CustomerID,
+			&i.ProductID,
+			&i.PurchasedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductAnswerVotesByUser = `-- name: ListProductAnswerVotesByUser :many
+SELECT tenant_id, answer_id, user_id, helpful, created_at, updated_at FROM product_answer_votes
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY answer_id
+`
+
+type ListProductAnswerVotesByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListProductAnswerVotesByUser(ctx context.Context, arg ListProductAnswerVotesByUserParams) ([]ProductAnswerVote, error) {
+	rows, err := q.db.Query(ctx, listProductAnswerVotesByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductAnswerVote{}
+	for rows.Next() {
+		var i ProductAnswerVote
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.AnswerID,
+			&i.UserID,
+			&i.Helpful,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductAnswersByUser = `-- name: ListProductAnswersByUser :many
+SELECT id, tenant_id, question_id, user_id, body, brand, status, helpful_count, unhelpful_count, created_at, updated_at, deleted_at, helpful_score FROM product_answers
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY id
+`
+
+type ListProductAnswersByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListProductAnswersByUser(ctx context.Context, arg ListProductAnswersByUserParams) ([]ProductAnswer, error) {
+	rows, err := q.db.Query(ctx, listProductAnswersByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductAnswer{}
+	for rows.Next() {
+		var i ProductAnswer
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.QuestionID,
+			&i.UserID,
+			&i.Body,
+			&i.Brand,
+			&i.Status,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.HelpfulScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductOwnershipsByUser = `-- name: ListProductOwnershipsByUser :many
+SELECT product_id, user_id, created_at, tenant_id FROM product_owners
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY product_id
+`
+
+type ListProductOwnershipsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListProductOwnershipsByUser(ctx context.Context, arg ListProductOwnershipsByUserParams) ([]ProductOwner, error) {
+	rows, err := q.db.Query(ctx, listProductOwnershipsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductOwner{}
+	for rows.Next() {
+		var i ProductOwner
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductQuestionsByUser = `-- name: ListProductQuestionsByUser :many
+SELECT id, tenant_id, product_id, user_id, body, status, created_at, updated_at, deleted_at FROM product_questions
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY id
+`
+
+type ListProductQuestionsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListProductQuestionsByUser(ctx context.Context, arg ListProductQuestionsByUserParams) ([]ProductQuestion, error) {
+	rows, err := q.db.Query(ctx, listProductQuestionsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductQuestion{}
+	for rows.Next() {
+		var i ProductQuestion
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ProductID,
+			&i.UserID,
+			&i.Body,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewAttachmentsByUser = `-- name: ListReviewAttachmentsByUser :many
+SELECT review_attachments.id, review_attachments.review_id, review_attachments.blob_key, review_attachments.thumbnail_key, review_attachments.content_type, review_attachments.size_bytes, review_attachments.width, review_attachments.height, review_attachments.created_at, review_attachments.deleted_at, review_attachments.tenant_id FROM review_attachments
+JOIN reviews ON reviews.id = review_attachments.review_id
+WHERE review_attachments.tenant_id = $1
+AND reviews.tenant_id = $1
+AND reviews.user_id = $2
+ORDER BY review_attachments.id
+`
+
+type ListReviewAttachmentsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListReviewAttachmentsByUser(ctx context.Context, arg ListReviewAttachmentsByUserParams) ([]ReviewAttachment, error) {
+	rows, err := q.db.Query(ctx, listReviewAttachmentsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewAttachment{}
+	for rows.Next() {
+		var i ReviewAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviewID,
+			&i.BlobKey,
+			&i.ThumbnailKey,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.Width,
+			&i.Height,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewInvitationsByCustomer = `-- name: ListReviewInvitationsByCustomer :many
+SELECT id, tenant_id, order_id, customer_id, product_id, expires_at, opened_at, completed_at, review_id, created_at FROM review_invitations
+WHERE tenant_id = $1 AND customer_id = $2
+ORDER BY id
+`
+
+type ListReviewInvitationsByCustomerParams struct {
+	TenantID   int64 `json:"tenantId"`
+	CustomerID int64 `json:"customerId"`
+}
+
+func (q *Queries) ListReviewInvitationsByCustomer(ctx context.Context, arg ListReviewInvitationsByCustomerParams) ([]ReviewInvitation, error) {
+	rows, err := q.db.Query(ctx, listReviewInvitationsByCustomer, arg.TenantID, arg.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewInvitation{}
+	for rows.Next() {
+		var i ReviewInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.OrderID,
+			&i.CustomerID,
+			&i.ProductID,
+			&i.ExpiresAt,
+			&i.OpenedAt,
+			&i.CompletedAt,
+			&i.ReviewID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewRepliesByAuthor = `-- name: ListReviewRepliesByAuthor :many
+SELECT id, review_id, author_id, body, created_at, updated_at, deleted_at, tenant_id FROM review_replies
+WHERE tenant_id = $1 AND author_id = $2
+ORDER BY id
+`
+
+type ListReviewRepliesByAuthorParams struct {
+	TenantID int64 `json:"tenantId"`
+	AuthorID int64 `json:"authorId"`
+}
+
+func (q *Queries) ListReviewRepliesByAuthor(ctx context.Context, arg ListReviewRepliesByAuthorParams) ([]ReviewReply, error) {
+	rows, err := q.db.Query(ctx, listReviewRepliesByAuthor, arg.TenantID, arg.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewReply{}
+	for rows.Next() {
+		var i ReviewReply
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviewID,
+			&i.AuthorID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewReportsByReporter = `-- name: ListReviewReportsByReporter :many
+SELECT id, tenant_id, review_id, reporter_id, reason, details, status, resolved_by, resolved_at, created_at FROM review_reports
+WHERE tenant_id = $1 AND reporter_id = $2
+ORDER BY id
+`
+
+type ListReviewReportsByReporterParams struct {
+	TenantID   int64 `json:"tenantId"`
+	ReporterID int64 `json:"reporterId"`
+}
+
+func (q *Queries) ListReviewReportsByReporter(ctx context.Context, arg ListReviewReportsByReporterParams) ([]ReviewReport, error) {
+	rows, err := q.db.Query(ctx, listReviewReportsByReporter, arg.TenantID, arg.ReporterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewReport{}
+	for rows.Next() {
+		var i ReviewReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ReviewID,
+			&i.ReporterID,
+			&i.Reason,
+			&i.Details,
+			&i.Status,
+			&i.ResolvedBy,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewVotesByUser = `-- name: ListReviewVotesByUser :many
+SELECT review_id, user_id, helpful, created_at, updated_at, tenant_id FROM review_votes
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY review_id
+`
+
+type ListReviewVotesByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) ListReviewVotesByUser(ctx context.Context, arg ListReviewVotesByUserParams) ([]ReviewVote, error) {
+	rows, err := q.db.Query(ctx, listReviewVotesByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewVote{}
+	for rows.Next() {
+		var i ReviewVote
+		if err := rows.Scan(
+			&i.ReviewID,
+			&i.UserID,
+			&i.Helpful,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewsByUser = `-- name: ListReviewsByUser :many
+
+SELECT id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at FROM reviews
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY id
+`
+
+type ListReviewsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Personal data held about a user, for access and erasure requests.
+func (q *Queries) ListReviewsByUser(ctx context.Context, arg ListReviewsByUserParams) ([]Review, error) {
+	rows, err := q.db.Query(ctx, listReviewsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Review{}
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.Rating,
+			&i.Comment,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.HelpfulScore,
+			&i.VerifiedPurchase,
+			&i.OrderID,
+			&i.TenantID,
+			&i.Status,
+			&i.CommentSimhash,
+			&i.SentimentScore,
+			&i.SentimentLabel,
+			&i.SentimentMismatch,
+			&i.DetectedLanguage,
+			&i.Locale,
+			&i.Language,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.PublishAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserProfilesByEmail = `-- name: ListUserProfilesByEmail :many
+SELECT id, email, name, created_at, updated_at, tenant_id FROM user_profiles
+WHERE tenant_id = $1 AND email = $2
+ORDER BY created_at
+`
+
+type ListUserProfilesByEmailParams struct {
+	TenantID int64  `json:"tenantId"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) ListUserProfilesByEmail(ctx context.Context, arg ListUserProfilesByEmailParams) ([]UserProfile, error) {
+	rows, err := q.db.Query(ctx, listUserProfilesByEmail, arg.TenantID, arg.Email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserProfile{}
+	for rows.Next() {
+		var i UserProfile
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const redactAuditActor = `-- name: RedactAuditActor :execrows
+UPDATE audit_log
+SET actor = 'erased'
+WHERE tenant_id = $1 AND actor = $2
+`
+
+type RedactAuditActorParams struct {
+	TenantID int64  `json:"tenantId"`
+	Actor    string `json:"actor"`
+}
+
+func (q *Queries) RedactAuditActor(ctx context.Context, arg RedactAuditActorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, redactAuditActor, arg.TenantID, arg.Actor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const redactAuditSnapshotsByUser = `-- name: RedactAuditSnapshotsByUser :execrows
+UPDATE audit_log
+SET
+    before = NULL,
+    after = NULL
+WHERE audit_log.tenant_id = $1
+AND (
+    (audit_log.entity_type = 'review' AND audit_log.entity_id IN (
+        SELECT reviews.id FROM reviews
+        WHERE reviews.tenant_id = $1 AND reviews.user_id = $2
+    ))
+    OR (audit_log.entity_type = 'review_reply' AND audit_log.entity_id IN (
+        SELECT review_replies.id FROM review_replies
+        WHERE review_replies.tenant_id = $1 AND review_replies.author_id = $2
+    ))
+    OR (audit_log.entity_type = 'product_question' AND audit_log.entity_id IN (
+        SELECT product_questions.id FROM product_questions
+        WHERE product_questions.tenant_id = $1 AND product_questions.user_id = $2
+    ))
+    OR (audit_log.entity_type = 'product_answer' AND audit_log.entity_id IN (
+        SELECT product_answers.id FROM product_answers
+        WHERE product_answers.tenant_id = $1 AND product_answers.user_id = $2
+    ))
+)
+`
+
+type RedactAuditSnapshotsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Removes the snapshots of the user's reviews, replies, questions and answers
+// from the audit trail; what was done to them stays on record. Runs before the
+// user's content is deleted or anonymized.
+func (q *Queries) RedactAuditSnapshotsByUser(ctx context.Context, arg RedactAuditSnapshotsByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, redactAuditSnapshotsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const redactOutboxEventsByUser = `-- name: RedactOutboxEventsByUser :many
+UPDATE outbox
+SET payload = jsonb_build_object('id', outbox.payload->'id', 'product_id', outbox.payload->'product_id')
+WHERE outbox.tenant_id = $1
+AND outbox.aggregate_type = 'review'
+AND outbox.aggregate_id IN (
+    SELECT reviews.id FROM reviews
+    WHERE reviews.tenant_id = $1 AND reviews.user_id = $2
+)
+RETURNING outbox.id
+`
+
+type RedactOutboxEventsByUserParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+// Cuts the events about the user's reviews down to the review and product
+// IDs. Runs before the user's reviews are deleted or anonymized.
+func (q *Queries) RedactOutboxEventsByUser(ctx context.Context, arg RedactOutboxEventsByUserParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, redactOutboxEventsByUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const redactWebhookDeliveriesByEvents = `-- name: RedactWebhookDeliveriesByEvents :execrows
+UPDATE webhook_deliveries
+SET payload = jsonb_set(payload, '{data}', jsonb_build_object(
+    'id', payload->'data'->'id',
+    'product_id', payload->'data'->'product_id'
+))
+WHERE tenant_id = $1
+AND event_id = ANY($2::bigint[])
+`
+
+type RedactWebhookDeliveriesByEventsParams struct {
+	TenantID int64   `json:"tenantId"`
+	EventIds []int64 `json:"eventIds"`
+}
+
+// Cuts the data of deliveries of the events down like the events themselves.
+func (q *Queries) RedactWebhookDeliveriesByEvents(ctx context.Context, arg RedactWebhookDeliveriesByEventsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, redactWebhookDeliveriesByEvents, arg.TenantID, arg.EventIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeTokens = `-- name: RevokeTokens :exec
+INSERT INTO token_revocations (
+    user_id,
+    revoked_before,
+    tenant_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (tenant_id, user_id) DO UPDATE
+SET revoked_before = GREATEST(token_revocations.revoked_before, EXCLUDED.revoked_before)
+`
+
+type RevokeTokensParams struct {
+	UserID        int64              `json:"userId"`
+	RevokedBefore pgtype.Timestamptz `json:"revokedBefore"`
+	TenantID      int64              `json:"tenantId"`
+}
+
+func (q *Queries) RevokeTokens(ctx context.Context, arg RevokeTokensParams) error {
+	_, err := q.db.Exec(ctx, revokeTokens, arg.UserID, arg.RevokedBefore, arg.TenantID)
+	return err
+}
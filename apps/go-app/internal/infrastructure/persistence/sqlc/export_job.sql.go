@@ -0,0 +1,191 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: export_job.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimExportJob = `-- name: ClaimExportJob :one
+UPDATE export_jobs
+SET
+    status = 'running',
+    started_at = NOW()
+WHERE export_jobs.id = (
+    SELECT queued.id FROM export_jobs queued
+    WHERE queued.tenant_id = $1
+    AND (
+        queued.status = 'queued'
+        OR (queued.status = 'running' AND queued.started_at < NOW() - make_interval(secs => $2::int))
+    )
+    ORDER BY queued.id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, tenant_id, requested_by, format, gzip, filters, status, blob_key, row_count, size_bytes, error, created_at, started_at, finished_at
+`
+
+type ClaimExportJobParams struct {
+	TenantID     int64 `json:"tenantId"`
+	LeaseSeconds int32 `json:"leaseSeconds"`
+}
+
+// Takes the oldest queued job, or a running one whose worker has gone silent
+// for longer than the lease.
+func (q *Queries) ClaimExportJob(ctx context.Context, arg ClaimExportJobParams) (ExportJob, error) {
+	row := q.db.QueryRow(ctx, claimExportJob, arg.TenantID, arg.LeaseSeconds)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.RequestedBy,
+		&i.Format,
+		&i.Gzip,
+		&i.Filters,
+		&i.Status,
+		&i.BlobKey,
+		&i.RowCount,
+		&i.SizeBytes,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const completeExportJob = `-- name: CompleteExportJob :exec
+UPDATE export_jobs
+SET
+    status = 'succeeded',
+    blob_key = $1,
+    row_count = $2,
+    size_bytes = $3,
+    error = NULL,
+    finished_at = NOW()
+WHERE id = $4 AND tenant_id = $5
+`
+
+type CompleteExportJobParams struct {
+	BlobKey   pgtype.Text `json:"blobKey"`
+	RowCount  pgtype.Int8 `json:"rowCount"`
+	SizeBytes pgtype.Int8 `json:"sizeBytes"`
+	ID        int64       `json:"id"`
+	TenantID  int64       `json:"tenantId"`
+}
+
+func (q *Queries) CompleteExportJob(ctx context.Context, arg CompleteExportJobParams) error {
+	_, err := q.db.Exec(ctx, completeExportJob,
+		arg.BlobKey,
+		arg.RowCount,
+		arg.SizeBytes,
+		arg.ID,
+		arg.TenantID,
+	)
+	return err
+}
+
+const createExportJob = `-- name: CreateExportJob :one
+INSERT INTO export_jobs (
+    requested_by,
+    format,
+    gzip,
+    filters,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, tenant_id, requested_by, format, gzip, filters, status, blob_key, row_count, size_bytes, error, created_at, started_at, finished_at
+`
+
+type CreateExportJobParams struct {
+	RequestedBy int64  `json:"requestedBy"`
+	Format      string `json:"format"`
+	Gzip        bool   `json:"gzip"`
+	Filters     []byte `json:"filters"`
+	TenantID    int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateExportJob(ctx context.Context, arg CreateExportJobParams) (ExportJob, error) {
+	row := q.db.QueryRow(ctx, createExportJob,
+		arg.RequestedBy,
+		arg.Format,
+		arg.Gzip,
+		arg.Filters,
+		arg.TenantID,
+	)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.RequestedBy,
+		&i.Format,
+		&i.Gzip,
+		&i.Filters,
+		&i.Status,
+		&i.BlobKey,
+		&i.RowCount,
+		&i.SizeBytes,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const failExportJob = `-- name: FailExportJob :exec
+UPDATE export_jobs
+SET
+    status = 'failed',
+    error = $1,
+    finished_at = NOW()
+WHERE id = $2 AND tenant_id = $3
+`
+
+type FailExportJobParams struct {
+	Error    pgtype.Text `json:"error"`
+	ID       int64       `json:"id"`
+	TenantID int64       `json:"tenantId"`
+}
+
+func (q *Queries) FailExportJob(ctx context.Context, arg FailExportJobParams) error {
+	_, err := q.db.Exec(ctx, failExportJob, arg.Error, arg.ID, arg.TenantID)
+	return err
+}
+
+const getExportJob = `-- name: GetExportJob :one
+SELECT id, tenant_id, requested_by, format, gzip, filters, status, blob_key, row_count, size_bytes, error, created_at, started_at, finished_at FROM export_jobs
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetExportJobParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetExportJob(ctx context.Context, arg GetExportJobParams) (ExportJob, error) {
+	row := q.db.QueryRow(ctx, getExportJob, arg.ID, arg.TenantID)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.RequestedBy,
+		&i.Format,
+		&i.Gzip,
+		&i.Filters,
+		&i.Status,
+		&i.BlobKey,
+		&i.RowCount,
+		&i.SizeBytes,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
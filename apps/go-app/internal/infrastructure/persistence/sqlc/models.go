@@ -8,6 +8,18 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID         int64              `json:"id"`
+	EntityType string             `json:"entityType"`
+	EntityID   int64              `json:"entityId"`
+	Action     string             `json:"action"`
+	Actor      string             `json:"actor"`
+	Before     []byte             `json:"before"`
+	After      []byte             `json:"after"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+	TenantID   int64              `json:"tenantId"`
+}
+
 type Auth struct {
 	ID           pgtype.UUID        `json:"id"`
 	Email        pgtype.Text        `json:"email"`
@@ -16,6 +28,60 @@ type Auth struct {
 	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
 	DeletedAt    pgtype.Timestamptz `json:"deletedAt"`
+	TenantID     int64              `json:"tenantId"`
+}
+
+type Category struct {
+	ID              int64              `json:"id"`
+	TenantID        int64              `json:"tenantId"`
+	Slug            string             `json:"slug"`
+	Name            string             `json:"name"`
+	CreatedAt       pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamptz `json:"updatedAt"`
+	RatingScaleMin  pgtype.Float8      `json:"ratingScaleMin"`
+	RatingScaleMax  pgtype.Float8      `json:"ratingScaleMax"`
+	RatingScaleStep pgtype.Float8      `json:"ratingScaleStep"`
+}
+
+type CategoryAspect struct {
+	ID         int64              `json:"id"`
+	TenantID   int64              `json:"tenantId"`
+	CategoryID int64              `json:"categoryId"`
+	Key        string             `json:"key"`
+	Name       string             `json:"name"`
+	Required   bool               `json:"required"`
+	Position   int32              `json:"position"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+type DataSubjectRequest struct {
+	ID            int64              `json:"id"`
+	TenantID      int64              `json:"tenantId"`
+	Kind          string             `json:"kind"`
+	UserID        int64              `json:"userId"`
+	AccountID     pgtype.UUID        `json:"accountId"`
+	RequestedBy   string             `json:"requestedBy"`
+	ReviewPolicy  pgtype.Text        `json:"reviewPolicy"`
+	Summary       []byte             `json:"summary"`
+	ArchiveSha256 pgtype.Text        `json:"archiveSha256"`
+	FulfilledAt   pgtype.Timestamptz `json:"fulfilledAt"`
+}
+
+type ExportJob struct {
+	ID          int64              `json:"id"`
+	TenantID    int64              `json:"tenantId"`
+	RequestedBy int64              `json:"requestedBy"`
+	Format      string             `json:"format"`
+	Gzip        bool               `json:"gzip"`
+	Filters     []byte             `json:"filters"`
+	Status      string             `json:"status"`
+	BlobKey     pgtype.Text        `json:"blobKey"`
+	RowCount    pgtype.Int8        `json:"rowCount"`
+	SizeBytes   pgtype.Int8        `json:"sizeBytes"`
+	Error       pgtype.Text        `json:"error"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	StartedAt   pgtype.Timestamptz `json:"startedAt"`
+	FinishedAt  pgtype.Timestamptz `json:"finishedAt"`
 }
 
 type OauthProvider struct {
@@ -31,18 +97,285 @@ type OauthProvider struct {
 	Scopes         []string           `json:"scopes"`
 	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+	TenantID       int64              `json:"tenantId"`
 }
 
-type Review struct {
-	ID        int64              `json:"id"`
+type Order struct {
+	ID          int64              `json:"id"`
+	ExternalID  string             `json:"externalId"`
+	CustomerID  int64              `json:"customerId"`
+	ProductID   int64              `json:"productId"`
+	PurchasedAt pgtype.Timestamptz `json:"purchasedAt"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+	TenantID    int64              `json:"tenantId"`
+}
+
+type Outbox struct {
+	ID             int64              `json:"id"`
+	TenantID       int64              `json:"tenantId"`
+	AggregateType  string             `json:"aggregateType"`
+	AggregateID    int64              `json:"aggregateId"`
+	EventType      string             `json:"eventType"`
+	Payload        []byte             `json:"payload"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"nextAttemptAt"`
+	LastError      pgtype.Text        `json:"lastError"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	PublishedAt    pgtype.Timestamptz `json:"publishedAt"`
+	DeadLetteredAt pgtype.Timestamptz `json:"deadLetteredAt"`
+	Seq            pgtype.Int8        `json:"seq"`
+}
+
+type Product struct {
+	ID             int64              `json:"id"`
+	Sku            string             `json:"sku"`
+	ExternalID     pgtype.Text        `json:"externalId"`
+	Name           string             `json:"name"`
+	GroupID        pgtype.Int8        `json:"groupId"`
+	ArchivedAt     pgtype.Timestamptz `json:"archivedAt"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+	TenantID       int64              `json:"tenantId"`
+	HoldNewReviews bool               `json:"holdNewReviews"`
+	CategoryID     pgtype.Int8        `json:"categoryId"`
+}
+
+type ProductAnswer struct {
+	ID             int64              `json:"id"`
+	TenantID       int64              `json:"tenantId"`
+	QuestionID     int64              `json:"questionId"`
+	UserID         int64              `json:"userId"`
+	Body           string             `json:"body"`
+	Brand          bool               `json:"brand"`
+	Status         string             `json:"status"`
+	HelpfulCount   int32              `json:"helpfulCount"`
+	UnhelpfulCount int32              `json:"unhelpfulCount"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt      pgtype.Timestamptz `json:"deletedAt"`
+	HelpfulScore   float64            `json:"helpfulScore"`
+}
+
+type ProductAnswerVote struct {
+	TenantID  int64              `json:"tenantId"`
+	AnswerID  int64              `json:"answerId"`
 	UserID    int64              `json:"userId"`
+	Helpful   bool               `json:"helpful"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ProductOwner struct {
+	ProductID int64              `json:"productId"`
+	UserID    int64              `json:"userId"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	TenantID  int64              `json:"tenantId"`
+}
+
+type ProductQuestion struct {
+	ID        int64              `json:"id"`
+	TenantID  int64              `json:"tenantId"`
 	ProductID int64              `json:"productId"`
-	Rating    int32              `json:"rating"`
-	Comment   pgtype.Text        `json:"comment"`
+	UserID    int64              `json:"userId"`
+	Body      string             `json:"body"`
+	Status    string             `json:"status"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
 	DeletedAt pgtype.Timestamptz `json:"deletedAt"`
-	CreatedBy pgtype.Text        `json:"createdBy"`
+}
+
+type RatingAlert struct {
+	ID             int64              `json:"id"`
+	TenantID       int64              `json:"tenantId"`
+	ProductID      int64              `json:"productId"`
+	Kind           string             `json:"kind"`
+	Status         string             `json:"status"`
+	WindowStart    pgtype.Timestamptz `json:"windowStart"`
+	WindowEnd      pgtype.Timestamptz `json:"windowEnd"`
+	ReviewCount    int32              `json:"reviewCount"`
+	MeanRating     float64            `json:"meanRating"`
+	ExpectedCount  float64            `json:"expectedCount"`
+	BaselineMean   float64            `json:"baselineMean"`
+	BaselineStddev float64            `json:"baselineStddev"`
+	Score          float64            `json:"score"`
+	ProductHeld    bool               `json:"productHeld"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Review struct {
+	ID                int64              `json:"id"`
+	UserID            int64              `json:"userId"`
+	ProductID         int64              `json:"productId"`
+	Rating            float64            `json:"rating"`
+	Comment           pgtype.Text        `json:"comment"`
+	CreatedAt         pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt         pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt         pgtype.Timestamptz `json:"deletedAt"`
+	CreatedBy         pgtype.Text        `json:"createdBy"`
+	HelpfulCount      int32              `json:"helpfulCount"`
+	UnhelpfulCount    int32              `json:"unhelpfulCount"`
+	HelpfulScore      float64            `json:"helpfulScore"`
+	VerifiedPurchase  bool               `json:"verifiedPurchase"`
+	OrderID           pgtype.Int8        `json:"orderId"`
+	TenantID          int64              `json:"tenantId"`
+	Status            string             `json:"status"`
+	CommentSimhash    pgtype.Int8        `json:"commentSimhash"`
+	SentimentScore    pgtype.Float8      `json:"sentimentScore"`
+	SentimentLabel    pgtype.Text        `json:"sentimentLabel"`
+	SentimentMismatch bool               `json:"sentimentMismatch"`
+	DetectedLanguage  pgtype.Text        `json:"detectedLanguage"`
+	Locale            pgtype.Text        `json:"locale"`
+	Language          pgtype.Text        `json:"language"`
+	RatingValue       float64            `json:"ratingValue"`
+	RatingScaleMin    float64            `json:"ratingScaleMin"`
+	RatingScaleMax    float64            `json:"ratingScaleMax"`
+	RatingScaleStep   float64            `json:"ratingScaleStep"`
+	PublishAt         pgtype.Timestamptz `json:"publishAt"`
+	PublishedAt       pgtype.Timestamptz `json:"publishedAt"`
+}
+
+type ReviewAspectRating struct {
+	TenantID    int64   `json:"tenantId"`
+	ReviewID    int64   `json:"reviewId"`
+	AspectID    int64   `json:"aspectId"`
+	Rating      float64 `json:"rating"`
+	RatingValue float64 `json:"ratingValue"`
+}
+
+type ReviewAttachment struct {
+	ID           int64              `json:"id"`
+	ReviewID     int64              `json:"reviewId"`
+	BlobKey      string             `json:"blobKey"`
+	ThumbnailKey string             `json:"thumbnailKey"`
+	ContentType  string             `json:"contentType"`
+	SizeBytes    int64              `json:"sizeBytes"`
+	Width        int32              `json:"width"`
+	Height       int32              `json:"height"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	DeletedAt    pgtype.Timestamptz `json:"deletedAt"`
+	TenantID     int64              `json:"tenantId"`
+}
+
+type ReviewChange struct {
+	ID        int64              `json:"id"`
+	TenantID  int64              `json:"tenantId"`
+	ReviewID  int64              `json:"reviewId"`
+	Operation string             `json:"operation"`
+	Seq       pgtype.Int8        `json:"seq"`
+	ChangedAt pgtype.Timestamptz `json:"changedAt"`
+}
+
+type ReviewDuplicate struct {
+	TenantID   int64              `json:"tenantId"`
+	ReviewID   int64              `json:"reviewId"`
+	OriginalID int64              `json:"originalId"`
+	Distance   int16              `json:"distance"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+type ReviewInvitation struct {
+	ID          int64              `json:"id"`
+	TenantID    int64              `json:"tenantId"`
+	OrderID     int64              `json:"orderId"`
+	CustomerID  int64              `json:"customerId"`
+	ProductID   int64              `json:"productId"`
+	ExpiresAt   pgtype.Timestamptz `json:"expiresAt"`
+	OpenedAt    pgtype.Timestamptz `json:"openedAt"`
+	CompletedAt pgtype.Timestamptz `json:"completedAt"`
+	ReviewID    pgtype.Int8        `json:"reviewId"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+}
+
+type ReviewPolicy struct {
+	ID               int64              `json:"id"`
+	TenantID         int64              `json:"tenantId"`
+	ProductID        pgtype.Int8        `json:"productId"`
+	CategoryID       pgtype.Int8        `json:"categoryId"`
+	MinCommentLength pgtype.Int4        `json:"minCommentLength"`
+	CommentRequired  pgtype.Bool        `json:"commentRequired"`
+	VerifiedOnly     pgtype.Bool        `json:"verifiedOnly"`
+	Moderation       pgtype.Text        `json:"moderation"`
+	Closed           pgtype.Bool        `json:"closed"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type ReviewReply struct {
+	ID        int64              `json:"id"`
+	ReviewID  int64              `json:"reviewId"`
+	AuthorID  int64              `json:"authorId"`
+	Body      string             `json:"body"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt pgtype.Timestamptz `json:"deletedAt"`
+	TenantID  int64              `json:"tenantId"`
+}
+
+type ReviewReport struct {
+	ID         int64              `json:"id"`
+	TenantID   int64              `json:"tenantId"`
+	ReviewID   int64              `json:"reviewId"`
+	ReporterID int64              `json:"reporterId"`
+	Reason     string             `json:"reason"`
+	Details    pgtype.Text        `json:"details"`
+	Status     string             `json:"status"`
+	ResolvedBy pgtype.Int8        `json:"resolvedBy"`
+	ResolvedAt pgtype.Timestamptz `json:"resolvedAt"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+type ReviewVelocityCounter struct {
+	TenantID    int64              `json:"tenantId"`
+	Scope       string             `json:"scope"`
+	Subject     string             `json:"subject"`
+	WindowStart pgtype.Timestamptz `json:"windowStart"`
+	Count       int32              `json:"count"`
+}
+
+type ReviewVote struct {
+	ReviewID  int64              `json:"reviewId"`
+	UserID    int64              `json:"userId"`
+	Helpful   bool               `json:"helpful"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+	TenantID  int64              `json:"tenantId"`
+}
+
+type ReviewerStat struct {
+	TenantID       int64              `json:"tenantId"`
+	UserID         int64              `json:"userId"`
+	ReviewCount    int32              `json:"reviewCount"`
+	ApprovedCount  int32              `json:"approvedCount"`
+	RejectedCount  int32              `json:"rejectedCount"`
+	VerifiedCount  int32              `json:"verifiedCount"`
+	HelpfulVotes   int32              `json:"helpfulVotes"`
+	UnhelpfulVotes int32              `json:"unhelpfulVotes"`
+	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+	Reputation     float64            `json:"reputation"`
+}
+
+type Tenant struct {
+	ID                    int64              `json:"id"`
+	Slug                  string             `json:"slug"`
+	Name                  string             `json:"name"`
+	CreatedAt             pgtype.Timestamptz `json:"createdAt"`
+	RatingScaleMin        pgtype.Float8      `json:"ratingScaleMin"`
+	RatingScaleMax        pgtype.Float8      `json:"ratingScaleMax"`
+	RatingScaleStep       pgtype.Float8      `json:"ratingScaleStep"`
+	ReviewCoolingOffHours int32              `json:"reviewCoolingOffHours"`
+}
+
+type TenantDomain struct {
+	Hostname string `json:"hostname"`
+	TenantID int64  `json:"tenantId"`
+}
+
+type TokenRevocation struct {
+	TenantID      int64              `json:"tenantId"`
+	UserID        int64              `json:"userId"`
+	RevokedBefore pgtype.Timestamptz `json:"revokedBefore"`
 }
 
 type UserProfile struct {
@@ -51,4 +384,35 @@ type UserProfile struct {
 	Name      pgtype.Text        `json:"name"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+	TenantID  int64              `json:"tenantId"`
+}
+
+type WebhookDelivery struct {
+	ID             int64              `json:"id"`
+	TenantID       int64              `json:"tenantId"`
+	SubscriptionID int64              `json:"subscriptionId"`
+	EventID        int64              `json:"eventId"`
+	EventType      string             `json:"eventType"`
+	Payload        []byte             `json:"payload"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"nextAttemptAt"`
+	LastAttemptAt  pgtype.Timestamptz `json:"lastAttemptAt"`
+	ResponseStatus pgtype.Int4        `json:"responseStatus"`
+	LastError      pgtype.Text        `json:"lastError"`
+	DeliveredAt    pgtype.Timestamptz `json:"deliveredAt"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+}
+
+type WebhookSubscription struct {
+	ID                  int64              `json:"id"`
+	TenantID            int64              `json:"tenantId"`
+	Url                 string             `json:"url"`
+	Secret              string             `json:"secret"`
+	EventTypes          []string           `json:"eventTypes"`
+	Active              bool               `json:"active"`
+	ConsecutiveFailures int32              `json:"consecutiveFailures"`
+	DisabledAt          pgtype.Timestamptz `json:"disabledAt"`
+	CreatedAt           pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamptz `json:"updatedAt"`
 }
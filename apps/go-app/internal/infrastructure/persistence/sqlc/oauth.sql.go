@@ -13,9 +13,9 @@ import (
 
 const createAuthUser = `-- name: CreateAuthUser :one
 INSERT INTO auth (
-    id, email, password_hash, status
+    id, email, password_hash, status, tenant_id
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5
 ) RETURNING id, email, password_hash, status, created_at, updated_at, deleted_at
 `
 
@@ -24,16 +24,28 @@ type CreateAuthUserParams struct {
 	Email        pgtype.Text `json:"email"`
 	PasswordHash pgtype.Text `json:"passwordHash"`
 	Status       string      `json:"status"`
+	TenantID     int64       `json:"tenantId"`
+}
+
+type CreateAuthUserRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	Email        pgtype.Text        `json:"email"`
+	PasswordHash pgtype.Text        `json:"passwordHash"`
+	Status       string             `json:"status"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt    pgtype.Timestamptz `json:"deletedAt"`
 }
 
-func (q *Queries) CreateAuthUser(ctx context.Context, arg CreateAuthUserParams) (Auth, error) {
+func (q *Queries) CreateAuthUser(ctx context.Context, arg CreateAuthUserParams) (CreateAuthUserRow, error) {
 	row := q.db.QueryRow(ctx, createAuthUser,
 		arg.ID,
 		arg.Email,
 		arg.PasswordHash,
 		arg.Status,
+		arg.TenantID,
 	)
-	var i Auth
+	var i CreateAuthUserRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
@@ -49,9 +61,9 @@ func (q *Queries) CreateAuthUser(ctx context.Context, arg CreateAuthUserParams)
 const createOAuthProvider = `-- name: CreateOAuthProvider :one
 INSERT INTO oauth_providers (
     id, user_id, provider_name, provider_user_id, email, name, 
-    access_token, refresh_token, expires_at, scopes
+    access_token, refresh_token, expires_at, scopes, tenant_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, created_at, updated_at
 `
 
@@ -66,6 +78,7 @@ type CreateOAuthProviderParams struct {
 	RefreshToken   pgtype.Text        `json:"refreshToken"`
 	ExpiresAt      pgtype.Timestamptz `json:"expiresAt"`
 	Scopes         []string           `json:"scopes"`
+	TenantID       int64              `json:"tenantId"`
 }
 
 type CreateOAuthProviderRow struct {
@@ -94,6 +107,7 @@ func (q *Queries) CreateOAuthProvider(ctx context.Context, arg CreateOAuthProvid
 		arg.RefreshToken,
 		arg.ExpiresAt,
 		arg.Scopes,
+		arg.TenantID,
 	)
 	var i CreateOAuthProviderRow
 	err := row.Scan(
@@ -114,9 +128,9 @@ func (q *Queries) CreateOAuthProvider(ctx context.Context, arg CreateOAuthProvid
 
 const createUserProfile = `-- name: CreateUserProfile :one
 INSERT INTO user_profiles (
-    id, email, name, created_at, updated_at
+    id, email, name, created_at, updated_at, tenant_id
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6
 ) RETURNING id, email, name, created_at, updated_at
 `
 
@@ -126,17 +140,27 @@ type CreateUserProfileParams struct {
 	Name      pgtype.Text        `json:"name"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+	TenantID  int64              `json:"tenantId"`
 }
 
-func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfileParams) (UserProfile, error) {
+type CreateUserProfileRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      pgtype.Text        `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfileParams) (CreateUserProfileRow, error) {
 	row := q.db.QueryRow(ctx, createUserProfile,
 		arg.ID,
 		arg.Email,
 		arg.Name,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
-	var i UserProfile
+	var i CreateUserProfileRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
@@ -150,12 +174,27 @@ func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfilePa
 const getAuthUserByEmail = `-- name: GetAuthUserByEmail :one
 SELECT id, email, password_hash, status, created_at, updated_at, deleted_at
 FROM auth
-WHERE email = $1
+WHERE email = $1 AND tenant_id = $2
 `
 
-func (q *Queries) GetAuthUserByEmail(ctx context.Context, email pgtype.Text) (Auth, error) {
-	row := q.db.QueryRow(ctx, getAuthUserByEmail, email)
-	var i Auth
+type GetAuthUserByEmailParams struct {
+	Email    pgtype.Text `json:"email"`
+	TenantID int64       `json:"tenantId"`
+}
+
+type GetAuthUserByEmailRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	Email        pgtype.Text        `json:"email"`
+	PasswordHash pgtype.Text        `json:"passwordHash"`
+	Status       string             `json:"status"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt    pgtype.Timestamptz `json:"deletedAt"`
+}
+
+func (q *Queries) GetAuthUserByEmail(ctx context.Context, arg GetAuthUserByEmailParams) (GetAuthUserByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getAuthUserByEmail, arg.Email, arg.TenantID)
+	var i GetAuthUserByEmailRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
@@ -171,12 +210,27 @@ func (q *Queries) GetAuthUserByEmail(ctx context.Context, email pgtype.Text) (Au
 const getAuthUserByID = `-- name: GetAuthUserByID :one
 SELECT id, email, password_hash, status, created_at, updated_at, deleted_at
 FROM auth
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $2
 `
 
-func (q *Queries) GetAuthUserByID(ctx context.Context, id pgtype.UUID) (Auth, error) {
-	row := q.db.QueryRow(ctx, getAuthUserByID, id)
-	var i Auth
+type GetAuthUserByIDParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID int64       `json:"tenantId"`
+}
+
+type GetAuthUserByIDRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	Email        pgtype.Text        `json:"email"`
+	PasswordHash pgtype.Text        `json:"passwordHash"`
+	Status       string             `json:"status"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt    pgtype.Timestamptz `json:"deletedAt"`
+}
+
+func (q *Queries) GetAuthUserByID(ctx context.Context, arg GetAuthUserByIDParams) (GetAuthUserByIDRow, error) {
+	row := q.db.QueryRow(ctx, getAuthUserByID, arg.ID, arg.TenantID)
+	var i GetAuthUserByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
@@ -192,12 +246,13 @@ func (q *Queries) GetAuthUserByID(ctx context.Context, id pgtype.UUID) (Auth, er
 const getOAuthProviderByProviderID = `-- name: GetOAuthProviderByProviderID :one
 SELECT id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, created_at, updated_at
 FROM oauth_providers
-WHERE provider_name = $1 AND provider_user_id = $2
+WHERE provider_name = $1 AND provider_user_id = $2 AND tenant_id = $3
 `
 
 type GetOAuthProviderByProviderIDParams struct {
 	ProviderName   string `json:"providerName"`
 	ProviderUserID string `json:"providerUserId"`
+	TenantID       int64  `json:"tenantId"`
 }
 
 type GetOAuthProviderByProviderIDRow struct {
@@ -215,7 +270,7 @@ type GetOAuthProviderByProviderIDRow struct {
 }
 
 func (q *Queries) GetOAuthProviderByProviderID(ctx context.Context, arg GetOAuthProviderByProviderIDParams) (GetOAuthProviderByProviderIDRow, error) {
-	row := q.db.QueryRow(ctx, getOAuthProviderByProviderID, arg.ProviderName, arg.ProviderUserID)
+	row := q.db.QueryRow(ctx, getOAuthProviderByProviderID, arg.ProviderName, arg.ProviderUserID, arg.TenantID)
 	var i GetOAuthProviderByProviderIDRow
 	err := row.Scan(
 		&i.ID,
@@ -236,12 +291,25 @@ func (q *Queries) GetOAuthProviderByProviderID(ctx context.Context, arg GetOAuth
 const getUserProfileByEmail = `-- name: GetUserProfileByEmail :one
 SELECT id, email, name, created_at, updated_at
 FROM user_profiles
-WHERE email = $1
+WHERE email = $1 AND tenant_id = $2
 `
 
-func (q *Queries) GetUserProfileByEmail(ctx context.Context, email string) (UserProfile, error) {
-	row := q.db.QueryRow(ctx, getUserProfileByEmail, email)
-	var i UserProfile
+type GetUserProfileByEmailParams struct {
+	Email    string `json:"email"`
+	TenantID int64  `json:"tenantId"`
+}
+
+type GetUserProfileByEmailRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      pgtype.Text        `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+func (q *Queries) GetUserProfileByEmail(ctx context.Context, arg GetUserProfileByEmailParams) (GetUserProfileByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getUserProfileByEmail, arg.Email, arg.TenantID)
+	var i GetUserProfileByEmailRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
@@ -258,7 +326,7 @@ SET
     email = $2,
     status = $3,
     updated_at = $4
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $5
 RETURNING id, email, password_hash, status, created_at, updated_at, deleted_at
 `
 
@@ -267,16 +335,28 @@ type UpdateAuthUserParams struct {
 	Email     pgtype.Text        `json:"email"`
 	Status    string             `json:"status"`
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+	TenantID  int64              `json:"tenantId"`
 }
 
-func (q *Queries) UpdateAuthUser(ctx context.Context, arg UpdateAuthUserParams) (Auth, error) {
+type UpdateAuthUserRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	Email        pgtype.Text        `json:"email"`
+	PasswordHash pgtype.Text        `json:"passwordHash"`
+	Status       string             `json:"status"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+	DeletedAt    pgtype.Timestamptz `json:"deletedAt"`
+}
+
+func (q *Queries) UpdateAuthUser(ctx context.Context, arg UpdateAuthUserParams) (UpdateAuthUserRow, error) {
 	row := q.db.QueryRow(ctx, updateAuthUser,
 		arg.ID,
 		arg.Email,
 		arg.Status,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
-	var i Auth
+	var i UpdateAuthUserRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
@@ -296,7 +376,7 @@ SET
     refresh_token = $3,
     expires_at = $4,
     updated_at = now()
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $5
 RETURNING id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, created_at, updated_at
 `
 
@@ -305,6 +385,7 @@ type UpdateOAuthProviderParams struct {
 	AccessToken  pgtype.Text        `json:"accessToken"`
 	RefreshToken pgtype.Text        `json:"refreshToken"`
 	ExpiresAt    pgtype.Timestamptz `json:"expiresAt"`
+	TenantID     int64              `json:"tenantId"`
 }
 
 type UpdateOAuthProviderRow struct {
@@ -327,6 +408,7 @@ func (q *Queries) UpdateOAuthProvider(ctx context.Context, arg UpdateOAuthProvid
 		arg.AccessToken,
 		arg.RefreshToken,
 		arg.ExpiresAt,
+		arg.TenantID,
 	)
 	var i UpdateOAuthProviderRow
 	err := row.Scan(
@@ -351,7 +433,7 @@ SET
     email = $2,
     name = $3,
     updated_at = $4
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $5
 RETURNING id, email, name, created_at, updated_at
 `
 
@@ -360,16 +442,26 @@ type UpdateUserProfileParams struct {
 	Email     string             `json:"email"`
 	Name      pgtype.Text        `json:"name"`
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+	TenantID  int64              `json:"tenantId"`
+}
+
+type UpdateUserProfileRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      pgtype.Text        `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
 }
 
-func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (UserProfile, error) {
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (UpdateUserProfileRow, error) {
 	row := q.db.QueryRow(ctx, updateUserProfile,
 		arg.ID,
 		arg.Email,
 		arg.Name,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
-	var i UserProfile
+	var i UpdateUserProfileRow
 	err := row.Scan(
 		&i.ID,
 		&i.Email,
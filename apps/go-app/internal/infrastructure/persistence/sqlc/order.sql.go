@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: order.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getLatestOrderForCustomerProduct = `-- name: GetLatestOrderForCustomerProduct :one
+SELECT id, external_id, customer_id, product_id, purchased_at, created_at, updated_at, tenant_id FROM orders
+WHERE customer_id = $1
+AND product_id = $2
+AND tenant_id = $3
+AND purchased_at <= NOW()
+ORDER BY purchased_at DESC
+LIMIT 1
+`
+
+type GetLatestOrderForCustomerProductParams struct {
+	CustomerID int64 `json:"customerId"`
+	ProductID  int64 `json:"productId"`
+	TenantID   int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetLatestOrderForCustomerProduct(ctx context.Context, arg GetLatestOrderForCustomerProductParams) (Order, error) {
+	row := q.db.QueryRow(ctx, getLatestOrderForCustomerProduct, arg.CustomerID, arg.ProductID, arg.TenantID)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.PurchasedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const getOrderByExternalID = `-- name: GetOrderByExternalID :one
+SELECT id, external_id, customer_id, product_id, purchased_at, created_at, updated_at, tenant_id FROM orders
+WHERE external_id = $1
+AND product_id = $2
+AND tenant_id = $3
+`
+
+type GetOrderByExternalIDParams struct {
+	ExternalID string `json:"externalId"`
+	ProductID  int64  `json:"productId"`
+	TenantID   int64  `json:"tenantId"`
+}
+
+func (q *Queries) GetOrderByExternalID(ctx context.Context, arg GetOrderByExternalIDParams) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderByExternalID, arg.ExternalID, arg.ProductID, arg.TenantID)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.PurchasedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const markReviewsVerifiedByOrder = `-- name: MarkReviewsVerifiedByOrder :execrows
+UPDATE reviews
+SET
+    verified_purchase = TRUE,
+    order_id = $1
+WHERE tenant_id = $2
+AND user_id = $3
+AND product_id = $4
+AND created_at >= $5
+AND verified_purchase = FALSE
+AND deleted_at IS NULL
+`
+
+type MarkReviewsVerifiedByOrderParams struct {
+	OrderID     pgtype.Int8        `json:"orderId"`
+	TenantID    int64              `json:"tenantId"`
+	CustomerID  int64              `json:"customerId"`
+	ProductID   int64              `json:"productId"`
+	PurchasedAt pgtype.Timestamptz `json:"purchasedAt"`
+}
+
+// Verifies reviews the customer wrote for the product after buying it.
+func (q *Queries) MarkReviewsVerifiedByOrder(ctx context.Context, arg MarkReviewsVerifiedByOrderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markReviewsVerifiedByOrder,
+		arg.OrderID,
+		arg.TenantID,
+		arg.CustomerID,
+		arg.ProductID,
+		arg.PurchasedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertOrder = `-- name: UpsertOrder :one
+INSERT INTO orders (
+    external_id,
+    customer_id,
+    product_id,
+    purchased_at,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (tenant_id, external_id, product_id) DO UPDATE
+SET
+    customer_id = EXCLUDED.customer_id,
+    purchased_at = EXCLUDED.purchased_at,
+    updated_at = NOW()
+RETURNING id, external_id, customer_id, product_id, purchased_at, created_at, updated_at, tenant_id
+`
+
+type UpsertOrderParams struct {
+	ExternalID  string             `json:"externalId"`
+	CustomerID  int64              `json:"customerId"`
+	ProductID   int64              `json:"productId"`
+	PurchasedAt pgtype.Timestamptz `json:"purchasedAt"`
+	TenantID    int64              `json:"tenantId"`
+}
+
+func (q *Queries) UpsertOrder(ctx context.Context, arg UpsertOrderParams) (Order, error) {
+	row := q.db.QueryRow(ctx, upsertOrder,
+		arg.ExternalID,
+		arg.CustomerID,
+		arg.ProductID,
+		arg.PurchasedAt,
+		arg.TenantID,
+	)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.PurchasedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
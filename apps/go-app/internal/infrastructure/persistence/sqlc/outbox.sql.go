@@ -0,0 +1,324 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: outbox.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+SELECT id, tenant_id, aggregate_type, aggregate_id, event_type, payload, attempts, next_attempt_at, last_error, created_at, published_at, dead_lettered_at, seq FROM outbox pending
+WHERE pending.tenant_id = $1
+AND pending.published_at IS NULL
+AND pending.dead_lettered_at IS NULL
+AND pending.next_attempt_at <= NOW()
+AND NOT EXISTS (
+    SELECT 1 FROM outbox earlier
+    WHERE earlier.tenant_id = pending.tenant_id
+    AND earlier.aggregate_type = pending.aggregate_type
+    AND earlier.aggregate_id = pending.aggregate_id
+    AND earlier.id < pending.id
+    AND earlier.published_at IS NULL
+    AND earlier.dead_lettered_at IS NULL
+)
+ORDER BY pending.id
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type ClaimOutboxEventsParams struct {
+	TenantID int64 `json:"tenantId"`
+	Limit    int32 `json:"limit"`
+}
+
+// Locks the oldest due event of each aggregate. Later events of an aggregate
+// wait until every earlier one was published or dead-lettered, which keeps
+// delivery in order per aggregate; SKIP LOCKED lets relays run concurrently.
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]Outbox, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents, arg.TenantID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Outbox{}
+	for rows.Next() {
+		var i Outbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.DeadLetteredAt,
+			&i.Seq,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, tenant_id, aggregate_type, aggregate_id, event_type, payload, attempts, next_attempt_at, last_error, created_at, published_at, dead_lettered_at, seq
+`
+
+type CreateOutboxEventParams struct {
+	AggregateType string `json:"aggregateType"`
+	AggregateID   int64  `json:"aggregateId"`
+	EventType     string `json:"eventType"`
+	Payload       []byte `json:"payload"`
+	TenantID      int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error) {
+	row := q.db.QueryRow(ctx, createOutboxEvent,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.EventType,
+		arg.Payload,
+		arg.TenantID,
+	)
+	var i Outbox
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.DeadLetteredAt,
+		&i.Seq,
+	)
+	return i, err
+}
+
+const deadLetterOutboxEvent = `-- name: DeadLetterOutboxEvent :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    last_error = $1,
+    dead_lettered_at = NOW()
+WHERE id = $2 AND tenant_id = $3
+`
+
+type DeadLetterOutboxEventParams struct {
+	LastError pgtype.Text `json:"lastError"`
+	ID        int64       `json:"id"`
+	TenantID  int64       `json:"tenantId"`
+}
+
+func (q *Queries) DeadLetterOutboxEvent(ctx context.Context, arg DeadLetterOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, deadLetterOutboxEvent, arg.LastError, arg.ID, arg.TenantID)
+	return err
+}
+
+const getLatestOutboxSeq = `-- name: GetLatestOutboxSeq :one
+SELECT COALESCE(MAX(seq), 0)::bigint FROM outbox
+WHERE tenant_id = $1 AND aggregate_type = $2
+`
+
+type GetLatestOutboxSeqParams struct {
+	TenantID      int64  `json:"tenantId"`
+	AggregateType string `json:"aggregateType"`
+}
+
+func (q *Queries) GetLatestOutboxSeq(ctx context.Context, arg GetLatestOutboxSeqParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getLatestOutboxSeq, arg.TenantID, arg.AggregateType)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getOutboxEvent = `-- name: GetOutboxEvent :one
+SELECT id, tenant_id, aggregate_type, aggregate_id, event_type, payload, attempts, next_attempt_at, last_error, created_at, published_at, dead_lettered_at, seq FROM outbox
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetOutboxEventParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetOutboxEvent(ctx context.Context, arg GetOutboxEventParams) (Outbox, error) {
+	row := q.db.QueryRow(ctx, getOutboxEvent, arg.ID, arg.TenantID)
+	var i Outbox
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.DeadLetteredAt,
+		&i.Seq,
+	)
+	return i, err
+}
+
+const listOutboxEventsAfter = `-- name: ListOutboxEventsAfter :many
+SELECT id, tenant_id, aggregate_type, aggregate_id, event_type, payload, attempts, next_attempt_at, last_error, created_at, published_at, dead_lettered_at, seq FROM outbox
+WHERE tenant_id = $1
+AND aggregate_type = $2
+AND seq > $3::bigint
+ORDER BY seq
+LIMIT $4
+`
+
+type ListOutboxEventsAfterParams struct {
+	TenantID      int64  `json:"tenantId"`
+	AggregateType string `json:"aggregateType"`
+	AfterSeq      int64  `json:"afterSeq"`
+	Limit         int32  `json:"limit"`
+}
+
+// Reads the event history of an aggregate type in commit order, for consumers
+// resuming from the last event they saw.
+func (q *Queries) ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]Outbox, error) {
+	rows, err := q.db.Query(ctx, listOutboxEventsAfter,
+		arg.TenantID,
+		arg.AggregateType,
+		arg.AfterSeq,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Outbox{}
+	for rows.Next() {
+		var i Outbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.DeadLetteredAt,
+			&i.Seq,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockOutboxSequencer = `-- name: LockOutboxSequencer :exec
+SELECT pg_advisory_xact_lock(hashtext('outbox'), $1::int)
+`
+
+// Serializes sequencing per tenant until the transaction ends, so that every
+// batch is numbered after the previous one committed.
+func (q *Queries) LockOutboxSequencer(ctx context.Context, tenantID int32) error {
+	_, err := q.db.Exec(ctx, lockOutboxSequencer, tenantID)
+	return err
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    last_error = $1,
+    next_attempt_at = $2
+WHERE id = $3 AND tenant_id = $4
+`
+
+type MarkOutboxEventFailedParams struct {
+	LastError     pgtype.Text        `json:"lastError"`
+	NextAttemptAt pgtype.Timestamptz `json:"nextAttemptAt"`
+	ID            int64              `json:"id"`
+	TenantID      int64              `json:"tenantId"`
+}
+
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventFailed,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+		arg.TenantID,
+	)
+	return err
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    published_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+`
+
+type MarkOutboxEventPublishedParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventPublished, arg.ID, arg.TenantID)
+	return err
+}
+
+const sequenceOutboxEvents = `-- name: SequenceOutboxEvents :execrows
+WITH pending AS (
+    SELECT unsequenced.id FROM outbox unsequenced
+    WHERE unsequenced.tenant_id = $1 AND unsequenced.seq IS NULL
+    ORDER BY unsequenced.id
+),
+numbered AS (
+    SELECT pending.id, nextval('outbox_seq') AS seq FROM pending
+)
+UPDATE outbox
+SET seq = numbered.seq
+FROM numbered
+WHERE outbox.id = numbered.id
+`
+
+func (q *Queries) SequenceOutboxEvents(ctx context.Context, tenantID int64) (int64, error) {
+	result, err := q.db.Exec(ctx, sequenceOutboxEvents, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,341 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: product.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const archiveProductsNotInSKUs = `-- name: ArchiveProductsNotInSKUs :execrows
+UPDATE products
+SET
+    archived_at = NOW(),
+    updated_at = NOW()
+WHERE tenant_id = $1
+AND archived_at IS NULL
+AND sku <> ALL($2::text[])
+`
+
+type ArchiveProductsNotInSKUsParams struct {
+	TenantID int64    `json:"tenantId"`
+	Skus     []string `json:"skus"`
+}
+
+func (q *Queries) ArchiveProductsNotInSKUs(ctx context.Context, arg ArchiveProductsNotInSKUsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveProductsNotInSKUs, arg.TenantID, arg.Skus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createProduct = `-- name: CreateProduct :one
+INSERT INTO products (
+    sku,
+    external_id,
+    name,
+    group_id,
+    category_id,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, sku, external_id, name, group_id, archived_at, created_at, updated_at, tenant_id, hold_new_reviews, category_id
+`
+
+type CreateProductParams struct {
+	Sku        string      `json:"sku"`
+	ExternalID pgtype.Text `json:"externalId"`
+	Name       string      `json:"name"`
+	GroupID    pgtype.Int8 `json:"groupId"`
+	CategoryID pgtype.Int8 `json:"categoryId"`
+	TenantID   int64       `json:"tenantId"`
+}
+
+func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error) {
+	row := q.db.QueryRow(ctx, createProduct,
+		arg.Sku,
+		arg.ExternalID,
+		arg.Name,
+		arg.GroupID,
+		arg.CategoryID,
+		arg.TenantID,
+	)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Sku,
+		&i.ExternalID,
+		&i.Name,
+		&i.GroupID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+		&i.HoldNewReviews,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const getProduct = `-- name: GetProduct :one
+SELECT id, sku, external_id, name, group_id, archived_at, created_at, updated_at, tenant_id, hold_new_reviews, category_id FROM products
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetProductParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetProduct(ctx context.Context, arg GetProductParams) (Product, error) {
+	row := q.db.QueryRow(ctx, getProduct, arg.ID, arg.TenantID)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Sku,
+		&i.ExternalID,
+		&i.Name,
+		&i.GroupID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+		&i.HoldNewReviews,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const getProductBySKU = `-- name: GetProductBySKU :one
+SELECT id, sku, external_id, name, group_id, archived_at, created_at, updated_at, tenant_id, hold_new_reviews, category_id FROM products
+WHERE sku = $1 AND tenant_id = $2
+`
+
+type GetProductBySKUParams struct {
+	Sku      string `json:"sku"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) GetProductBySKU(ctx context.Context, arg GetProductBySKUParams) (Product, error) {
+	row := q.db.QueryRow(ctx, getProductBySKU, arg.Sku, arg.TenantID)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Sku,
+		&i.ExternalID,
+		&i.Name,
+		&i.GroupID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+		&i.HoldNewReviews,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const holdProductReviews = `-- name: HoldProductReviews :exec
+UPDATE products
+SET
+    hold_new_reviews = TRUE,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND NOT hold_new_reviews
+`
+
+type HoldProductReviewsParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) HoldProductReviews(ctx context.Context, arg HoldProductReviewsParams) error {
+	_, err := q.db.Exec(ctx, holdProductReviews, arg.ID, arg.TenantID)
+	return err
+}
+
+const listProducts = `-- name: ListProducts :many
+SELECT id, sku, external_id, name, group_id, archived_at, created_at, updated_at, tenant_id, hold_new_reviews, category_id FROM products
+WHERE tenant_id = $1
+AND ($2::boolean OR archived_at IS NULL)
+AND ($3::bigint IS NULL OR id = $3 OR group_id = $3)
+ORDER BY id
+LIMIT $5
+OFFSET $4
+`
+
+type ListProductsParams struct {
+	TenantID        int64       `json:"tenantId"`
+	IncludeArchived bool        `json:"includeArchived"`
+	GroupID         pgtype.Int8 `json:"groupId"`
+	Offset          int32       `json:"offset"`
+	Limit           int32       `json:"limit"`
+}
+
+func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]Product, error) {
+	rows, err := q.db.Query(ctx, listProducts,
+		arg.TenantID,
+		arg.IncludeArchived,
+		arg.GroupID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sku,
+			&i.ExternalID,
+			&i.Name,
+			&i.GroupID,
+			&i.ArchivedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.HoldNewReviews,
+			&i.CategoryID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const regroupProducts = `-- name: RegroupProducts :exec
+UPDATE products
+SET
+    group_id = $1,
+    updated_at = NOW()
+WHERE group_id = $2 AND tenant_id = $3
+`
+
+type RegroupProductsParams struct {
+	ToGroupID   pgtype.Int8 `json:"toGroupId"`
+	FromGroupID pgtype.Int8 `json:"fromGroupId"`
+	TenantID    int64       `json:"tenantId"`
+}
+
+// Moves every variant of one group into another.
+func (q *Queries) RegroupProducts(ctx context.Context, arg RegroupProductsParams) error {
+	_, err := q.db.Exec(ctx, regroupProducts, arg.ToGroupID, arg.FromGroupID, arg.TenantID)
+	return err
+}
+
+const updateProduct = `-- name: UpdateProduct :one
+UPDATE products
+SET
+    sku = $1,
+    external_id = $2,
+    name = $3,
+    group_id = $4,
+    category_id = $5,
+    archived_at = $6,
+    hold_new_reviews = $7,
+    updated_at = NOW()
+WHERE id = $8 AND tenant_id = $9
+RETURNING id, sku, external_id, name, group_id, archived_at, created_at, updated_at, tenant_id, hold_new_reviews, category_id
+`
+
+type UpdateProductParams struct {
+	Sku            string             `json:"sku"`
+	ExternalID     pgtype.Text        `json:"externalId"`
+	Name           string             `json:"name"`
+	GroupID        pgtype.Int8        `json:"groupId"`
+	CategoryID     pgtype.Int8        `json:"categoryId"`
+	ArchivedAt     pgtype.Timestamptz `json:"archivedAt"`
+	HoldNewReviews bool               `json:"holdNewReviews"`
+	ID             int64              `json:"id"`
+	TenantID       int64              `json:"tenantId"`
+}
+
+func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (Product, error) {
+	row := q.db.QueryRow(ctx, updateProduct,
+		arg.Sku,
+		arg.ExternalID,
+		arg.Name,
+		arg.GroupID,
+		arg.CategoryID,
+		arg.ArchivedAt,
+		arg.HoldNewReviews,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Sku,
+		&i.ExternalID,
+		&i.Name,
+		&i.GroupID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+		&i.HoldNewReviews,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const upsertProductBySKU = `-- name: UpsertProductBySKU :one
+INSERT INTO products (
+    sku,
+    external_id,
+    name,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (tenant_id, sku) DO UPDATE
+SET
+    external_id = EXCLUDED.external_id,
+    name = EXCLUDED.name,
+    archived_at = NULL,
+    updated_at = NOW()
+RETURNING id, sku, external_id, name, group_id, archived_at, created_at, updated_at, tenant_id, hold_new_reviews, category_id
+`
+
+type UpsertProductBySKUParams struct {
+	Sku        string      `json:"sku"`
+	ExternalID pgtype.Text `json:"externalId"`
+	Name       string      `json:"name"`
+	TenantID   int64       `json:"tenantId"`
+}
+
+// Used by the catalog sync; a synced product is live again even if it was archived.
+func (q *Queries) UpsertProductBySKU(ctx context.Context, arg UpsertProductBySKUParams) (Product, error) {
+	row := q.db.QueryRow(ctx, upsertProductBySKU,
+		arg.Sku,
+		arg.ExternalID,
+		arg.Name,
+		arg.TenantID,
+	)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Sku,
+		&i.ExternalID,
+		&i.Name,
+		&i.GroupID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+		&i.HoldNewReviews,
+		&i.CategoryID,
+	)
+	return i, err
+}
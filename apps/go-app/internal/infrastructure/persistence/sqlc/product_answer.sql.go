@@ -0,0 +1,387 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: product_answer.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const adjustProductAnswerVoteCounts = `-- name: AdjustProductAnswerVoteCounts :exec
+UPDATE product_answers
+SET
+    helpful_count = helpful_count + $1::int,
+    unhelpful_count = unhelpful_count + $2::int
+WHERE id = $3 AND tenant_id = $4
+`
+
+type AdjustProductAnswerVoteCountsParams struct {
+	HelpfulDelta   int32 `json:"helpfulDelta"`
+	UnhelpfulDelta int32 `json:"unhelpfulDelta"`
+	ID             int64 `json:"id"`
+	TenantID       int64 `json:"tenantId"`
+}
+
+func (q *Queries) AdjustProductAnswerVoteCounts(ctx context.Context, arg AdjustProductAnswerVoteCountsParams) error {
+	_, err := q.db.Exec(ctx, adjustProductAnswerVoteCounts,
+		arg.HelpfulDelta,
+		arg.UnhelpfulDelta,
+		arg.ID,
+		arg.TenantID,
+	)
+	return err
+}
+
+const createProductAnswer = `-- name: CreateProductAnswer :one
+INSERT INTO product_answers (
+    question_id,
+    user_id,
+    body,
+    brand,
+    status,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, tenant_id, question_id, user_id, body, brand, status, helpful_count, unhelpful_count, created_at, updated_at, deleted_at, helpful_score
+`
+
+type CreateProductAnswerParams struct {
+	QuestionID int64  `json:"questionId"`
+	UserID     int64  `json:"userId"`
+	Body       string `json:"body"`
+	Brand      bool   `json:"brand"`
+	Status     string `json:"status"`
+	TenantID   int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateProductAnswer(ctx context.Context, arg CreateProductAnswerParams) (ProductAnswer, error) {
+	row := q.db.QueryRow(ctx, createProductAnswer,
+		arg.QuestionID,
+		arg.UserID,
+		arg.Body,
+		arg.Brand,
+		arg.Status,
+		arg.TenantID,
+	)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.Brand,
+		&i.Status,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.HelpfulScore,
+	)
+	return i, err
+}
+
+const deleteProductAnswer = `-- name: DeleteProductAnswer :execrows
+UPDATE product_answers
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteProductAnswerParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteProductAnswer(ctx context.Context, arg DeleteProductAnswerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProductAnswer, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteProductAnswerVote = `-- name: DeleteProductAnswerVote :one
+DELETE FROM product_answer_votes
+WHERE answer_id = $1 AND user_id = $2 AND tenant_id = $3
+RETURNING tenant_id, answer_id, user_id, helpful, created_at, updated_at
+`
+
+type DeleteProductAnswerVoteParams struct {
+	AnswerID int64 `json:"answerId"`
+	UserID   int64 `json:"userId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteProductAnswerVote(ctx context.Context, arg DeleteProductAnswerVoteParams) (ProductAnswerVote, error) {
+	row := q.db.QueryRow(ctx, deleteProductAnswerVote, arg.AnswerID, arg.UserID, arg.TenantID)
+	var i ProductAnswerVote
+	err := row.Scan(
+		&i.TenantID,
+		&i.AnswerID,
+		&i.UserID,
+		&i.Helpful,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteProductAnswersByQuestion = `-- name: DeleteProductAnswersByQuestion :exec
+UPDATE product_answers
+SET deleted_at = NOW()
+WHERE question_id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteProductAnswersByQuestionParams struct {
+	QuestionID int64 `json:"questionId"`
+	TenantID   int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteProductAnswersByQuestion(ctx context.Context, arg DeleteProductAnswersByQuestionParams) error {
+	_, err := q.db.Exec(ctx, deleteProductAnswersByQuestion, arg.QuestionID, arg.TenantID)
+	return err
+}
+
+const getProductAnswer = `-- name: GetProductAnswer :one
+SELECT id, tenant_id, question_id, user_id, body, brand, status, helpful_count, unhelpful_count, created_at, updated_at, deleted_at, helpful_score FROM product_answers
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type GetProductAnswerParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetProductAnswer(ctx context.Context, arg GetProductAnswerParams) (ProductAnswer, error) {
+	row := q.db.QueryRow(ctx, getProductAnswer, arg.ID, arg.TenantID)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.Brand,
+		&i.Status,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.HelpfulScore,
+	)
+	return i, err
+}
+
+const getProductAnswerVote = `-- name: GetProductAnswerVote :one
+SELECT tenant_id, answer_id, user_id, helpful, created_at, updated_at FROM product_answer_votes
+WHERE answer_id = $1 AND user_id = $2 AND tenant_id = $3
+`
+
+type GetProductAnswerVoteParams struct {
+	AnswerID int64 `json:"answerId"`
+	UserID   int64 `json:"userId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetProductAnswerVote(ctx context.Context, arg GetProductAnswerVoteParams) (ProductAnswerVote, error) {
+	row := q.db.QueryRow(ctx, getProductAnswerVote, arg.AnswerID, arg.UserID, arg.TenantID)
+	var i ProductAnswerVote
+	err := row.Scan(
+		&i.TenantID,
+		&i.AnswerID,
+		&i.UserID,
+		&i.Helpful,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listProductAnswersByQuestionIDs = `-- name: ListProductAnswersByQuestionIDs :many
+SELECT id, tenant_id, question_id, user_id, body, brand, status, helpful_count, unhelpful_count, created_at, updated_at, deleted_at, helpful_score FROM product_answers
+WHERE tenant_id = $1
+AND question_id = ANY($2::bigint[])
+AND deleted_at IS NULL
+AND ($3::text IS NULL OR status = $3)
+ORDER BY question_id, brand DESC, helpful_score DESC, created_at
+`
+
+type ListProductAnswersByQuestionIDsParams struct {
+	TenantID    int64       `json:"tenantId"`
+	QuestionIds []int64     `json:"questionIds"`
+	Status      pgtype.Text `json:"status"`
+}
+
+// Brand answers come first, then the most helpful.
+func (q *Queries) ListProductAnswersByQuestionIDs(ctx context.Context, arg ListProductAnswersByQuestionIDsParams) ([]ProductAnswer, error) {
+	rows, err := q.db.Query(ctx, listProductAnswersByQuestionIDs, arg.TenantID, arg.QuestionIds, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductAnswer{}
+	for rows.Next() {
+		var i ProductAnswer
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.QuestionID,
+			&i.UserID,
+			&i.Body,
+			&i.Brand,
+			&i.Status,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.HelpfulScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockProductAnswer = `-- name: LockProductAnswer :one
+SELECT id FROM product_answers
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type LockProductAnswerParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) LockProductAnswer(ctx context.Context, arg LockProductAnswerParams) (int64, error) {
+	row := q.db.QueryRow(ctx, lockProductAnswer, arg.ID, arg.TenantID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const setProductAnswerStatus = `-- name: SetProductAnswerStatus :one
+UPDATE product_answers
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING id, tenant_id, question_id, user_id, body, brand, status, helpful_count, unhelpful_count, created_at, updated_at, deleted_at, helpful_score
+`
+
+type SetProductAnswerStatusParams struct {
+	Status   string `json:"status"`
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) SetProductAnswerStatus(ctx context.Context, arg SetProductAnswerStatusParams) (ProductAnswer, error) {
+	row := q.db.QueryRow(ctx, setProductAnswerStatus, arg.Status, arg.ID, arg.TenantID)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.Brand,
+		&i.Status,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.HelpfulScore,
+	)
+	return i, err
+}
+
+const updateProductAnswer = `-- name: UpdateProductAnswer :one
+UPDATE product_answers
+SET
+    body = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING id, tenant_id, question_id, user_id, body, brand, status, helpful_count, unhelpful_count, created_at, updated_at, deleted_at, helpful_score
+`
+
+type UpdateProductAnswerParams struct {
+	Body     string `json:"body"`
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) UpdateProductAnswer(ctx context.Context, arg UpdateProductAnswerParams) (ProductAnswer, error) {
+	row := q.db.QueryRow(ctx, updateProductAnswer, arg.Body, arg.ID, arg.TenantID)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.Brand,
+		&i.Status,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.HelpfulScore,
+	)
+	return i, err
+}
+
+const upsertProductAnswerVote = `-- name: UpsertProductAnswerVote :one
+INSERT INTO product_answer_votes (
+    answer_id,
+    user_id,
+    helpful,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (answer_id, user_id) DO UPDATE
+SET
+    helpful = EXCLUDED.helpful,
+    updated_at = NOW()
+WHERE product_answer_votes.tenant_id = EXCLUDED.tenant_id
+RETURNING tenant_id, answer_id, user_id, helpful, created_at, updated_at
+`
+
+type UpsertProductAnswerVoteParams struct {
+	AnswerID int64 `json:"answerId"`
+	UserID   int64 `json:"userId"`
+	Helpful  bool  `json:"helpful"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) UpsertProductAnswerVote(ctx context.Context, arg UpsertProductAnswerVoteParams) (ProductAnswerVote, error) {
+	row := q.db.QueryRow(ctx, upsertProductAnswerVote,
+		arg.AnswerID,
+		arg.UserID,
+		arg.Helpful,
+		arg.TenantID,
+	)
+	var i ProductAnswerVote
+	err := row.Scan(
+		&i.TenantID,
+		&i.AnswerID,
+		&i.UserID,
+		&i.Helpful,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
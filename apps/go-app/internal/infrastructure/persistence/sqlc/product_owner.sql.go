@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: product_owner.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addProductOwner = `-- name: AddProductOwner :execrows
+INSERT INTO product_owners (
+    product_id,
+    user_id,
+    tenant_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (product_id, user_id) DO NOTHING
+`
+
+type AddProductOwnerParams struct {
+	ProductID int64 `json:"productId"`
+	UserID    int64 `json:"userId"`
+	TenantID  int64 `json:"tenantId"`
+}
+
+func (q *Queries) AddProductOwner(ctx context.Context, arg AddProductOwnerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addProductOwner, arg.ProductID, arg.UserID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const isProductOwner = `-- name: IsProductOwner :one
+SELECT EXISTS (
+    SELECT 1 FROM product_owners
+    WHERE product_id = $1 AND user_id = $2 AND tenant_id = $3
+)
+`
+
+type IsProductOwnerParams struct {
+	ProductID int64 `json:"productId"`
+	UserID    int64 `json:"userId"`
+	TenantID  int64 `json:"tenantId"`
+}
+
+func (q *Queries) IsProductOwner(ctx context.Context, arg IsProductOwnerParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isProductOwner, arg.ProductID, arg.UserID, arg.TenantID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listProductOwners = `-- name: ListProductOwners :many
+SELECT product_id, user_id, created_at, tenant_id FROM product_owners
+WHERE product_id = $1 AND tenant_id = $2
+ORDER BY created_at, user_id
+`
+
+type ListProductOwnersParams struct {
+	ProductID int64 `json:"productId"`
+	TenantID  int64 `json:"tenantId"`
+}
+
+func (q *Queries) ListProductOwners(ctx context.Context, arg ListProductOwnersParams) ([]ProductOwner, error) {
+	rows, err := q.db.Query(ctx, listProductOwners, arg.ProductID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductOwner{}
+	for rows.Next() {
+		var i ProductOwner
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeProductOwner = `-- name: RemoveProductOwner :execrows
+DELETE FROM product_owners
+WHERE product_id = $1 AND user_id = $2 AND tenant_id = $3
+`
+
+type RemoveProductOwnerParams struct {
+	ProductID int64 `json:"productId"`
+	UserID    int64 `json:"userId"`
+	TenantID  int64 `json:"tenantId"`
+}
+
+func (q *Queries) RemoveProductOwner(ctx context.Context, arg RemoveProductOwnerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeProductOwner, arg.ProductID, arg.UserID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,272 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: product_question.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createProductQuestion = `-- name: CreateProductQuestion :one
+INSERT INTO product_questions (
+    product_id,
+    user_id,
+    body,
+    status,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, tenant_id, product_id, user_id, body, status, created_at, updated_at, deleted_at
+`
+
+type CreateProductQuestionParams struct {
+	ProductID int64  `json:"productId"`
+	UserID    int64  `json:"userId"`
+	Body      string `json:"body"`
+	Status    string `json:"status"`
+	TenantID  int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateProductQuestion(ctx context.Context, arg CreateProductQuestionParams) (ProductQuestion, error) {
+	row := q.db.QueryRow(ctx, createProductQuestion,
+		arg.ProductID,
+		arg.UserID,
+		arg.Body,
+		arg.Status,
+		arg.TenantID,
+	)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const deleteProductQuestion = `-- name: DeleteProductQuestion :execrows
+UPDATE product_questions
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteProductQuestionParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteProductQuestion(ctx context.Context, arg DeleteProductQuestionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProductQuestion, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getProductQuestion = `-- name: GetProductQuestion :one
+SELECT id, tenant_id, product_id, user_id, body, status, created_at, updated_at, deleted_at FROM product_questions
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type GetProductQuestionParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetProductQuestion(ctx context.Context, arg GetProductQuestionParams) (ProductQuestion, error) {
+	row := q.db.QueryRow(ctx, getProductQuestion, arg.ID, arg.TenantID)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const listProductQuestions = `-- name: ListProductQuestions :many
+SELECT id, tenant_id, product_id, user_id, body, status, created_at, updated_at, deleted_at FROM product_questions
+WHERE product_questions.tenant_id = $1
+AND deleted_at IS NULL
+AND ($2::text IS NULL OR status = $2)
+AND product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = $3
+    AND requested.tenant_id = $1
+    AND variant.tenant_id = $1
+)
+ORDER BY created_at DESC, id DESC
+LIMIT $5
+OFFSET $4
+`
+
+type ListProductQuestionsParams struct {
+	TenantID  int64       `json:"tenantId"`
+	Status    pgtype.Text `json:"status"`
+	ProductID int64       `json:"productId"`
+	Offset    int32       `json:"offset"`
+	Limit     int32       `json:"limit"`
+}
+
+// Questions about the product and its variants, newest first.
+func (q *Queries) ListProductQuestions(ctx context.Context, arg ListProductQuestionsParams) ([]ProductQuestion, error) {
+	rows, err := q.db.Query(ctx, listProductQuestions,
+		arg.TenantID,
+		arg.Status,
+		arg.ProductID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductQuestion{}
+	for rows.Next() {
+		var i ProductQuestion
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ProductID,
+			&i.UserID,
+			&i.Body,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setProductQuestionStatus = `-- name: SetProductQuestionStatus :one
+UPDATE product_questions
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING id, tenant_id, product_id, user_id, body, status, created_at, updated_at, deleted_at
+`
+
+type SetProductQuestionStatusParams struct {
+	Status   string `json:"status"`
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) SetProductQuestionStatus(ctx context.Context, arg SetProductQuestionStatusParams) (ProductQuestion, error) {
+	row := q.db.QueryRow(ctx, setProductQuestionStatus, arg.Status, arg.ID, arg.TenantID)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const summarizeProductQuestions = `-- name: SummarizeProductQuestions :one
+SELECT
+    COUNT(*) AS question_count,
+    COUNT(*) FILTER (WHERE answers.answer_count > 0) AS answered_count,
+    COALESCE(SUM(answers.answer_count), 0)::bigint AS answer_count
+FROM product_questions
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS answer_count FROM product_answers
+    WHERE product_answers.question_id = product_questions.id
+    AND product_answers.tenant_id = $1
+    AND product_answers.deleted_at IS NULL
+    AND product_answers.status = 'approved'
+) answers
+WHERE product_questions.tenant_id = $1
+AND product_questions.deleted_at IS NULL
+AND product_questions.status = 'approved'
+AND product_questions.product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = $2
+    AND requested.tenant_id = $1
+    AND variant.tenant_id = $1
+)
+`
+
+type SummarizeProductQuestionsParams struct {
+	TenantID  int64 `json:"tenantId"`
+	ProductID int64 `json:"productId"`
+}
+
+type SummarizeProductQuestionsRow struct {
+	QuestionCount int64 `json:"questionCount"`
+	AnsweredCount int64 `json:"answeredCount"`
+	AnswerCount   int64 `json:"answerCount"`
+}
+
+// Counts the approved questions about the product and its variants, those
+// with an approved answer, and their approved answers.
+func (q *Queries) SummarizeProductQuestions(ctx context.Context, arg SummarizeProductQuestionsParams) (SummarizeProductQuestionsRow, error) {
+	row := q.db.QueryRow(ctx, summarizeProductQuestions, arg.TenantID, arg.ProductID)
+	var i SummarizeProductQuestionsRow
+	err := row.Scan(&i.QuestionCount, &i.AnsweredCount, &i.AnswerCount)
+	return i, err
+}
+
+const updateProductQuestion = `-- name: UpdateProductQuestion :one
+UPDATE product_questions
+SET
+    body = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING id, tenant_id, product_id, user_id, body, status, created_at, updated_at, deleted_at
+`
+
+type UpdateProductQuestionParams struct {
+	Body     string `json:"body"`
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) UpdateProductQuestion(ctx context.Context, arg UpdateProductQuestionParams) (ProductQuestion, error) {
+	row := q.db.QueryRow(ctx, updateProductQuestion, arg.Body, arg.ID, arg.TenantID)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -11,21 +11,256 @@ import (
 )
 
 type Querier interface {
-	CreateAuthUser(ctx context.Context, arg CreateAuthUserParams) (Auth, error)
+	AddProductOwner(ctx context.Context, arg AddProductOwnerParams) (int64, error)
+	AdjustProductAnswerVoteCounts(ctx context.Context, arg AdjustProductAnswerVoteCountsParams) error
+	AdjustReviewVoteCounts(ctx context.Context, arg AdjustReviewVoteCountsParams) error
+	AnonymizeProductAnswersByUser(ctx context.Context, arg AnonymizeProductAnswersByUserParams) (int64, error)
+	AnonymizeProductQuestionsByUser(ctx context.Context, arg AnonymizeProductQuestionsByUserParams) (int64, error)
+	// Replies speak for the brand, so only their author is removed.
+	AnonymizeReviewRepliesByAuthor(ctx context.Context, arg AnonymizeReviewRepliesByAuthorParams) (int64, error)
+	// Detaches the reviews from their author; their content stays published.
+	AnonymizeReviewsByUser(ctx context.Context, arg AnonymizeReviewsByUserParams) ([]Review, error)
+	ArchiveProductsNotInSKUs(ctx context.Context, arg ArchiveProductsNotInSKUsParams) (int64, error)
+	// Takes the oldest queued job, or a running one whose worker has gone silent
+	// for longer than the lease.
+	ClaimExportJob(ctx context.Context, arg ClaimExportJobParams) (ExportJob, error)
+	// Locks the oldest due event of each aggregate. Later events of an aggregate
+	// wait until every earlier one was published or dead-lettered, which keeps
+	// delivery in order per aggregate; SKIP LOCKED lets relays run concurrently.
+	ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]Outbox, error)
+	// Leases due deliveries of active subscriptions by pushing their next attempt
+	// past the lease, so that concurrent dispatchers skip them while they are sent.
+	ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	CompleteExportJob(ctx context.Context, arg CompleteExportJobParams) error
+	// Redeems the invitation unless it was redeemed already or has expired.
+	CompleteReviewInvitation(ctx context.Context, arg CompleteReviewInvitationParams) (ReviewInvitation, error)
+	CountOpenReviewReports(ctx context.Context, arg CountOpenReviewReportsParams) (int64, error)
+	CountReviewAttachments(ctx context.Context, arg CountReviewAttachmentsParams) (int64, error)
+	CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error
+	CreateAuthUser(ctx context.Context, arg CreateAuthUserParams) (CreateAuthUserRow, error)
+	CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error)
+	CreateDataSubjectRequest(ctx context.Context, arg CreateDataSubjectRequestParams) (DataSubjectRequest, error)
+	CreateExportJob(ctx context.Context, arg CreateExportJobParams) (ExportJob, error)
 	CreateOAuthProvider(ctx context.Context, arg CreateOAuthProviderParams) (CreateOAuthProviderRow, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error)
+	CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error)
+	CreateProductAnswer(ctx context.Context, arg CreateProductAnswerParams) (ProductAnswer, error)
+	CreateProductQuestion(ctx context.Context, arg CreateProductQuestionParams) (ProductQuestion, error)
+	// Returns no row when the product already has an unresolved alert of the kind.
+	CreateRatingAlert(ctx context.Context, arg CreateRatingAlertParams) (RatingAlert, error)
 	CreateReview(ctx context.Context, arg CreateReviewParams) (Review, error)
-	CreateUserProfile(ctx context.Context, arg CreateUserProfileParams) (UserProfile, error)
-	DeleteReview(ctx context.Context, id int64) error
-	GetAuthUserByEmail(ctx context.Context, email pgtype.Text) (Auth, error)
-	GetAuthUserByID(ctx context.Context, id pgtype.UUID) (Auth, error)
+	CreateReviewAspectRating(ctx context.Context, arg CreateReviewAspectRatingParams) error
+	CreateReviewAttachment(ctx context.Context, arg CreateReviewAttachmentParams) (ReviewAttachment, error)
+	CreateReviewDuplicate(ctx context.Context, arg CreateReviewDuplicateParams) error
+	CreateReviewReply(ctx context.Context, arg CreateReviewReplyParams) (ReviewReply, error)
+	CreateReviewReport(ctx context.Context, arg CreateReviewReportParams) (ReviewReport, error)
+	CreateUserProfile(ctx context.Context, arg CreateUserProfileParams) (CreateUserProfileRow, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
+	DeadLetterOutboxEvent(ctx context.Context, arg DeadLetterOutboxEventParams) error
+	DeleteAuthAccount(ctx context.Context, arg DeleteAuthAccountParams) (int64, error)
+	DeleteCategory(ctx context.Context, arg DeleteCategoryParams) (int64, error)
+	// Ratings of the deleted aspects go with them.
+	DeleteCategoryAspectsNotInKeys(ctx context.Context, arg DeleteCategoryAspectsNotInKeysParams) error
+	DeleteCategoryReviewPolicy(ctx context.Context, arg DeleteCategoryReviewPolicyParams) (int64, error)
+	DeleteExportJobsByRequester(ctx context.Context, arg DeleteExportJobsByRequesterParams) (int64, error)
+	DeleteOAuthProvidersByUser(ctx context.Context, arg DeleteOAuthProvidersByUserParams) (int64, error)
+	DeleteOrdersByCustomer(ctx context.Context, arg DeleteOrdersByCustomerParams) (int64, error)
+	DeleteProductAnswer(ctx context.Context, arg DeleteProductAnswerParams) (int64, error)
+	DeleteProductAnswerVote(ctx context.Context, arg DeleteProductAnswerVoteParams) (ProductAnswerVote, error)
+	DeleteProductAnswersByQuestion(ctx context.Context, arg DeleteProductAnswersByQuestionParams) error
+	// Brand answers speak for the brand and are anonymized instead.
+	DeleteProductAnswersByUser(ctx context.Context, arg DeleteProductAnswersByUserParams) (int64, error)
+	DeleteProductOwnershipsByUser(ctx context.Context, arg DeleteProductOwnershipsByUserParams) (int64, error)
+	DeleteProductQuestion(ctx context.Context, arg DeleteProductQuestionParams) (int64, error)
+	// Removes the questions together with their answers.
+	DeleteProductQuestionsByUser(ctx context.Context, arg DeleteProductQuestionsByUserParams) (int64, error)
+	DeleteProductReviewPolicy(ctx context.Context, arg DeleteProductReviewPolicyParams) (int64, error)
+	DeleteReview(ctx context.Context, arg DeleteReviewParams) error
+	DeleteReviewAspectRatings(ctx context.Context, arg DeleteReviewAspectRatingsParams) error
+	DeleteReviewAttachment(ctx context.Context, arg DeleteReviewAttachmentParams) error
+	DeleteReviewDuplicates(ctx context.Context, arg DeleteReviewDuplicatesParams) error
+	DeleteReviewInvitationsByCustomer(ctx context.Context, arg DeleteReviewInvitationsByCustomerParams) (int64, error)
+	DeleteReviewReply(ctx context.Context, arg DeleteReviewReplyParams) error
+	DeleteReviewReportsByReporter(ctx context.Context, arg DeleteReviewReportsByReporterParams) (int64, error)
+	DeleteReviewVote(ctx context.Context, arg DeleteReviewVoteParams) (ReviewVote, error)
+	// Runs after the user's reviews are deleted or anonymized, which empty the totals.
+	DeleteReviewerStatsByUser(ctx context.Context, arg DeleteReviewerStatsByUserParams) (int64, error)
+	// Removes the reviews together with their votes, replies and attachments.
+	DeleteReviewsByUser(ctx context.Context, arg DeleteReviewsByUserParams) ([]Review, error)
+	DeleteUserProfilesByEmail(ctx context.Context, arg DeleteUserProfilesByEmailParams) (int64, error)
+	DeleteVelocityCountersByUser(ctx context.Context, arg DeleteVelocityCountersByUserParams) (int64, error)
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error)
+	// Removes the user's answer votes and takes them off the answers' vote counts.
+	EraseProductAnswerVotesByUser(ctx context.Context, arg EraseProductAnswerVotesByUserParams) (int64, error)
+	// Removes the user's votes and takes them off the reviews' vote counts.
+	EraseReviewVotesByUser(ctx context.Context, arg EraseReviewVotesByUserParams) (int64, error)
+	FailExportJob(ctx context.Context, arg FailExportJobParams) error
+	GetAuthAccount(ctx context.Context, arg GetAuthAccountParams) (Auth, error)
+	GetAuthUserByEmail(ctx context.Context, arg GetAuthUserByEmailParams) (GetAuthUserByEmailRow, error)
+	GetAuthUserByID(ctx context.Context, arg GetAuthUserByIDParams) (GetAuthUserByIDRow, error)
+	GetCategory(ctx context.Context, arg GetCategoryParams) (Category, error)
+	GetCategoryReviewPolicy(ctx context.Context, arg GetCategoryReviewPolicyParams) (ReviewPolicy, error)
+	GetExportJob(ctx context.Context, arg GetExportJobParams) (ExportJob, error)
+	GetLatestOrderForCustomerProduct(ctx context.Context, arg GetLatestOrderForCustomerProductParams) (Order, error)
+	GetLatestOutboxSeq(ctx context.Context, arg GetLatestOutboxSeqParams) (int64, error)
 	GetOAuthProviderByProviderID(ctx context.Context, arg GetOAuthProviderByProviderIDParams) (GetOAuthProviderByProviderIDRow, error)
-	GetReview(ctx context.Context, id int64) (Review, error)
-	GetUserProfileByEmail(ctx context.Context, email string) (UserProfile, error)
+	GetOrderByExternalID(ctx context.Context, arg GetOrderByExternalIDParams) (Order, error)
+	GetOutboxEvent(ctx context.Context, arg GetOutboxEventParams) (Outbox, error)
+	GetProduct(ctx context.Context, arg GetProductParams) (Product, error)
+	GetProductAnswer(ctx context.Context, arg GetProductAnswerParams) (ProductAnswer, error)
+	GetProductAnswerVote(ctx context.Context, arg GetProductAnswerVoteParams) (ProductAnswerVote, error)
+	GetProductBySKU(ctx context.Context, arg GetProductBySKUParams) (Product, error)
+	GetProductQuestion(ctx context.Context, arg GetProductQuestionParams) (ProductQuestion, error)
+	GetProductReviewPolicy(ctx context.Context, arg GetProductReviewPolicyParams) (ReviewPolicy, error)
+	GetRatingAlert(ctx context.Context, arg GetRatingAlertParams) (RatingAlert, error)
+	GetReview(ctx context.Context, arg GetReviewParams) (Review, error)
+	GetReviewAttachment(ctx context.Context, arg GetReviewAttachmentParams) (ReviewAttachment, error)
+	GetReviewInvitation(ctx context.Context, arg GetReviewInvitationParams) (ReviewInvitation, error)
+	GetReviewReplyByReviewID(ctx context.Context, arg GetReviewReplyByReviewIDParams) (ReviewReply, error)
+	GetReviewVote(ctx context.Context, arg GetReviewVoteParams) (ReviewVote, error)
+	GetReviewerStats(ctx context.Context, arg GetReviewerStatsParams) (ReviewerStat, error)
+	GetReviewerStatsByUser(ctx context.Context, arg GetReviewerStatsByUserParams) (ReviewerStat, error)
+	GetTenantByHostname(ctx context.Context, hostname string) (Tenant, error)
+	GetTenantBySlug(ctx context.Context, slug string) (Tenant, error)
+	GetTokenRevocation(ctx context.Context, arg GetTokenRevocationParams) (pgtype.Timestamptz, error)
+	GetUserProfileByEmail(ctx context.Context, arg GetUserProfileByEmailParams) (GetUserProfileByEmailRow, error)
+	GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error)
+	GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (WebhookSubscription, error)
+	// Counts a review in the current window and returns the counts of the current
+	// and the previous window.
+	HitVelocityCounter(ctx context.Context, arg HitVelocityCounterParams) (HitVelocityCounterRow, error)
+	HoldProductReviews(ctx context.Context, arg HoldProductReviewsParams) error
+	IsProductOwner(ctx context.Context, arg IsProductOwnerParams) (bool, error)
+	// Issuing again for the same order returns the existing invitation, with its
+	// expiry extended unless it was redeemed.
+	IssueReviewInvitation(ctx context.Context, arg IssueReviewInvitationParams) (ReviewInvitation, error)
+	ListActiveWebhookSubscriptions(ctx context.Context, tenantID int64) ([]WebhookSubscription, error)
+	// Returns the policies of the product, of its group's primary product and of
+	// its category, most specific first.
+	ListApplicableReviewPolicies(ctx context.Context, arg ListApplicableReviewPoliciesParams) ([]ReviewPolicy, error)
+	ListCategories(ctx context.Context, arg ListCategoriesParams) ([]Category, error)
+	ListCategoryAspects(ctx context.Context, arg ListCategoryAspectsParams) ([]CategoryAspect, error)
+	ListDataSubjectRequests(ctx context.Context, arg ListDataSubjectRequestsParams) ([]DataSubjectRequest, error)
+	ListExportJobsByRequester(ctx context.Context, arg ListExportJobsByRequesterParams) ([]ExportJob, error)
+	ListOAuthProvidersByUser(ctx context.Context, arg ListOAuthProvidersByUserParams) ([]OauthProvider, error)
+	ListOrdersByCustomer(ctx context.Context, arg ListOrdersByCustomerParams) ([]Order, error)
+	// Reads the event history of an aggregate type in commit order, for consumers
+	// resuming from the last event they saw.
+	ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]Outbox, error)
+	ListProductAnswerVotesByUser(ctx context.Context, arg ListProductAnswerVotesByUserParams) ([]ProductAnswerVote, error)
+	// Brand answers come first, then the most helpful.
+	ListProductAnswersByQuestionIDs(ctx context.Context, arg ListProductAnswersByQuestionIDsParams) ([]ProductAnswer, error)
+	ListProductAnswersByUser(ctx context.Context, arg ListProductAnswersByUserParams) ([]ProductAnswer, error)
+	ListProductOwners(ctx context.Context, arg ListProductOwnersParams) ([]ProductOwner, error)
+	ListProductOwnershipsByUser(ctx context.Context, arg ListProductOwnershipsByUserParams) ([]ProductOwner, error)
+	// Questions about the product and its variants, newest first.
+	ListProductQuestions(ctx context.Context, arg ListProductQuestionsParams) ([]ProductQuestion, error)
+	ListProductQuestionsByUser(ctx context.Context, arg ListProductQuestionsByUserParams) ([]ProductQuestion, error)
+	ListProducts(ctx context.Context, arg ListProductsParams) ([]Product, error)
+	ListRatingAlerts(ctx context.Context, arg ListRatingAlertsParams) ([]RatingAlert, error)
+	// Compares each product's reviews since window_start with those received
+	// between baseline_start and window_start. Only products with at least
+	// min_reviews reviews in the window are returned.
+	ListRatingWindowStats(ctx context.Context, arg ListRatingWindowStatsParams) ([]ListRatingWindowStatsRow, error)
+	// Counts the reports of each reported review by reason and resolution, most
+	// open reports first. Deleted reviews are left out.
+	ListReportedReviews(ctx context.Context, arg ListReportedReviewsParams) ([]ListReportedReviewsRow, error)
+	// Aspects are rated on the scale of their review.
+	ListReviewAspectRatings(ctx context.Context, arg ListReviewAspectRatingsParams) ([]ListReviewAspectRatingsRow, error)
+	ListReviewAttachmentsByReviewIDs(ctx context.Context, arg ListReviewAttachmentsByReviewIDsParams) ([]ReviewAttachment, error)
+	ListReviewAttachmentsByUser(ctx context.Context, arg ListReviewAttachmentsByUserParams) ([]ReviewAttachment, error)
+	ListReviewChangesAfter(ctx context.Context, arg ListReviewChangesAfterParams) ([]ReviewChange, error)
+	ListReviewDuplicates(ctx context.Context, arg ListReviewDuplicatesParams) ([]ReviewDuplicate, error)
+	ListReviewInvitationsByCustomer(ctx context.Context, arg ListReviewInvitationsByCustomerParams) ([]ReviewInvitation, error)
+	ListReviewRepliesByAuthor(ctx context.Context, arg ListReviewRepliesByAuthorParams) ([]ReviewReply, error)
+	ListReviewRepliesByReviewIDs(ctx context.Context, arg ListReviewRepliesByReviewIDsParams) ([]ReviewReply, error)
+	ListReviewReports(ctx context.Context, arg ListReviewReportsParams) ([]ReviewReport, error)
+	ListReviewReportsByReporter(ctx context.Context, arg ListReviewReportsByReporterParams) ([]ReviewReport, error)
+	ListReviewVotesByUser(ctx context.Context, arg ListReviewVotesByUserParams) ([]ReviewVote, error)
 	ListReviews(ctx context.Context, arg ListReviewsParams) ([]Review, error)
-	UpdateAuthUser(ctx context.Context, arg UpdateAuthUserParams) (Auth, error)
+	ListReviewsByIDs(ctx context.Context, arg ListReviewsByIDsParams) ([]Review, error)
+	// Personal data held about a user, for access and erasure requests.
+	ListReviewsByUser(ctx context.Context, arg ListReviewsByUserParams) ([]Review, error)
+	// Recent reviews whose fingerprint shares a band with the given one.
+	ListSimHashCandidates(ctx context.Context, arg ListSimHashCandidatesParams) ([]ListSimHashCandidatesRow, error)
+	ListTenants(ctx context.Context) ([]Tenant, error)
+	ListUserProfilesByEmail(ctx context.Context, arg ListUserProfilesByEmailParams) ([]UserProfile, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, tenantID int64) ([]WebhookSubscription, error)
+	// Serializes sequencing per tenant until the transaction ends, so that every
+	// batch is numbered after the previous one committed.
+	LockOutboxSequencer(ctx context.Context, tenantID int32) error
+	LockProductAnswer(ctx context.Context, arg LockProductAnswerParams) (int64, error)
+	LockReview(ctx context.Context, arg LockReviewParams) (int64, error)
+	// Serializes sequencing per tenant until the transaction ends, so that every
+	// batch is numbered after the previous one committed.
+	LockReviewChangeSequencer(ctx context.Context, tenantID int32) error
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
+	MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error
+	// Verifies reviews the customer wrote for the product after buying it.
+	MarkReviewsVerifiedByOrder(ctx context.Context, arg MarkReviewsVerifiedByOrderParams) (int64, error)
+	OpenReviewInvitation(ctx context.Context, arg OpenReviewInvitationParams) (ReviewInvitation, error)
+	PruneVelocityCounters(ctx context.Context, arg PruneVelocityCountersParams) (int64, error)
+	// Publishes up to limit reviews whose cooling-off period is over. Reviews
+	// being published by another instance are skipped.
+	PublishDueReviews(ctx context.Context, arg PublishDueReviewsParams) ([]Review, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) error
+	// Counts a failed attempt and disables the subscription once disable_after
+	// attempts in a row have failed.
+	RecordWebhookSubscriptionFailure(ctx context.Context, arg RecordWebhookSubscriptionFailureParams) (WebhookSubscription, error)
+	RecordWebhookSubscriptionSuccess(ctx context.Context, arg RecordWebhookSubscriptionSuccessParams) error
+	RedactAuditActor(ctx context.Context, arg RedactAuditActorParams) (int64, error)
+	// Removes the snapshots of the user's reviews, replies, questions and answers
+	// from the audit trail; what was done to them stays on record. Runs before the
+	// user's content is deleted or anonymized.
+	RedactAuditSnapshotsByUser(ctx context.Context, arg RedactAuditSnapshotsByUserParams) (int64, error)
+	// Cuts the events about the user's reviews down to the review and product
+	// IDs. Runs before the user's reviews are deleted or anonymized.
+	RedactOutboxEventsByUser(ctx context.Context, arg RedactOutboxEventsByUserParams) ([]int64, error)
+	// Cuts the data of deliveries of the events down like the events themselves.
+	RedactWebhookDeliveriesByEvents(ctx context.Context, arg RedactWebhookDeliveriesByEventsParams) (int64, error)
+	// Moves every variant of one group into another.
+	RegroupProducts(ctx context.Context, arg RegroupProductsParams) error
+	RemoveProductOwner(ctx context.Context, arg RemoveProductOwnerParams) (int64, error)
+	ResolveReviewReports(ctx context.Context, arg ResolveReviewReportsParams) ([]ReviewReport, error)
+	RevokeTokens(ctx context.Context, arg RevokeTokensParams) error
+	SequenceOutboxEvents(ctx context.Context, tenantID int64) (int64, error)
+	SequenceReviewChanges(ctx context.Context, tenantID int64) (int64, error)
+	SetProductAnswerStatus(ctx context.Context, arg SetProductAnswerStatusParams) (ProductAnswer, error)
+	SetProductQuestionStatus(ctx context.Context, arg SetProductQuestionStatusParams) (ProductQuestion, error)
+	SetReviewStatus(ctx context.Context, arg SetReviewStatusParams) (Review, error)
+	// Averages the ratings of each aspect of the category over the published
+	// approved reviews of the product and its variants. Aspects nobody rated yet
+	// are included with a count of zero.
+	SummarizeProductAspects(ctx context.Context, arg SummarizeProductAspectsParams) ([]SummarizeProductAspectsRow, error)
+	// Counts the approved questions about the product and its variants, those
+	// with an approved answer, and their approved answers.
+	SummarizeProductQuestions(ctx context.Context, arg SummarizeProductQuestionsParams) (SummarizeProductQuestionsRow, error)
+	// Counts the published approved reviews of the product and its variants per
+	// whole normalized star, and sums their normalized ratings.
+	SummarizeProductRatings(ctx context.Context, arg SummarizeProductRatingsParams) ([]SummarizeProductRatingsRow, error)
+	// Conversion of the invitations issued in the period.
+	SummarizeReviewInvitations(ctx context.Context, arg SummarizeReviewInvitationsParams) (SummarizeReviewInvitationsRow, error)
+	UpdateAuthUser(ctx context.Context, arg UpdateAuthUserParams) (UpdateAuthUserRow, error)
+	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error)
 	UpdateOAuthProvider(ctx context.Context, arg UpdateOAuthProviderParams) (UpdateOAuthProviderRow, error)
+	UpdateProduct(ctx context.Context, arg UpdateProductParams) (Product, error)
+	UpdateProductAnswer(ctx context.Context, arg UpdateProductAnswerParams) (ProductAnswer, error)
+	UpdateProductQuestion(ctx context.Context, arg UpdateProductQuestionParams) (ProductQuestion, error)
+	UpdateRatingAlertStatus(ctx context.Context, arg UpdateRatingAlertStatusParams) (RatingAlert, error)
 	UpdateReview(ctx context.Context, arg UpdateReviewParams) (Review, error)
-	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (UserProfile, error)
+	UpdateReviewReply(ctx context.Context, arg UpdateReviewReplyParams) (ReviewReply, error)
+	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (UpdateUserProfileRow, error)
+	UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error)
+	UpsertCategoryAspect(ctx context.Context, arg UpsertCategoryAspectParams) (CategoryAspect, error)
+	UpsertCategoryReviewPolicy(ctx context.Context, arg UpsertCategoryReviewPolicyParams) (ReviewPolicy, error)
+	UpsertOrder(ctx context.Context, arg UpsertOrderParams) (Order, error)
+	UpsertProductAnswerVote(ctx context.Context, arg UpsertProductAnswerVoteParams) (ProductAnswerVote, error)
+	// Used by the catalog sync; a synced product is live again even if it was archived.
+	UpsertProductBySKU(ctx context.Context, arg UpsertProductBySKUParams) (Product, error)
+	UpsertProductReviewPolicy(ctx context.Context, arg UpsertProductReviewPolicyParams) (ReviewPolicy, error)
+	UpsertReviewVote(ctx context.Context, arg UpsertReviewVoteParams) (ReviewVote, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,287 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: rating_alert.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createRatingAlert = `-- name: CreateRatingAlert :one
+INSERT INTO rating_alerts (
+    product_id,
+    kind,
+    window_start,
+    window_end,
+    review_count,
+    mean_rating,
+    expected_count,
+    baseline_mean,
+    baseline_stddev,
+    score,
+    product_held,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+ON CONFLICT (tenant_id, product_id, kind) WHERE status <> 'resolved' DO NOTHING
+RETURNING id, tenant_id, product_id, kind, status, window_start, window_end, review_count, mean_rating, expected_count, baseline_mean, baseline_stddev, score, product_held, created_at, updated_at
+`
+
+type CreateRatingAlertParams struct {
+	ProductID      int64              `json:"productId"`
+	Kind           string             `json:"kind"`
+	WindowStart    pgtype.Timestamptz `json:"windowStart"`
+	WindowEnd      pgtype.Timestamptz `json:"windowEnd"`
+	ReviewCount    int32              `json:"reviewCount"`
+	MeanRating     float64            `json:"meanRating"`
+	ExpectedCount  float64            `json:"expectedCount"`
+	BaselineMean   float64            `json:"baselineMean"`
+	BaselineStddev float64            `json:"baselineStddev"`
+	Score          float64            `json:"score"`
+	ProductHeld    bool               `json:"productHeld"`
+	TenantID       int64              `json:"tenantId"`
+}
+
+// Returns no row when the product already has an unresolved alert of the kind.
+func (q *Queries) CreateRatingAlert(ctx context.Context, arg CreateRatingAlertParams) (RatingAlert, error) {
+	row := q.db.QueryRow(ctx, createRatingAlert,
+		arg.ProductID,
+		arg.Kind,
+		arg.WindowStart,
+		arg.WindowEnd,
+		arg.ReviewCount,
+		arg.MeanRating,
+		arg.ExpectedCount,
+		arg.BaselineMean,
+		arg.BaselineStddev,
+		arg.Score,
+		arg.ProductHeld,
+		arg.TenantID,
+	)
+	var i RatingAlert
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.Kind,
+		&i.Status,
+		&i.WindowStart,
+		&i.WindowEnd,
+		&i.ReviewCount,
+		&i.MeanRating,
+		&i.ExpectedCount,
+		&i.BaselineMean,
+		&i.BaselineStddev,
+		&i.Score,
+		&i.ProductHeld,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRatingAlert = `-- name: GetRatingAlert :one
+SELECT id, tenant_id, product_id, kind, status, window_start, window_end, review_count, mean_rating, expected_count, baseline_mean, baseline_stddev, score, product_held, created_at, updated_at FROM rating_alerts
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetRatingAlertParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetRatingAlert(ctx context.Context, arg GetRatingAlertParams) (RatingAlert, error) {
+	row := q.db.QueryRow(ctx, getRatingAlert, arg.ID, arg.TenantID)
+	var i RatingAlert
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.Kind,
+		&i.Status,
+		&i.WindowStart,
+		&i.WindowEnd,
+		&i.ReviewCount,
+		&i.MeanRating,
+		&i.ExpectedCount,
+		&i.BaselineMean,
+		&i.BaselineStddev,
+		&i.Score,
+		&i.ProductHeld,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listRatingAlerts = `-- name: ListRatingAlerts :many
+SELECT id, tenant_id, product_id, kind, status, window_start, window_end, review_count, mean_rating, expected_count, baseline_mean, baseline_stddev, score, product_held, created_at, updated_at FROM rating_alerts
+WHERE tenant_id = $1
+AND ($2::text IS NULL OR status = $2)
+AND ($3::bigint IS NULL OR product_id = $3)
+ORDER BY id DESC
+LIMIT $5
+OFFSET $4
+`
+
+type ListRatingAlertsParams struct {
+	TenantID  int64       `json:"tenantId"`
+	Status    pgtype.Text `json:"status"`
+	ProductID pgtype.Int8 `json:"productId"`
+	Offset    int32       `json:"offset"`
+	Limit     int32       `json:"limit"`
+}
+
+func (q *Queries) ListRatingAlerts(ctx context.Context, arg ListRatingAlertsParams) ([]RatingAlert, error) {
+	rows, err := q.db.Query(ctx, listRatingAlerts,
+		arg.TenantID,
+		arg.Status,
+		arg.ProductID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RatingAlert{}
+	for rows.Next() {
+		var i RatingAlert
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ProductID,
+			&i.Kind,
+			&i.Status,
+			&i.WindowStart,
+			&i.WindowEnd,
+			&i.ReviewCount,
+			&i.MeanRating,
+			&i.ExpectedCount,
+			&i.BaselineMean,
+			&i.BaselineStddev,
+			&i.Score,
+			&i.ProductHeld,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRatingWindowStats = `-- name: ListRatingWindowStats :many
+SELECT
+    product_id,
+    COUNT(*) FILTER (WHERE created_at >= $1) AS window_count,
+    COALESCE(AVG(rating) FILTER (WHERE created_at >= $1), 0)::float8 AS window_mean,
+    COUNT(*) FILTER (WHERE created_at < $1) AS baseline_count,
+    COALESCE(AVG(rating) FILTER (WHERE created_at < $1), 0)::float8 AS baseline_mean,
+    COALESCE(STDDEV_SAMP(rating) FILTER (WHERE created_at < $1), 0)::float8 AS baseline_stddev
+FROM reviews
+WHERE tenant_id = $2
+AND deleted_at IS NULL
+AND created_at >= $3
+GROUP BY product_id
+HAVING COUNT(*) FILTER (WHERE created_at >= $1) >= $4::bigint
+`
+
+type ListRatingWindowStatsParams struct {
+	WindowStart   pgtype.Timestamptz `json:"windowStart"`
+	TenantID      int64              `json:"tenantId"`
+	BaselineStart pgtype.Timestamptz `json:"baselineStart"`
+	MinReviews    int64              `json:"minReviews"`
+}
+
+type ListRatingWindowStatsRow struct {
+	ProductID      int64   `json:"productId"`
+	WindowCount    int64   `json:"windowCount"`
+	WindowMean     float64 `json:"windowMean"`
+	BaselineCount  int64   `json:"baselineCount"`
+	BaselineMean   float64 `json:"baselineMean"`
+	BaselineStddev float64 `json:"baselineStddev"`
+}
+
+// Compares each product's reviews since window_start with those received
+// between baseline_start and window_start. Only products with at least
+// min_reviews reviews in the window are returned.
+func (q *Queries) ListRatingWindowStats(ctx context.Context, arg ListRatingWindowStatsParams) ([]ListRatingWindowStatsRow, error) {
+	rows, err := q.db.Query(ctx, listRatingWindowStats,
+		arg.WindowStart,
+		arg.TenantID,
+		arg.BaselineStart,
+		arg.MinReviews,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRatingWindowStatsRow{}
+	for rows.Next() {
+		var i ListRatingWindowStatsRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.WindowCount,
+			&i.WindowMean,
+			&i.BaselineCount,
+			&i.BaselineMean,
+			&i.BaselineStddev,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRatingAlertStatus = `-- name: UpdateRatingAlertStatus :one
+UPDATE rating_alerts
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3
+RETURNING id, tenant_id, product_id, kind, status, window_start, window_end, review_count, mean_rating, expected_count, baseline_mean, baseline_stddev, score, product_held, created_at, updated_at
+`
+
+type UpdateRatingAlertStatusParams struct {
+	Status   string `json:"status"`
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) UpdateRatingAlertStatus(ctx context.Context, arg UpdateRatingAlertStatusParams) (RatingAlert, error) {
+	row := q.db.QueryRow(ctx, updateRatingAlertStatus, arg.Status, arg.ID, arg.TenantID)
+	var i RatingAlert
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.Kind,
+		&i.Status,
+		&i.WindowStart,
+		&i.WindowEnd,
+		&i.ReviewCount,
+		&i.MeanRating,
+		&i.ExpectedCount,
+		&i.BaselineMean,
+		&i.BaselineStddev,
+		&i.Score,
+		&i.ProductHeld,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -17,18 +17,52 @@ INSERT INTO reviews (
     product_id,
     rating,
     comment,
-    created_by
+    created_by,
+    verified_purchase,
+    order_id,
+    status,
+    comment_simhash,
+    sentiment_score,
+    sentiment_label,
+    sentiment_mismatch,
+    detected_language,
+    locale,
+    language,
+    rating_value,
+    rating_scale_min,
+    rating_scale_max,
+    rating_scale_step,
+    publish_at,
+    published_at,
+    tenant_id
 ) VALUES (
-    $1, $2, $3, $4, $5
-) RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+) RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at
 `
 
 type CreateReviewParams struct {
-	UserID    int64       `json:"userId"`
-	ProductID int64       `json:"productId"`
-	Rating    int32       `json:"rating"`
-	Comment   pgtype.Text `json:"comment"`
-	CreatedBy pgtype.Text `json:"createdBy"`
+	UserID            int64              `json:"userId"`
+	ProductID         int64              `json:"productId"`
+	Rating            float64            `json:"rating"`
+	Comment           pgtype.Text        `json:"comment"`
+	CreatedBy         pgtype.Text        `json:"createdBy"`
+	VerifiedPurchase  bool               `json:"verifiedPurchase"`
+	OrderID           pgtype.Int8        `json:"orderId"`
+	Status            string             `json:"status"`
+	CommentSimhash    pgtype.Int8        `json:"commentSimhash"`
+	SentimentScore    pgtype.Float8      `json:"sentimentScore"`
+	SentimentLabel    pgtype.Text        `json:"sentimentLabel"`
+	SentimentMismatch bool               `json:"sentimentMismatch"`
+	DetectedLanguage  pgtype.Text        `json:"detectedLanguage"`
+	Locale            pgtype.Text        `json:"locale"`
+	Language          pgtype.Text        `json:"language"`
+	RatingValue       float64            `json:"ratingValue"`
+	RatingScaleMin    float64            `json:"ratingScaleMin"`
+	RatingScaleMax    float64            `json:"ratingScaleMax"`
+	RatingScaleStep   float64            `json:"ratingScaleStep"`
+	PublishAt         pgtype.Timestamptz `json:"publishAt"`
+	PublishedAt       pgtype.Timestamptz `json:"publishedAt"`
+	TenantID          int64              `json:"tenantId"`
 }
 
 func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Review, error) {
@@ -38,6 +72,23 @@ func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Rev
 		arg.Rating,
 		arg.Comment,
 		arg.CreatedBy,
+		arg.VerifiedPurchase,
+		arg.OrderID,
+		arg.Status,
+		arg.CommentSimhash,
+		arg.SentimentScore,
+		arg.SentimentLabel,
+		arg.SentimentMismatch,
+		arg.DetectedLanguage,
+		arg.Locale,
+		arg.Language,
+		arg.RatingValue,
+		arg.RatingScaleMin,
+		arg.RatingScaleMax,
+		arg.RatingScaleStep,
+		arg.PublishAt,
+		arg.PublishedAt,
+		arg.TenantID,
 	)
 	var i Review
 	err := row.Scan(
@@ -50,6 +101,26 @@ func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Rev
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.CreatedBy,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.HelpfulScore,
+		&i.VerifiedPurchase,
+		&i.OrderID,
+		&i.TenantID,
+		&i.Status,
+		&i.CommentSimhash,
+		&i.SentimentScore,
+		&i.SentimentLabel,
+		&i.SentimentMismatch,
+		&i.DetectedLanguage,
+		&i.Locale,
+		&i.Language,
+		&i.RatingValue,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+		&i.PublishAt,
+		&i.PublishedAt,
 	)
 	return i, err
 }
@@ -57,21 +128,31 @@ func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Rev
 const deleteReview = `-- name: DeleteReview :exec
 UPDATE reviews
 SET deleted_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $2
 `
 
-func (q *Queries) DeleteReview(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteReview, id)
+type DeleteReviewParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteReview(ctx context.Context, arg DeleteReviewParams) error {
+	_, err := q.db.Exec(ctx, deleteReview, arg.ID, arg.TenantID)
 	return err
 }
 
 const getReview = `-- name: GetReview :one
-SELECT id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by FROM reviews
-WHERE id = $1 AND deleted_at IS NULL
+SELECT id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at FROM reviews
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
 `
 
-func (q *Queries) GetReview(ctx context.Context, id int64) (Review, error) {
-	row := q.db.QueryRow(ctx, getReview, id)
+type GetReviewParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetReview(ctx context.Context, arg GetReviewParams) (Review, error) {
+	row := q.db.QueryRow(ctx, getReview, arg.ID, arg.TenantID)
 	var i Review
 	err := row.Scan(
 		&i.ID,
@@ -83,25 +164,228 @@ func (q *Queries) GetReview(ctx context.Context, id int64) (Review, error) {
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.CreatedBy,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.HelpfulScore,
+		&i.VerifiedPurchase,
+		&i.OrderID,
+		&i.TenantID,
+		&i.Status,
+		&i.CommentSimhash,
+		&i.SentimentScore,
+		&i.SentimentLabel,
+		&i.SentimentMismatch,
+		&i.DetectedLanguage,
+		&i.Locale,
+		&i.Language,
+		&i.RatingValue,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+		&i.PublishAt,
+		&i.PublishedAt,
 	)
 	return i, err
 }
 
 const listReviews = `-- name: ListReviews :many
-SELECT id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by FROM reviews
-WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1
-OFFSET $2
+SELECT id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at FROM reviews
+WHERE reviews.tenant_id = $1
+AND deleted_at IS NULL
+AND ($2::text IS NULL OR status = $2)
+AND ($3::boolean IS NULL OR verified_purchase = $3)
+AND ($4::text IS NULL OR sentiment_label = $4)
+AND ($5::boolean IS NULL OR sentiment_mismatch = $5)
+AND ($6::text IS NULL OR language = $6)
+AND ($7::boolean IS NULL OR (published_at IS NOT NULL) = $7)
+AND ($8::bigint IS NULL OR product_id IN (
+    -- Variants of a product share its reviews
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = $8
+    AND requested.tenant_id = $1
+    AND variant.tenant_id = $1
+))
+ORDER BY
+    -- Reviews in the preferred languages come first, in order of preference
+    array_position($9::text[], language) NULLS LAST,
+    -- Relevance weighs helpfulness and the author's reputation equally
+    CASE WHEN $10::text = 'relevant' THEN 0.5 * helpful_score + 0.005 * COALESCE((
+        SELECT reputation FROM reviewer_stats
+        WHERE reviewer_stats.tenant_id = reviews.tenant_id
+        AND reviewer_stats.user_id = reviews.user_id
+    ), 0) END DESC,
+    CASE WHEN $10::text = 'most_helpful' THEN helpful_score END DESC,
+    CASE WHEN $10::text = 'verified' THEN verified_purchase END DESC,
+    created_at DESC
+LIMIT $12
+OFFSET $11
 `
 
 type ListReviewsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	TenantID           int64       `json:"tenantId"`
+	Status             pgtype.Text `json:"status"`
+	VerifiedPurchase   pgtype.Bool `json:"verifiedPurchase"`
+	SentimentLabel     pgtype.Text `json:"sentimentLabel"`
+	SentimentMismatch  pgtype.Bool `json:"sentimentMismatch"`
+	Language           pgtype.Text `json:"language"`
+	Published          pgtype.Bool `json:"published"`
+	ProductID          pgtype.Int8 `json:"productId"`
+	PreferredLanguages []string    `json:"preferredLanguages"`
+	Sort               string      `json:"sort"`
+	Offset             int32       `json:"offset"`
+	Limit              int32       `json:"limit"`
 }
 
 func (q *Queries) ListReviews(ctx context.Context, arg ListReviewsParams) ([]Review, error) {
-	rows, err := q.db.Query(ctx, listReviews, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listReviews,
+		arg.TenantID,
+		arg.Status,
+		arg.VerifiedPurchase,
+		arg.SentimentLabel,
+		arg.SentimentMismatch,
+		arg.Language,
+		arg.Published,
+		arg.ProductID,
+		arg.PreferredLanguages,
+		arg.Sort,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Review{}
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.Rating,
+			&i.Comment,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.HelpfulScore,
+			&i.VerifiedPurchase,
+			&i.OrderID,
+			&i.TenantID,
+			&i.Status,
+			&i.CommentSimhash,
+			&i.SentimentScore,
+			&i.SentimentLabel,
+			&i.SentimentMismatch,
+			&i.DetectedLanguage,
+			&i.Locale,
+			&i.Language,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.PublishAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewsByIDs = `-- name: ListReviewsByIDs :many
+SELECT id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at FROM reviews
+WHERE tenant_id = $1 AND id = ANY($2::bigint[]) AND deleted_at IS NULL
+`
+
+type ListReviewsByIDsParams struct {
+	TenantID int64   `json:"tenantId"`
+	Ids      []int64 `json:"ids"`
+}
+
+func (q *Queries) ListReviewsByIDs(ctx context.Context, arg ListReviewsByIDsParams) ([]Review, error) {
+	rows, err := q.db.Query(ctx, listReviewsByIDs, arg.TenantID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Review{}
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.Rating,
+			&i.Comment,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.HelpfulScore,
+			&i.VerifiedPurchase,
+			&i.OrderID,
+			&i.TenantID,
+			&i.Status,
+			&i.CommentSimhash,
+			&i.SentimentScore,
+			&i.SentimentLabel,
+			&i.SentimentMismatch,
+			&i.DetectedLanguage,
+			&i.Locale,
+			&i.Language,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.PublishAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishDueReviews = `-- name: PublishDueReviews :many
+UPDATE reviews
+SET published_at = NOW()
+WHERE id IN (
+    SELECT id FROM reviews due
+    WHERE due.tenant_id = $1
+    AND due.published_at IS NULL
+    AND due.deleted_at IS NULL
+    AND due.publish_at <= NOW()
+    ORDER BY due.publish_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+AND tenant_id = $1
+RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at
+`
+
+type PublishDueReviewsParams struct {
+	TenantID int64 `json:"tenantId"`
+	Limit    int32 `json:"limit"`
+}
+
+// Publishes up to limit reviews whose cooling-off period is over. Reviews
+// being published by another instance are skipped.
+func (q *Queries) PublishDueReviews(ctx context.Context, arg PublishDueReviewsParams) ([]Review, error) {
+	rows, err := q.db.Query(ctx, publishDueReviews, arg.TenantID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +403,26 @@ func (q *Queries) ListReviews(ctx context.Context, arg ListReviewsParams) ([]Rev
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.CreatedBy,
+			&i.HelpfulCount,
+			&i.UnhelpfulCount,
+			&i.HelpfulScore,
+			&i.VerifiedPurchase,
+			&i.OrderID,
+			&i.TenantID,
+			&i.Status,
+			&i.CommentSimhash,
+			&i.SentimentScore,
+			&i.SentimentLabel,
+			&i.SentimentMismatch,
+			&i.DetectedLanguage,
+			&i.Locale,
+			&i.Language,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.PublishAt,
+			&i.PublishedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -130,26 +434,109 @@ func (q *Queries) ListReviews(ctx context.Context, arg ListReviewsParams) ([]Rev
 	return items, nil
 }
 
+const setReviewStatus = `-- name: SetReviewStatus :one
+UPDATE reviews
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at
+`
+
+type SetReviewStatusParams struct {
+	Status   string `json:"status"`
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) SetReviewStatus(ctx context.Context, arg SetReviewStatusParams) (Review, error) {
+	row := q.db.QueryRow(ctx, setReviewStatus, arg.Status, arg.ID, arg.TenantID)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ProductID,
+		&i.Rating,
+		&i.Comment,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.HelpfulScore,
+		&i.VerifiedPurchase,
+		&i.OrderID,
+		&i.TenantID,
+		&i.Status,
+		&i.CommentSimhash,
+		&i.SentimentScore,
+		&i.SentimentLabel,
+		&i.SentimentMismatch,
+		&i.DetectedLanguage,
+		&i.Locale,
+		&i.Language,
+		&i.RatingValue,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+		&i.PublishAt,
+		&i.PublishedAt,
+	)
+	return i, err
+}
+
 const updateReview = `-- name: UpdateReview :one
 UPDATE reviews
 SET
-    rating = COALESCE($2, rating),
+    rating = COALESCE($1, rating),
+    rating_value = COALESCE($2, rating_value),
     comment = COALESCE($3, comment),
+    comment_simhash = $4,
+    sentiment_score = $5,
+    sentiment_label = $6,
+    sentiment_mismatch = $7,
+    detected_language = $8,
+    locale = $9,
+    language = $10,
     updated_at = NOW()
 WHERE
-    id = $1
+    id = $11
+AND tenant_id = $12
 AND deleted_at IS NULL
-RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by
+RETURNING id, user_id, product_id, rating, comment, created_at, updated_at, deleted_at, created_by, helpful_count, unhelpful_count, helpful_score, verified_purchase, order_id, tenant_id, status, comment_simhash, sentiment_score, sentiment_label, sentiment_mismatch, detected_language, locale, language, rating_value, rating_scale_min, rating_scale_max, rating_scale_step, publish_at, published_at
 `
 
 type UpdateReviewParams struct {
-	ID      int64       `json:"id"`
-	Rating  pgtype.Int4 `json:"rating"`
-	Comment pgtype.Text `json:"comment"`
+	Rating            pgtype.Float8 `json:"rating"`
+	RatingValue       pgtype.Float8 `json:"ratingValue"`
+	Comment           pgtype.Text   `json:"comment"`
+	CommentSimhash    pgtype.Int8   `json:"commentSimhash"`
+	SentimentScore    pgtype.Float8 `json:"sentimentScore"`
+	SentimentLabel    pgtype.Text   `json:"sentimentLabel"`
+	SentimentMismatch bool          `json:"sentimentMismatch"`
+	DetectedLanguage  pgtype.Text   `json:"detectedLanguage"`
+	Locale            pgtype.Text   `json:"locale"`
+	Language          pgtype.Text   `json:"language"`
+	ID                int64         `json:"id"`
+	TenantID          int64         `json:"tenantId"`
 }
 
 func (q *Queries) UpdateReview(ctx context.Context, arg UpdateReviewParams) (Review, error) {
-	row := q.db.QueryRow(ctx, updateReview, arg.ID, arg.Rating, arg.Comment)
+	row := q.db.QueryRow(ctx, updateReview,
+		arg.Rating,
+		arg.RatingValue,
+		arg.Comment,
+		arg.CommentSimhash,
+		arg.SentimentScore,
+		arg.SentimentLabel,
+		arg.SentimentMismatch,
+		arg.DetectedLanguage,
+		arg.Locale,
+		arg.Language,
+		arg.ID,
+		arg.TenantID,
+	)
 	var i Review
 	err := row.Scan(
 		&i.ID,
@@ -161,6 +548,26 @@ func (q *Queries) UpdateReview(ctx context.Context, arg UpdateReviewParams) (Rev
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.CreatedBy,
+		&i.HelpfulCount,
+		&i.UnhelpfulCount,
+		&i.HelpfulScore,
+		&i.VerifiedPurchase,
+		&i.OrderID,
+		&i.TenantID,
+		&i.Status,
+		&i.CommentSimhash,
+		&i.SentimentScore,
+		&i.SentimentLabel,
+		&i.SentimentMismatch,
+		&i.DetectedLanguage,
+		&i.Locale,
+		&i.Language,
+		&i.RatingValue,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+		&i.PublishAt,
+		&i.PublishedAt,
 	)
 	return i, err
 }
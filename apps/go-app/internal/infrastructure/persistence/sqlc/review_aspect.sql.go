@@ -0,0 +1,242 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_aspect.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const createReviewAspectRating = `-- name: CreateReviewAspectRating :exec
+INSERT INTO review_aspect_ratings (
+    review_id,
+    aspect_id,
+    rating,
+    rating_value,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+`
+
+type CreateReviewAspectRatingParams struct {
+	ReviewID    int64   `json:"reviewId"`
+	AspectID    int64   `json:"aspectId"`
+	Rating      float64 `json:"rating"`
+	RatingValue float64 `json:"ratingValue"`
+	TenantID    int64   `json:"tenantId"`
+}
+
+func (q *Queries) CreateReviewAspectRating(ctx context.Context, arg CreateReviewAspectRatingParams) error {
+	_, err := q.db.Exec(ctx, createReviewAspectRating,
+		arg.ReviewID,
+		arg.AspectID,
+		arg.Rating,
+		arg.RatingValue,
+		arg.TenantID,
+	)
+	return err
+}
+
+const deleteReviewAspectRatings = `-- name: DeleteReviewAspectRatings :exec
+DELETE FROM review_aspect_ratings
+WHERE review_id = $1 AND tenant_id = $2
+`
+
+type DeleteReviewAspectRatingsParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteReviewAspectRatings(ctx context.Context, arg DeleteReviewAspectRatingsParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewAspectRatings, arg.ReviewID, arg.TenantID)
+	return err
+}
+
+const listReviewAspectRatings = `-- name: ListReviewAspectRatings :many
+SELECT
+    review_aspect_ratings.review_id,
+    review_aspect_ratings.aspect_id,
+    category_aspects.key,
+    review_aspect_ratings.rating_value,
+    reviews.rating_scale_min,
+    reviews.rating_scale_max,
+    reviews.rating_scale_step
+FROM review_aspect_ratings
+JOIN category_aspects ON category_aspects.id = review_aspect_ratings.aspect_id
+JOIN reviews ON reviews.id = review_aspect_ratings.review_id
+WHERE review_aspect_ratings.tenant_id = $1
+AND reviews.tenant_id = $1
+AND review_aspect_ratings.review_id = ANY($2::bigint[])
+ORDER BY review_aspect_ratings.review_id, category_aspects.position, category_aspects.id
+`
+
+type ListReviewAspectRatingsParams struct {
+	TenantID  int64   `json:"tenantId"`
+	ReviewIds []int64 `json:"reviewIds"`
+}
+
+type ListReviewAspectRatingsRow struct {
+	ReviewID        int64   `json:"reviewId"`
+	AspectID        int64   `json:"aspectId"`
+	Key             string  `json:"key"`
+	RatingValue     float64 `json:"ratingValue"`
+	RatingScaleMin  float64 `json:"ratingScaleMin"`
+	RatingScaleMax  float64 `json:"ratingScaleMax"`
+	RatingScaleStep float64 `json:"ratingScaleStep"`
+}
+
+// Aspects are rated on the scale of their review.
+func (q *Queries) ListReviewAspectRatings(ctx context.Context, arg ListReviewAspectRatingsParams) ([]ListReviewAspectRatingsRow, error) {
+	rows, err := q.db.Query(ctx, listReviewAspectRatings, arg.TenantID, arg.ReviewIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReviewAspectRatingsRow{}
+	for rows.Next() {
+		var i ListReviewAspectRatingsRow
+		if err := rows.Scan(
+			&i.ReviewID,
+			&i.AspectID,
+			&i.Key,
+			&i.RatingValue,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const summarizeProductAspects = `-- name: SummarizeProductAspects :many
+SELECT
+    category_aspects.key,
+    category_aspects.name,
+    COUNT(rated.rating) AS rating_count,
+    COALESCE(AVG(rated.rating), 0)::float8 AS average_rating
+FROM category_aspects
+LEFT JOIN (
+    SELECT review_aspect_ratings.aspect_id, review_aspect_ratings.rating
+    FROM review_aspect_ratings
+    JOIN reviews ON reviews.id = review_aspect_ratings.review_id
+    WHERE reviews.tenant_id = $1
+    AND reviews.deleted_at IS NULL
+    AND reviews.status = 'approved'
+    AND reviews.published_at IS NOT NULL
+    AND reviews.product_id IN (
+        SELECT variant.id FROM products variant
+        JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+        WHERE requested.id = $2
+        AND requested.tenant_id = $1
+        AND variant.tenant_id = $1
+    )
+) rated ON rated.aspect_id = category_aspects.id
+WHERE category_aspects.tenant_id = $1
+AND category_aspects.category_id = $3
+GROUP BY category_aspects.id
+ORDER BY category_aspects.position, category_aspects.id
+`
+
+type SummarizeProductAspectsParams struct {
+	TenantID   int64 `json:"tenantId"`
+	ProductID  int64 `json:"productId"`
+	CategoryID int64 `json:"categoryId"`
+}
+
+type SummarizeProductAspectsRow struct {
+	Key           string  `json:"key"`
+	Name          string  `json:"name"`
+	RatingCount   int64   `json:"ratingCount"`
+	AverageRating float64 `json:"averageRating"`
+}
+
+// Averages the ratings of each aspect of the category over the published
+// approved reviews of the product and its variants. Aspects nobody rated yet
+// are included with a count of zero.
+func (q *Queries) SummarizeProductAspects(ctx context.Context, arg SummarizeProductAspectsParams) ([]SummarizeProductAspectsRow, error) {
+	rows, err := q.db.Query(ctx, summarizeProductAspects, arg.TenantID, arg.ProductID, arg.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SummarizeProductAspectsRow{}
+	for rows.Next() {
+		var i SummarizeProductAspectsRow
+		if err := rows.Scan(
+			&i.Key,
+			&i.Name,
+			&i.RatingCount,
+			&i.AverageRating,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const summarizeProductRatings = `-- name: SummarizeProductRatings :many
+SELECT
+    round(rating)::int AS stars,
+    COUNT(*) AS review_count,
+    SUM(rating)::float8 AS rating_sum
+FROM reviews
+WHERE reviews.tenant_id = $1
+AND deleted_at IS NULL
+AND status = 'approved'
+AND published_at IS NOT NULL
+AND product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = $2
+    AND requested.tenant_id = $1
+    AND variant.tenant_id = $1
+)
+GROUP BY stars
+ORDER BY stars
+`
+
+type SummarizeProductRatingsParams struct {
+	TenantID  int64 `json:"tenantId"`
+	ProductID int64 `json:"productId"`
+}
+
+type SummarizeProductRatingsRow struct {
+	Stars       int32   `json:"stars"`
+	ReviewCount int64   `json:"reviewCount"`
+	RatingSum   float64 `json:"ratingSum"`
+}
+
+// Counts the published approved reviews of the product and its variants per
+// whole normalized star, and sums their normalized ratings.
+func (q *Queries) SummarizeProductRatings(ctx context.Context, arg SummarizeProductRatingsParams) ([]SummarizeProductRatingsRow, error) {
+	rows, err := q.db.Query(ctx, summarizeProductRatings, arg.TenantID, arg.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SummarizeProductRatingsRow{}
+	for rows.Next() {
+		var i SummarizeProductRatingsRow
+		if err := rows.Scan(&i.Stars, &i.ReviewCount, &i.RatingSum); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_attachment.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const countReviewAttachments = `-- name: CountReviewAttachments :one
+SELECT COUNT(*) FROM review_attachments
+WHERE review_id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type CountReviewAttachmentsParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) CountReviewAttachments(ctx context.Context, arg CountReviewAttachmentsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countReviewAttachments, arg.ReviewID, arg.TenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createReviewAttachment = `-- name: CreateReviewAttachment :one
+INSERT INTO review_attachments (
+    review_id,
+    blob_key,
+    thumbnail_key,
+    content_type,
+    size_bytes,
+    width,
+    height,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, review_id, blob_key, thumbnail_key, content_type, size_bytes, width, height, created_at, deleted_at, tenant_id
+`
+
+type CreateReviewAttachmentParams struct {
+	ReviewID     int64  `json:"reviewId"`
+	BlobKey      string `json:"blobKey"`
+	ThumbnailKey string `json:"thumbnailKey"`
+	ContentType  string `json:"contentType"`
+	SizeBytes    int64  `json:"sizeBytes"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	TenantID     int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateReviewAttachment(ctx context.Context, arg CreateReviewAttachmentParams) (ReviewAttachment, error) {
+	row := q.db.QueryRow(ctx, createReviewAttachment,
+		arg.ReviewID,
+		arg.BlobKey,
+		arg.ThumbnailKey,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.Width,
+		arg.Height,
+		arg.TenantID,
+	)
+	var i ReviewAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.BlobKey,
+		&i.ThumbnailKey,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Width,
+		&i.Height,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const deleteReviewAttachment = `-- name: DeleteReviewAttachment :exec
+UPDATE review_attachments
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+`
+
+type DeleteReviewAttachmentParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteReviewAttachment(ctx context.Context, arg DeleteReviewAttachmentParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewAttachment, arg.ID, arg.TenantID)
+	return err
+}
+
+const getReviewAttachment = `-- name: GetReviewAttachment :one
+SELECT id, review_id, blob_key, thumbnail_key, content_type, size_bytes, width, height, created_at, deleted_at, tenant_id FROM review_attachments
+WHERE id = $1 AND review_id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+`
+
+type GetReviewAttachmentParams struct {
+	ID       int64 `json:"id"`
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetReviewAttachment(ctx context.Context, arg GetReviewAttachmentParams) (ReviewAttachment, error) {
+	row := q.db.QueryRow(ctx, getReviewAttachment, arg.ID, arg.ReviewID, arg.TenantID)
+	var i ReviewAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.BlobKey,
+		&i.ThumbnailKey,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Width,
+		&i.Height,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const listReviewAttachmentsByReviewIDs = `-- name: ListReviewAttachmentsByReviewIDs :many
+SELECT id, review_id, blob_key, thumbnail_key, content_type, size_bytes, width, height, created_at, deleted_at, tenant_id FROM review_attachments
+WHERE review_id = ANY($1::bigint[])
+AND tenant_id = $2
+AND deleted_at IS NULL
+ORDER BY id
+`
+
+type ListReviewAttachmentsByReviewIDsParams struct {
+	ReviewIds []int64 `json:"reviewIds"`
+	TenantID  int64   `json:"tenantId"`
+}
+
+func (q *Queries) ListReviewAttachmentsByReviewIDs(ctx context.Context, arg ListReviewAttachmentsByReviewIDsParams) ([]ReviewAttachment, error) {
+	rows, err := q.db.Query(ctx, listReviewAttachmentsByReviewIDs, arg.ReviewIds, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewAttachment{}
+	for rows.Next() {
+		var i ReviewAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviewID,
+			&i.BlobKey,
+			&i.ThumbnailKey,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.Width,
+			&i.Height,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,84 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_change.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const listReviewChangesAfter = `-- name: ListReviewChangesAfter :many
+SELECT id, tenant_id, review_id, operation, seq, changed_at FROM review_changes
+WHERE tenant_id = $1 AND seq > $2::bigint
+ORDER BY seq
+LIMIT $3
+`
+
+type ListReviewChangesAfterParams struct {
+	TenantID int64 `json:"tenantId"`
+	AfterSeq int64 `json:"afterSeq"`
+	Limit    int32 `json:"limit"`
+}
+
+func (q *Queries) ListReviewChangesAfter(ctx context.Context, arg ListReviewChangesAfterParams) ([]ReviewChange, error) {
+	rows, err := q.db.Query(ctx, listReviewChangesAfter, arg.TenantID, arg.AfterSeq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewChange{}
+	for rows.Next() {
+		var i ReviewChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ReviewID,
+			&i.Operation,
+			&i.Seq,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockReviewChangeSequencer = `-- name: LockReviewChangeSequencer :exec
+SELECT pg_advisory_xact_lock(hashtext('review_changes'), $1::int)
+`
+
+// Serializes sequencing per tenant until the transaction ends, so that every
+// batch is numbered after the previous one committed.
+func (q *Queries) LockReviewChangeSequencer(ctx context.Context, tenantID int32) error {
+	_, err := q.db.Exec(ctx, lockReviewChangeSequencer, tenantID)
+	return err
+}
+
+const sequenceReviewChanges = `-- name: SequenceReviewChanges :execrows
+WITH pending AS (
+    SELECT unsequenced.id FROM review_changes unsequenced
+    WHERE unsequenced.tenant_id = $1 AND unsequenced.seq IS NULL
+    ORDER BY unsequenced.id
+),
+numbered AS (
+    SELECT pending.id, nextval('review_change_seq') AS seq FROM pending
+)
+UPDATE review_changes
+SET seq = numbered.seq
+FROM numbered
+WHERE review_changes.id = numbered.id
+`
+
+func (q *Queries) SequenceReviewChanges(ctx context.Context, tenantID int64) (int64, error) {
+	result, err := q.db.Exec(ctx, sequenceReviewChanges, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
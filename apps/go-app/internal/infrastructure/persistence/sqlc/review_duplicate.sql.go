@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_duplicate.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createReviewDuplicate = `-- name: CreateReviewDuplicate :exec
+INSERT INTO review_duplicates (
+    review_id,
+    original_id,
+    distance,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (review_id, original_id) DO UPDATE
+SET distance = EXCLUDED.distance
+`
+
+type CreateReviewDuplicateParams struct {
+	ReviewID   int64 `json:"reviewId"`
+	OriginalID int64 `json:"originalId"`
+	Distance   int16 `json:"distance"`
+	TenantID   int64 `json:"tenantId"`
+}
+
+func (q *Queries) CreateReviewDuplicate(ctx context.Context, arg CreateReviewDuplicateParams) error {
+	_, err := q.db.Exec(ctx, createReviewDuplicate,
+		arg.ReviewID,
+		arg.OriginalID,
+		arg.Distance,
+		arg.TenantID,
+	)
+	return err
+}
+
+const deleteReviewDuplicates = `-- name: DeleteReviewDuplicates :exec
+DELETE FROM review_duplicates
+WHERE review_id = $1 AND tenant_id = $2
+`
+
+type DeleteReviewDuplicatesParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteReviewDuplicates(ctx context.Context, arg DeleteReviewDuplicatesParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewDuplicates, arg.ReviewID, arg.TenantID)
+	return err
+}
+
+const listReviewDuplicates = `-- name: ListReviewDuplicates :many
+SELECT tenant_id, review_id, original_id, distance, created_at FROM review_duplicates
+WHERE review_id = $1 AND tenant_id = $2
+ORDER BY distance, original_id
+`
+
+type ListReviewDuplicatesParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) ListReviewDuplicates(ctx context.Context, arg ListReviewDuplicatesParams) ([]ReviewDuplicate, error) {
+	rows, err := q.db.Query(ctx, listReviewDuplicates, arg.ReviewID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewDuplicate{}
+	for rows.Next() {
+		var i ReviewDuplicate
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.ReviewID,
+			&i.OriginalID,
+			&i.Distance,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSimHashCandidates = `-- name: ListSimHashCandidates :many
+SELECT id, comment_simhash::bigint AS comment_simhash FROM reviews
+WHERE tenant_id = $1
+AND comment_simhash IS NOT NULL
+AND deleted_at IS NULL
+AND id <> $2
+AND created_at >= $3
+AND (
+    ((comment_simhash >> 48) & 65535) = $4::bigint
+    OR ((comment_simhash >> 32) & 65535) = $5::bigint
+    OR ((comment_simhash >> 16) & 65535) = $6::bigint
+    OR (comment_simhash & 65535) = $7::bigint
+)
+ORDER BY id DESC
+LIMIT $8
+`
+
+type ListSimHashCandidatesParams struct {
+	TenantID int64              `json:"tenantId"`
+	ReviewID int64              `json:"reviewId"`
+	Since    pgtype.Timestamptz `json:"since"`
+	Band0    int64              `json:"band0"`
+	Band1    int64              `json:"band1"`
+	Band2    int64              `json:"band2"`
+	Band3    int64              `json:"band3"`
+	Limit    int32              `json:"limit"`
+}
+
+type ListSimHashCandidatesRow struct {
+	ID             int64 `json:"id"`
+	CommentSimhash int64 `json:"commentSimhash"`
+}
+
+// Recent reviews whose fingerprint shares a band with the given one.
+func (q *Queries) ListSimHashCandidates(ctx context.Context, arg ListSimHashCandidatesParams) ([]ListSimHashCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listSimHashCandidates,
+		arg.TenantID,
+		arg.ReviewID,
+		arg.Since,
+		arg.Band0,
+		arg.Band1,
+		arg.Band2,
+		arg.Band3,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSimHashCandidatesRow{}
+	for rows.Next() {
+		var i ListSimHashCandidatesRow
+		if err := rows.Scan(&i.ID, &i.CommentSimhash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
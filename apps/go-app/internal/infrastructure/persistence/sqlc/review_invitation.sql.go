@@ -0,0 +1,206 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_invitation.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const completeReviewInvitation = `-- name: CompleteReviewInvitation :one
+UPDATE review_invitations
+SET
+    opened_at = COALESCE(opened_at, NOW()),
+    completed_at = NOW(),
+    review_id = $1
+WHERE id = $2
+AND tenant_id = $3
+AND completed_at IS NULL
+AND expires_at > NOW()
+RETURNING id, tenant_id, order_id, customer_id, product_id, expires_at, opened_at, completed_at, review_id, created_at
+`
+
+type CompleteReviewInvitationParams struct {
+	ReviewID pgtype.Int8 `json:"reviewId"`
+	ID       int64       `json:"id"`
+	TenantID int64       `json:"tenantId"`
+}
+
+// Redeems the invitation unless it was redeemed already or has expired.
+func (q *Queries) CompleteReviewInvitation(ctx context.Context, arg CompleteReviewInvitationParams) (ReviewInvitation, error) {
+	row := q.db.QueryRow(ctx, completeReviewInvitation, arg.ReviewID, arg.ID, arg.TenantID)
+	var i ReviewInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.OrderID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.ExpiresAt,
+		&i.OpenedAt,
+		&i.CompletedAt,
+		&i.ReviewID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getReviewInvitation = `-- name: GetReviewInvitation :one
+SELECT id, tenant_id, order_id, customer_id, product_id, expires_at, opened_at, completed_at, review_id, created_at FROM review_invitations
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetReviewInvitationParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetReviewInvitation(ctx context.Context, arg GetReviewInvitationParams) (ReviewInvitation, error) {
+	row := q.db.QueryRow(ctx, getReviewInvitation, arg.ID, arg.TenantID)
+	var i ReviewInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.OrderID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.ExpiresAt,
+		&i.OpenedAt,
+		&i.CompletedAt,
+		&i.ReviewID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const issueReviewInvitation = `-- name: IssueReviewInvitation :one
+INSERT INTO review_invitations (
+    order_id,
+    customer_id,
+    product_id,
+    expires_at,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (tenant_id, order_id) DO UPDATE
+SET expires_at = CASE
+    WHEN review_invitations.completed_at IS NULL
+    THEN GREATEST(review_invitations.expires_at, EXCLUDED.expires_at)
+    ELSE review_invitations.expires_at
+END
+RETURNING id, tenant_id, order_id, customer_id, product_id, expires_at, opened_at, completed_at, review_id, created_at
+`
+
+type IssueReviewInvitationParams struct {
+	OrderID    int64              `json:"orderId"`
+	CustomerID int64              `json:"customerId"`
+	ProductID  int64              `json:"productId"`
+	ExpiresAt  pgtype.Timestamptz `json:"expiresAt"`
+	TenantID   int64              `json:"tenantId"`
+}
+
+// Issuing again for the same order returns the existing invitation, with its
+// expiry extended unless it was redeemed.
+func (q *Queries) IssueReviewInvitation(ctx context.Context, arg IssueReviewInvitationParams) (ReviewInvitation, error) {
+	row := q.db.QueryRow(ctx, issueReviewInvitation,
+		arg.OrderID,
+		arg.CustomerID,
+		arg.ProductID,
+		arg.ExpiresAt,
+		arg.TenantID,
+	)
+	var i ReviewInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.OrderID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.ExpiresAt,
+		&i.OpenedAt,
+		&i.CompletedAt,
+		&i.ReviewID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const openReviewInvitation = `-- name: OpenReviewInvitation :one
+UPDATE review_invitations
+SET opened_at = COALESCE(opened_at, NOW())
+WHERE id = $1 AND tenant_id = $2
+RETURNING id, tenant_id, order_id, customer_id, product_id, expires_at, opened_at, completed_at, review_id, created_at
+`
+
+type OpenReviewInvitationParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) OpenReviewInvitation(ctx context.Context, arg OpenReviewInvitationParams) (ReviewInvitation, error) {
+	row := q.db.QueryRow(ctx, openReviewInvitation, arg.ID, arg.TenantID)
+	var i ReviewInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.OrderID,
+		&i.CustomerID,
+		&i.ProductID,
+		&i.ExpiresAt,
+		&i.OpenedAt,
+		&i.CompletedAt,
+		&i.ReviewID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const summarizeReviewInvitations = `-- name: SummarizeReviewInvitations :one
+SELECT
+    COUNT(*) AS issued,
+    COUNT(*) FILTER (WHERE opened_at IS NOT NULL) AS opened,
+    COUNT(*) FILTER (WHERE completed_at IS NOT NULL) AS completed,
+    COUNT(*) FILTER (WHERE completed_at IS NULL AND expires_at <= NOW()) AS expired
+FROM review_invitations
+WHERE tenant_id = $1
+AND ($2::bigint IS NULL OR product_id = $2)
+AND ($3::timestamptz IS NULL OR created_at >= $3)
+AND ($4::timestamptz IS NULL OR created_at < $4)
+`
+
+type SummarizeReviewInvitationsParams struct {
+	TenantID   int64              `json:"tenantId"`
+	ProductID  pgtype.Int8        `json:"productId"`
+	IssuedFrom pgtype.Timestamptz `json:"issuedFrom"`
+	IssuedTo   pgtype.Timestamptz `json:"issuedTo"`
+}
+
+type SummarizeReviewInvitationsRow struct {
+	Issued    int64 `json:"issued"`
+	Opened    int64 `json:"opened"`
+	Completed int64 `json:"completed"`
+	Expired   int64 `json:"expired"`
+}
+
+// Conversion of the invitations issued in the period.
+func (q *Queries) SummarizeReviewInvitations(ctx context.Context, arg SummarizeReviewInvitationsParams) (SummarizeReviewInvitationsRow, error) {
+	row := q.db.QueryRow(ctx, summarizeReviewInvitations,
+		arg.TenantID,
+		arg.ProductID,
+		arg.IssuedFrom,
+		arg.IssuedTo,
+	)
+	var i SummarizeReviewInvitationsRow
+	err := row.Scan(
+		&i.Issued,
+		&i.Opened,
+		&i.Completed,
+		&i.Expired,
+	)
+	return i, err
+}
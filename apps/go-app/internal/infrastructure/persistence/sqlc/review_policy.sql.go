@@ -0,0 +1,286 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_policy.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteCategoryReviewPolicy = `-- name: DeleteCategoryReviewPolicy :execrows
+DELETE FROM review_policies
+WHERE category_id = $1 AND tenant_id = $2
+`
+
+type DeleteCategoryReviewPolicyParams struct {
+	CategoryID pgtype.Int8 `json:"categoryId"`
+	TenantID   int64       `json:"tenantId"`
+}
+
+func (q *Queries) DeleteCategoryReviewPolicy(ctx context.Context, arg DeleteCategoryReviewPolicyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCategoryReviewPolicy, arg.CategoryID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteProductReviewPolicy = `-- name: DeleteProductReviewPolicy :execrows
+DELETE FROM review_policies
+WHERE product_id = $1 AND tenant_id = $2
+`
+
+type DeleteProductReviewPolicyParams struct {
+	ProductID pgtype.Int8 `json:"productId"`
+	TenantID  int64       `json:"tenantId"`
+}
+
+func (q *Queries) DeleteProductReviewPolicy(ctx context.Context, arg DeleteProductReviewPolicyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProductReviewPolicy, arg.ProductID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCategoryReviewPolicy = `-- name: GetCategoryReviewPolicy :one
+SELECT id, tenant_id, product_id, category_id, min_comment_length, comment_required, verified_only, moderation, closed, created_at, updated_at FROM review_policies
+WHERE category_id = $1 AND tenant_id = $2
+`
+
+type GetCategoryReviewPolicyParams struct {
+	CategoryID pgtype.Int8 `json:"categoryId"`
+	TenantID   int64       `json:"tenantId"`
+}
+
+func (q *Queries) GetCategoryReviewPolicy(ctx context.Context, arg GetCategoryReviewPolicyParams) (ReviewPolicy, error) {
+	row := q.db.QueryRow(ctx, getCategoryReviewPolicy, arg.CategoryID, arg.TenantID)
+	var i ReviewPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.CategoryID,
+		&i.MinCommentLength,
+		&i.CommentRequired,
+		&i.VerifiedOnly,
+		&i.Moderation,
+		&i.Closed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getProductReviewPolicy = `-- name: GetProductReviewPolicy :one
+SELECT id, tenant_id, product_id, category_id, min_comment_length, comment_required, verified_only, moderation, closed, created_at, updated_at FROM review_policies
+WHERE product_id = $1 AND tenant_id = $2
+`
+
+type GetProductReviewPolicyParams struct {
+	ProductID pgtype.Int8 `json:"productId"`
+	TenantID  int64       `json:"tenantId"`
+}
+
+func (q *Queries) GetProductReviewPolicy(ctx context.Context, arg GetProductReviewPolicyParams) (ReviewPolicy, error) {
+	row := q.db.QueryRow(ctx, getProductReviewPolicy, arg.ProductID, arg.TenantID)
+	var i ReviewPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.CategoryID,
+		&i.MinCommentLength,
+		&i.CommentRequired,
+		&i.VerifiedOnly,
+		&i.Moderation,
+		&i.Closed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listApplicableReviewPolicies = `-- name: ListApplicableReviewPolicies :many
+SELECT id, tenant_id, product_id, category_id, min_comment_length, comment_required, verified_only, moderation, closed, created_at, updated_at FROM review_policies
+WHERE tenant_id = $1
+AND (
+    product_id = $2
+    OR product_id = $3
+    OR category_id = $4
+)
+ORDER BY
+    CASE
+        WHEN product_id = $2 THEN 0
+        WHEN product_id IS NOT NULL THEN 1
+        ELSE 2
+    END
+`
+
+type ListApplicableReviewPoliciesParams struct {
+	TenantID   int64       `json:"tenantId"`
+	ProductID  pgtype.Int8 `json:"productId"`
+	GroupID    pgtype.Int8 `json:"groupId"`
+	CategoryID pgtype.Int8 `json:"categoryId"`
+}
+
+// Returns the policies of the product, of its group's primary product and of
+// its category, most specific first.
+func (q *Queries) ListApplicableReviewPolicies(ctx context.Context, arg ListApplicableReviewPoliciesParams) ([]ReviewPolicy, error) {
+	rows, err := q.db.Query(ctx, listApplicableReviewPolicies,
+		arg.TenantID,
+		arg.ProductID,
+		arg.GroupID,
+		arg.CategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewPolicy{}
+	for rows.Next() {
+		var i ReviewPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ProductID,
+			&i.CategoryID,
+			&i.MinCommentLength,
+			&i.CommentRequired,
+			&i.VerifiedOnly,
+			&i.Moderation,
+			&i.Closed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertCategoryReviewPolicy = `-- name: UpsertCategoryReviewPolicy :one
+INSERT INTO review_policies (
+    category_id,
+    min_comment_length,
+    comment_required,
+    verified_only,
+    moderation,
+    closed,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (category_id) WHERE category_id IS NOT NULL DO UPDATE SET
+    min_comment_length = EXCLUDED.min_comment_length,
+    comment_required = EXCLUDED.comment_required,
+    verified_only = EXCLUDED.verified_only,
+    moderation = EXCLUDED.moderation,
+    closed = EXCLUDED.closed,
+    updated_at = NOW()
+RETURNING id, tenant_id, product_id, category_id, min_comment_length, comment_required, verified_only, moderation, closed, created_at, updated_at
+`
+
+type UpsertCategoryReviewPolicyParams struct {
+	CategoryID       pgtype.Int8 `json:"categoryId"`
+	MinCommentLength pgtype.Int4 `json:"minCommentLength"`
+	CommentRequired  pgtype.Bool `json:"commentRequired"`
+	VerifiedOnly     pgtype.Bool `json:"verifiedOnly"`
+	Moderation       pgtype.Text `json:"moderation"`
+	Closed           pgtype.Bool `json:"closed"`
+	TenantID         int64       `json:"tenantId"`
+}
+
+func (q *Queries) UpsertCategoryReviewPolicy(ctx context.Context, arg UpsertCategoryReviewPolicyParams) (ReviewPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertCategoryReviewPolicy,
+		arg.CategoryID,
+		arg.MinCommentLength,
+		arg.CommentRequired,
+		arg.VerifiedOnly,
+		arg.Moderation,
+		arg.Closed,
+		arg.TenantID,
+	)
+	var i ReviewPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.CategoryID,
+		&i.MinCommentLength,
+		&i.CommentRequired,
+		&i.VerifiedOnly,
+		&i.Moderation,
+		&i.Closed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertProductReviewPolicy = `-- name: UpsertProductReviewPolicy :one
+INSERT INTO review_policies (
+    product_id,
+    min_comment_length,
+    comment_required,
+    verified_only,
+    moderation,
+    closed,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (product_id) WHERE product_id IS NOT NULL DO UPDATE SET
+    min_comment_length = EXCLUDED.min_comment_length,
+    comment_required = EXCLUDED.comment_required,
+    verified_only = EXCLUDED.verified_only,
+    moderation = EXCLUDED.moderation,
+    closed = EXCLUDED.closed,
+    updated_at = NOW()
+RETURNING id, tenant_id, product_id, category_id, min_comment_length, comment_required, verified_only, moderation, closed, created_at, updated_at
+`
+
+type UpsertProductReviewPolicyParams struct {
+	ProductID        pgtype.Int8 `json:"productId"`
+	MinCommentLength pgtype.Int4 `json:"minCommentLength"`
+	CommentRequired  pgtype.Bool `json:"commentRequired"`
+	VerifiedOnly     pgtype.Bool `json:"verifiedOnly"`
+	Moderation       pgtype.Text `json:"moderation"`
+	Closed           pgtype.Bool `json:"closed"`
+	TenantID         int64       `json:"tenantId"`
+}
+
+func (q *Queries) UpsertProductReviewPolicy(ctx context.Context, arg UpsertProductReviewPolicyParams) (ReviewPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertProductReviewPolicy,
+		arg.ProductID,
+		arg.MinCommentLength,
+		arg.CommentRequired,
+		arg.VerifiedOnly,
+		arg.Moderation,
+		arg.Closed,
+		arg.TenantID,
+	)
+	var i ReviewPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ProductID,
+		&i.CategoryID,
+		&i.MinCommentLength,
+		&i.CommentRequired,
+		&i.VerifiedOnly,
+		&i.Moderation,
+		&i.Closed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
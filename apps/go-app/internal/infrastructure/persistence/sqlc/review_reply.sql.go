@@ -0,0 +1,166 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_reply.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const createReviewReply = `-- name: CreateReviewReply :one
+INSERT INTO review_replies (
+    review_id,
+    author_id,
+    body,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, review_id, author_id, body, created_at, updated_at, deleted_at, tenant_id
+`
+
+type CreateReviewReplyParams struct {
+	ReviewID int64  `json:"reviewId"`
+	AuthorID int64  `json:"authorId"`
+	Body     string `json:"body"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateReviewReply(ctx context.Context, arg CreateReviewReplyParams) (ReviewReply, error) {
+	row := q.db.QueryRow(ctx, createReviewReply,
+		arg.ReviewID,
+		arg.AuthorID,
+		arg.Body,
+		arg.TenantID,
+	)
+	var i ReviewReply
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.AuthorID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const deleteReviewReply = `-- name: DeleteReviewReply :exec
+UPDATE review_replies
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+`
+
+type DeleteReviewReplyParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteReviewReply(ctx context.Context, arg DeleteReviewReplyParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewReply, arg.ID, arg.TenantID)
+	return err
+}
+
+const getReviewReplyByReviewID = `-- name: GetReviewReplyByReviewID :one
+SELECT id, review_id, author_id, body, created_at, updated_at, deleted_at, tenant_id FROM review_replies
+WHERE review_id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+`
+
+type GetReviewReplyByReviewIDParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetReviewReplyByReviewID(ctx context.Context, arg GetReviewReplyByReviewIDParams) (ReviewReply, error) {
+	row := q.db.QueryRow(ctx, getReviewReplyByReviewID, arg.ReviewID, arg.TenantID)
+	var i ReviewReply
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.AuthorID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const listReviewRepliesByReviewIDs = `-- name: ListReviewRepliesByReviewIDs :many
+SELECT id, review_id, author_id, body, created_at, updated_at, deleted_at, tenant_id FROM review_replies
+WHERE review_id = ANY($1::bigint[])
+AND tenant_id = $2
+AND deleted_at IS NULL
+`
+
+type ListReviewRepliesByReviewIDsParams struct {
+	ReviewIds []int64 `json:"reviewIds"`
+	TenantID  int64   `json:"tenantId"`
+}
+
+func (q *Queries) ListReviewRepliesByReviewIDs(ctx context.Context, arg ListReviewRepliesByReviewIDsParams) ([]ReviewReply, error) {
+	rows, err := q.db.Query(ctx, listReviewRepliesByReviewIDs, arg.ReviewIds, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewReply{}
+	for rows.Next() {
+		var i ReviewReply
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviewID,
+			&i.AuthorID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateReviewReply = `-- name: UpdateReviewReply :one
+UPDATE review_replies
+SET
+    body = $2,
+    updated_at = NOW()
+WHERE
+    id = $1
+AND tenant_id = $3
+AND deleted_at IS NULL
+RETURNING id, review_id, author_id, body, created_at, updated_at, deleted_at, tenant_id
+`
+
+type UpdateReviewReplyParams struct {
+	ID       int64  `json:"id"`
+	Body     string `json:"body"`
+	TenantID int64  `json:"tenantId"`
+}
+
+func (q *Queries) UpdateReviewReply(ctx context.Context, arg UpdateReviewReplyParams) (ReviewReply, error) {
+	row := q.db.QueryRow(ctx, updateReviewReply, arg.ID, arg.Body, arg.TenantID)
+	var i ReviewReply
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.AuthorID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
@@ -0,0 +1,249 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_report.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countOpenReviewReports = `-- name: CountOpenReviewReports :one
+SELECT COUNT(*) FROM review_reports
+WHERE review_id = $1 AND tenant_id = $2 AND status = 'open'
+`
+
+type CountOpenReviewReportsParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) CountOpenReviewReports(ctx context.Context, arg CountOpenReviewReportsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOpenReviewReports, arg.ReviewID, arg.TenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createReviewReport = `-- name: CreateReviewReport :one
+INSERT INTO review_reports (
+    review_id,
+    reporter_id,
+    reason,
+    details,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, tenant_id, review_id, reporter_id, reason, details, status, resolved_by, resolved_at, created_at
+`
+
+type CreateReviewReportParams struct {
+	ReviewID   int64       `json:"reviewId"`
+	ReporterID int64       `json:"reporterId"`
+	Reason     string      `json:"reason"`
+	Details    pgtype.Text `json:"details"`
+	TenantID   int64       `json:"tenantId"`
+}
+
+func (q *Queries) CreateReviewReport(ctx context.Context, arg CreateReviewReportParams) (ReviewReport, error) {
+	row := q.db.QueryRow(ctx, createReviewReport,
+		arg.ReviewID,
+		arg.ReporterID,
+		arg.Reason,
+		arg.Details,
+		arg.TenantID,
+	)
+	var i ReviewReport
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ReviewID,
+		&i.ReporterID,
+		&i.Reason,
+		&i.Details,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listReportedReviews = `-- name: ListReportedReviews :many
+SELECT
+    review_reports.review_id,
+    COUNT(*) FILTER (WHERE review_reports.status = 'open') AS open_count,
+    COUNT(*) FILTER (WHERE review_reports.status = 'upheld') AS upheld_count,
+    COUNT(*) FILTER (WHERE review_reports.status = 'dismissed') AS dismissed_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'spam') AS spam_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'offensive') AS offensive_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'fake') AS fake_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'other') AS other_count,
+    MAX(review_reports.created_at)::timestamptz AS last_reported_at
+FROM review_reports
+JOIN reviews ON reviews.id = review_reports.review_id
+WHERE review_reports.tenant_id = $1
+AND reviews.tenant_id = $1
+AND reviews.deleted_at IS NULL
+GROUP BY review_reports.review_id
+HAVING NOT $2::boolean OR COUNT(*) FILTER (WHERE review_reports.status = 'open') > 0
+ORDER BY open_count DESC, last_reported_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListReportedReviewsParams struct {
+	TenantID int64 `json:"tenantId"`
+	OpenOnly bool  `json:"openOnly"`
+	Offset   int32 `json:"offset"`
+	Limit    int32 `json:"limit"`
+}
+
+type ListReportedReviewsRow struct {
+	ReviewID       int64              `json:"reviewId"`
+	OpenCount      int64              `json:"openCount"`
+	UpheldCount    int64              `json:"upheldCount"`
+	DismissedCount int64              `json:"dismissedCount"`
+	SpamCount      int64              `json:"spamCount"`
+	OffensiveCount int64              `json:"offensiveCount"`
+	FakeCount      int64              `json:"fakeCount"`
+	OtherCount     int64              `json:"otherCount"`
+	LastReportedAt pgtype.Timestamptz `json:"lastReportedAt"`
+}
+
+// Counts the reports of each reported review by reason and resolution, most
+// open reports first. Deleted reviews are left out.
+func (q *Queries) ListReportedReviews(ctx context.Context, arg ListReportedReviewsParams) ([]ListReportedReviewsRow, error) {
+	rows, err := q.db.Query(ctx, listReportedReviews,
+		arg.TenantID,
+		arg.OpenOnly,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReportedReviewsRow{}
+	for rows.Next() {
+		var i ListReportedReviewsRow
+		if err := rows.Scan(
+			&i.ReviewID,
+			&i.OpenCount,
+			&i.UpheldCount,
+			&i.DismissedCount,
+			&i.SpamCount,
+			&i.OffensiveCount,
+			&i.FakeCount,
+			&i.OtherCount,
+			&i.LastReportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewReports = `-- name: ListReviewReports :many
+SELECT id, tenant_id, review_id, reporter_id, reason, details, status, resolved_by, resolved_at, created_at FROM review_reports
+WHERE review_id = $1 AND tenant_id = $2
+ORDER BY created_at, id
+`
+
+type ListReviewReportsParams struct {
+	ReviewID int64 `json:"reviewId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) ListReviewReports(ctx context.Context, arg ListReviewReportsParams) ([]ReviewReport, error) {
+	rows, err := q.db.Query(ctx, listReviewReports, arg.ReviewID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewReport{}
+	for rows.Next() {
+		var i ReviewReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ReviewID,
+			&i.ReporterID,
+			&i.Reason,
+			&i.Details,
+			&i.Status,
+			&i.ResolvedBy,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveReviewReports = `-- name: ResolveReviewReports :many
+UPDATE review_reports
+SET
+    status = $1,
+    resolved_by = $2,
+    resolved_at = NOW()
+WHERE review_id = $3
+AND tenant_id = $4
+AND status = 'open'
+RETURNING id, tenant_id, review_id, reporter_id, reason, details, status, resolved_by, resolved_at, created_at
+`
+
+type ResolveReviewReportsParams struct {
+	Status     string      `json:"status"`
+	ResolvedBy pgtype.Int8 `json:"resolvedBy"`
+	ReviewID   int64       `json:"reviewId"`
+	TenantID   int64       `json:"tenantId"`
+}
+
+func (q *Queries) ResolveReviewReports(ctx context.Context, arg ResolveReviewReportsParams) ([]ReviewReport, error) {
+	rows, err := q.db.Query(ctx, resolveReviewReports,
+		arg.Status,
+		arg.ResolvedBy,
+		arg.ReviewID,
+		arg.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReviewReport{}
+	for rows.Next() {
+		var i ReviewReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ReviewID,
+			&i.ReporterID,
+			&i.Reason,
+			&i.Details,
+			&i.Status,
+			&i.ResolvedBy,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,78 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_velocity.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const hitVelocityCounter = `-- name: HitVelocityCounter :one
+WITH hit AS (
+    INSERT INTO review_velocity_counters (tenant_id, scope, subject, window_start, count)
+    VALUES ($1, $2, $3, $5, 1)
+    ON CONFLICT (tenant_id, scope, subject, window_start)
+    DO UPDATE SET count = review_velocity_counters.count + 1
+    RETURNING review_velocity_counters.count
+)
+SELECT
+    hit.count AS current_count,
+    COALESCE((
+        SELECT previous.count FROM review_velocity_counters previous
+        WHERE previous.tenant_id = $1
+        AND previous.scope = $2
+        AND previous.subject = $3
+        AND previous.window_start = $4
+    ), 0)::int AS previous_count
+FROM hit
+`
+
+type HitVelocityCounterParams struct {
+	TenantID            int64              `json:"tenantId"`
+	Scope               string             `json:"scope"`
+	Subject             string             `json:"subject"`
+	PreviousWindowStart pgtype.Timestamptz `json:"previousWindowStart"`
+	WindowStart         pgtype.Timestamptz `json:"windowStart"`
+}
+
+type HitVelocityCounterRow struct {
+	CurrentCount  int32 `json:"currentCount"`
+	PreviousCount int32 `json:"previousCount"`
+}
+
+// Counts a review in the current window and returns the counts of the current
+// and the previous window.
+func (q *Queries) HitVelocityCounter(ctx context.Context, arg HitVelocityCounterParams) (HitVelocityCounterRow, error) {
+	row := q.db.QueryRow(ctx, hitVelocityCounter,
+		arg.TenantID,
+		arg.Scope,
+		arg.Subject,
+		arg.PreviousWindowStart,
+		arg.WindowStart,
+	)
+	var i HitVelocityCounterRow
+	err := row.Scan(&i.CurrentCount, &i.PreviousCount)
+	return i, err
+}
+
+const pruneVelocityCounters = `-- name: PruneVelocityCounters :execrows
+DELETE FROM review_velocity_counters
+WHERE tenant_id = $1 AND window_start < $2
+`
+
+type PruneVelocityCountersParams struct {
+	TenantID int64              `json:"tenantId"`
+	Before   pgtype.Timestamptz `json:"before"`
+}
+
+func (q *Queries) PruneVelocityCounters(ctx context.Context, arg PruneVelocityCountersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, pruneVelocityCounters, arg.TenantID, arg.Before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
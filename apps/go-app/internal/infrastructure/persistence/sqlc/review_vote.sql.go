@@ -0,0 +1,147 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: review_vote.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const adjustReviewVoteCounts = `-- name: AdjustReviewVoteCounts :exec
+UPDATE reviews
+SET
+    helpful_count = helpful_count + $1::int,
+    unhelpful_count = unhelpful_count + $2::int
+WHERE id = $3 AND tenant_id = $4
+`
+
+type AdjustReviewVoteCountsParams struct {
+	HelpfulDelta   int32 `json:"helpfulDelta"`
+	UnhelpfulDelta int32 `json:"unhelpfulDelta"`
+	ID             int64 `json:"id"`
+	TenantID       int64 `json:"tenantId"`
+}
+
+func (q *Queries) AdjustReviewVoteCounts(ctx context.Context, arg AdjustReviewVoteCountsParams) error {
+	_, err := q.db.Exec(ctx, adjustReviewVoteCounts,
+		arg.HelpfulDelta,
+		arg.UnhelpfulDelta,
+		arg.ID,
+		arg.TenantID,
+	)
+	return err
+}
+
+const deleteReviewVote = `-- name: DeleteReviewVote :one
+DELETE FROM review_votes
+WHERE review_id = $1 AND user_id = $2 AND tenant_id = $3
+RETURNING review_id, user_id, helpful, created_at, updated_at, tenant_id
+`
+
+type DeleteReviewVoteParams struct {
+	ReviewID int64 `json:"reviewId"`
+	UserID   int64 `json:"userId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteReviewVote(ctx context.Context, arg DeleteReviewVoteParams) (ReviewVote, error) {
+	row := q.db.QueryRow(ctx, deleteReviewVote, arg.ReviewID, arg.UserID, arg.TenantID)
+	var i ReviewVote
+	err := row.Scan(
+		&i.ReviewID,
+		&i.UserID,
+		&i.Helpful,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const getReviewVote = `-- name: GetReviewVote :one
+SELECT review_id, user_id, helpful, created_at, updated_at, tenant_id FROM review_votes
+WHERE review_id = $1 AND user_id = $2 AND tenant_id = $3
+`
+
+type GetReviewVoteParams struct {
+	ReviewID int64 `json:"reviewId"`
+	UserID   int64 `json:"userId"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetReviewVote(ctx context.Context, arg GetReviewVoteParams) (ReviewVote, error) {
+	row := q.db.QueryRow(ctx, getReviewVote, arg.ReviewID, arg.UserID, arg.TenantID)
+	var i ReviewVote
+	err := row.Scan(
+		&i.ReviewID,
+		&i.UserID,
+		&i.Helpful,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const lockReview = `-- name: LockReview :one
+SELECT id FROM reviews
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type LockReviewParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) LockReview(ctx context.Context, arg LockReviewParams) (int64, error) {
+	row := q.db.QueryRow(ctx, lockReview, arg.ID, arg.TenantID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const upsertReviewVote = `-- name: UpsertReviewVote :one
+INSERT INTO review_votes (
+    review_id,
+    user_id,
+    helpful,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (review_id, user_id) DO UPDATE
+SET
+    helpful = EXCLUDED.helpful,
+    updated_at = NOW()
+WHERE review_votes.tenant_id = EXCLUDED.tenant_id
+RETURNING review_id, user_id, helpful, created_at, updated_at, tenant_id
+`
+
+type UpsertReviewVoteParams struct {
+	ReviewID int64 `json:"reviewId"`
+	UserID   int64 `json:"userId"`
+	Helpful  bool  `json:"helpful"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) UpsertReviewVote(ctx context.Context, arg UpsertReviewVoteParams) (ReviewVote, error) {
+	row := q.db.QueryRow(ctx, upsertReviewVote,
+		arg.ReviewID,
+		arg.UserID,
+		arg.Helpful,
+		arg.TenantID,
+	)
+	var i ReviewVote
+	err := row.Scan(
+		&i.ReviewID,
+		&i.UserID,
+		&i.Helpful,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: reviewer.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const getReviewerStats = `-- name: GetReviewerStats :one
+SELECT tenant_id, user_id, review_count, approved_count, rejected_count, verified_count, helpful_votes, unhelpful_votes, updated_at, reputation FROM reviewer_stats
+WHERE tenant_id = $1 AND user_id = $2
+`
+
+type GetReviewerStatsParams struct {
+	TenantID int64 `json:"tenantId"`
+	UserID   int64 `json:"userId"`
+}
+
+func (q *Queries) GetReviewerStats(ctx context.Context, arg GetReviewerStatsParams) (ReviewerStat, error) {
+	row := q.db.QueryRow(ctx, getReviewerStats, arg.TenantID, arg.UserID)
+	var i ReviewerStat
+	err := row.Scan(
+		&i.TenantID,
+		&i.UserID,
+		&i.ReviewCount,
+		&i.ApprovedCount,
+		&i.RejectedCount,
+		&i.VerifiedCount,
+		&i.HelpfulVotes,
+		&i.UnhelpfulVotes,
+		&i.UpdatedAt,
+		&i.Reputation,
+	)
+	return i, err
+}
@@ -0,0 +1,87 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: tenant.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const getTenantByHostname = `-- name: GetTenantByHostname :one
+SELECT tenants.id, tenants.slug, tenants.name, tenants.created_at, tenants.rating_scale_min, tenants.rating_scale_max, tenants.rating_scale_step, tenants.review_cooling_off_hours FROM tenants
+JOIN tenant_domains ON tenant_domains.tenant_id = tenants.id
+WHERE tenant_domains.hostname = $1
+`
+
+func (q *Queries) GetTenantByHostname(ctx context.Context, hostname string) (Tenant, error) {
+	row := q.db.QueryRow(ctx, getTenantByHostname, hostname)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.Slug,
+		&i.Name,
+		&i.CreatedAt,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+		&i.ReviewCoolingOffHours,
+	)
+	return i, err
+}
+
+const getTenantBySlug = `-- name: GetTenantBySlug :one
+SELECT id, slug, name, created_at, rating_scale_min, rating_scale_max, rating_scale_step, review_cooling_off_hours FROM tenants
+WHERE slug = $1
+`
+
+func (q *Queries) GetTenantBySlug(ctx context.Context, slug string) (Tenant, error) {
+	row := q.db.QueryRow(ctx, getTenantBySlug, slug)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.Slug,
+		&i.Name,
+		&i.CreatedAt,
+		&i.RatingScaleMin,
+		&i.RatingScaleMax,
+		&i.RatingScaleStep,
+		&i.ReviewCoolingOffHours,
+	)
+	return i, err
+}
+
+const listTenants = `-- name: ListTenants :many
+SELECT id, slug, name, created_at, rating_scale_min, rating_scale_max, rating_scale_step, review_cooling_off_hours FROM tenants
+ORDER BY id
+`
+
+func (q *Queries) ListTenants(ctx context.Context) ([]Tenant, error) {
+	rows, err := q.db.Query(ctx, listTenants)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Tenant{}
+	for rows.Next() {
+		var i Tenant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Name,
+			&i.CreatedAt,
+			&i.RatingScaleMin,
+			&i.RatingScaleMax,
+			&i.RatingScaleStep,
+			&i.ReviewCoolingOffHours,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,512 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhook.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = NOW() + make_interval(secs => $1::int)
+WHERE webhook_deliveries.id IN (
+    SELECT due.id FROM webhook_deliveries due
+    JOIN webhook_subscriptions subscription ON subscription.id = due.subscription_id
+    WHERE due.tenant_id = $2
+    AND due.status = 'pending'
+    AND due.next_attempt_at <= NOW()
+    AND subscription.active
+    ORDER BY due.next_attempt_at
+    LIMIT $3
+    FOR UPDATE OF due SKIP LOCKED
+)
+RETURNING id, tenant_id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	LeaseSeconds int32 `json:"leaseSeconds"`
+	TenantID     int64 `json:"tenantId"`
+	Limit        int32 `json:"limit"`
+}
+
+// Leases due deliveries of active subscriptions by pushing their next attempt
+// past the lease, so that concurrent dispatchers skip them while they are sent.
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, claimWebhookDeliveries, arg.LeaseSeconds, arg.TenantID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastAttemptAt,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_id,
+    event_type,
+    payload,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, tenant_id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	SubscriptionID int64  `json:"subscriptionId"`
+	EventID        int64  `json:"eventId"`
+	EventType      string `json:"eventType"`
+	Payload        []byte `json:"payload"`
+	TenantID       int64  `json:"tenantId"`
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.TenantID,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastAttemptAt,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    url,
+    secret,
+    event_types,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, tenant_id, url, secret, event_types, active, consecutive_failures, disabled_at, created_at, updated_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	Url        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"eventTypes"`
+	TenantID   int64    `json:"tenantId"`
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.TenantID,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND tenant_id = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, tenant_id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at FROM webhook_deliveries
+WHERE id = $1 AND subscription_id = $2 AND tenant_id = $3
+`
+
+type GetWebhookDeliveryParams struct {
+	ID             int64 `json:"id"`
+	SubscriptionID int64 `json:"subscriptionId"`
+	TenantID       int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, getWebhookDelivery, arg.ID, arg.SubscriptionID, arg.TenantID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastAttemptAt,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWebhookSubscription = `-- name: GetWebhookSubscription :one
+SELECT id, tenant_id, url, secret, event_types, active, consecutive_failures, disabled_at, created_at, updated_at FROM webhook_subscriptions
+WHERE id = $1 AND tenant_id = $2
+`
+
+type GetWebhookSubscriptionParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscription, arg.ID, arg.TenantID)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listActiveWebhookSubscriptions = `-- name: ListActiveWebhookSubscriptions :many
+SELECT id, tenant_id, url, secret, event_types, active, consecutive_failures, disabled_at, created_at, updated_at FROM webhook_subscriptions
+WHERE tenant_id = $1 AND active
+ORDER BY id
+`
+
+func (q *Queries) ListActiveWebhookSubscriptions(ctx context.Context, tenantID int64) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listActiveWebhookSubscriptions, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookSubscription{}
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Active,
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, tenant_id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at FROM webhook_deliveries
+WHERE subscription_id = $1
+AND tenant_id = $2
+AND ($3::text IS NULL OR status = $3)
+ORDER BY id DESC
+LIMIT $5
+OFFSET $4
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID int64       `json:"subscriptionId"`
+	TenantID       int64       `json:"tenantId"`
+	Status         pgtype.Text `json:"status"`
+	Offset         int32       `json:"offset"`
+	Limit          int32       `json:"limit"`
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.TenantID,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastAttemptAt,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, tenant_id, url, secret, event_types, active, consecutive_failures, disabled_at, created_at, updated_at FROM webhook_subscriptions
+WHERE tenant_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context, tenantID int64) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptions, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookSubscription{}
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Active,
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordWebhookDeliveryAttempt = `-- name: RecordWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET
+    status = $1,
+    attempts = attempts + 1,
+    last_attempt_at = NOW(),
+    response_status = $2,
+    last_error = $3,
+    next_attempt_at = $4,
+    delivered_at = COALESCE(delivered_at, CASE WHEN $1 = 'succeeded' THEN NOW() END)
+WHERE id = $5 AND tenant_id = $6
+`
+
+type RecordWebhookDeliveryAttemptParams struct {
+	Status         string             `json:"status"`
+	ResponseStatus pgtype.Int4        `json:"responseStatus"`
+	LastError      pgtype.Text        `json:"lastError"`
+	NextAttemptAt  pgtype.Timestamptz `json:"nextAttemptAt"`
+	ID             int64              `json:"id"`
+	TenantID       int64              `json:"tenantId"`
+}
+
+func (q *Queries) RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) error {
+	_, err := q.db.Exec(ctx, recordWebhookDeliveryAttempt,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+		arg.TenantID,
+	)
+	return err
+}
+
+const recordWebhookSubscriptionFailure = `-- name: RecordWebhookSubscriptionFailure :one
+UPDATE webhook_subscriptions
+SET
+    consecutive_failures = consecutive_failures + 1,
+    active = active AND consecutive_failures + 1 < $1::int,
+    disabled_at = CASE
+        WHEN active AND consecutive_failures + 1 >= $1::int THEN NOW()
+        ELSE disabled_at
+    END,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3
+RETURNING id, tenant_id, url, secret, event_types, active, consecutive_failures, disabled_at, created_at, updated_at
+`
+
+type RecordWebhookSubscriptionFailureParams struct {
+	DisableAfter int32 `json:"disableAfter"`
+	ID           int64 `json:"id"`
+	TenantID     int64 `json:"tenantId"`
+}
+
+// Counts a failed attempt and disables the subscription once disable_after
+// attempts in a row have failed.
+func (q *Queries) RecordWebhookSubscriptionFailure(ctx context.Context, arg RecordWebhookSubscriptionFailureParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, recordWebhookSubscriptionFailure, arg.DisableAfter, arg.ID, arg.TenantID)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const recordWebhookSubscriptionSuccess = `-- name: RecordWebhookSubscriptionSuccess :exec
+UPDATE webhook_subscriptions
+SET consecutive_failures = 0
+WHERE id = $1 AND tenant_id = $2 AND consecutive_failures <> 0
+`
+
+type RecordWebhookSubscriptionSuccessParams struct {
+	ID       int64 `json:"id"`
+	TenantID int64 `json:"tenantId"`
+}
+
+func (q *Queries) RecordWebhookSubscriptionSuccess(ctx context.Context, arg RecordWebhookSubscriptionSuccessParams) error {
+	_, err := q.db.Exec(ctx, recordWebhookSubscriptionSuccess, arg.ID, arg.TenantID)
+	return err
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET
+    url = $1,
+    event_types = $2,
+    active = $3,
+    consecutive_failures = $4,
+    disabled_at = $5,
+    updated_at = NOW()
+WHERE id = $6 AND tenant_id = $7
+RETURNING id, tenant_id, url, secret, event_types, active, consecutive_failures, disabled_at, created_at, updated_at
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	Url                 string             `json:"url"`
+	EventTypes          []string           `json:"eventTypes"`
+	Active              bool               `json:"active"`
+	ConsecutiveFailures int32              `json:"consecutiveFailures"`
+	DisabledAt          pgtype.Timestamptz `json:"disabledAt"`
+	ID                  int64              `json:"id"`
+	TenantID            int64              `json:"tenantId"`
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, updateWebhookSubscription,
+		arg.Url,
+		arg.EventTypes,
+		arg.Active,
+		arg.ConsecutiveFailures,
+		arg.DisabledAt,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TenantRepositoryImpl reads the tenant registry, which is the one set of
+// tables that is not itself scoped by tenant.
+type TenantRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewTenantRepositoryImpl(db *pgxpool.Pool) repository.TenantRepository {
+	return &TenantRepositoryImpl{db: db}
+}
+
+func (r *TenantRepositoryImpl) List(ctx context.Context) ([]*entity.Tenant, error) {
+	tenants, err := queriesFor(ctx, r.db).ListTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*entity.Tenant
+	for _, tenant := range tenants {
+		result = append(result, toTenantEntity(tenant))
+	}
+	return result, nil
+}
+
+func (r *TenantRepositoryImpl) GetBySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
+	tenant, err := queriesFor(ctx, r.db).GetTenantBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrTenantNotFound
+		}
+		return nil, err
+	}
+
+	return toTenantEntity(tenant), nil
+}
+
+func (r *TenantRepositoryImpl) GetByHostname(ctx context.Context, hostname string) (*entity.Tenant, error) {
+	tenant, err := queriesFor(ctx, r.db).GetTenantByHostname(ctx, hostname)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrTenantNotFound
+		}
+		return nil, err
+	}
+
+	return toTenantEntity(tenant), nil
+}
+
+func toTenantEntity(tenant sqlc.Tenant) *entity.Tenant {
+	return &entity.Tenant{
+		ID:        tenant.ID,
+		Slug:      tenant.Slug,
+		Name:      tenant.Name,
+		CreatedAt: tenant.CreatedAt.Time,
+		RatingScale: ratingScalePtr(
+			tenant.RatingScaleMin, tenant.RatingScaleMax, tenant.RatingScaleStep,
+		),
+		ReviewCoolingOff: time.Duration(tenant.ReviewCoolingOffHours) * time.Hour,
+	}
+}
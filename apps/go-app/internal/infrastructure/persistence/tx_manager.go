@@ -0,0 +1,66 @@
+package persistence
+
+import (
+	"context"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type txKey struct{}
+
+type TxManagerImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewTxManagerImpl(db *pgxpool.Pool) repository.TxManager {
+	return &TxManagerImpl{db: db}
+}
+
+// WithinTransaction runs fn inside a transaction carried on its context. Nested
+// calls join the outermost transaction.
+func (m *TxManagerImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := txFor(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// queriesFor returns queries bound to the transaction on ctx, falling back to the pool.
+func queriesFor(ctx context.Context, db *pgxpool.Pool) *sqlc.Queries {
+	if tx, ok := txFor(ctx); ok {
+		return sqlc.New(tx)
+	}
+	return sqlc.New(db)
+}
+
+// tenantID returns the tenant ctx is scoped to. Every query is filtered by it,
+// so repositories refuse to run without one.
+func tenantID(ctx context.Context) (int64, error) {
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return 0, domainerrors.ErrTenantRequired
+	}
+	return tenant.ID, nil
+}
+
+// txFor returns the transaction carried on ctx, if any.
+func txFor(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"context"
+	"time"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type VelocityCounterRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewVelocityCounterRepositoryImpl(db *pgxpool.Pool) repository.VelocityCounterRepository {
+	return &VelocityCounterRepositoryImpl{db: db}
+}
+
+func (r *VelocityCounterRepositoryImpl) Hit(ctx context.Context, scope, subject string, windowStart, previousWindowStart time.Time) (int64, int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	counts, err := queriesFor(ctx, r.db).HitVelocityCounter(ctx, sqlc.HitVelocityCounterParams{
+		TenantID:            tenantID,
+		Scope:               scope,
+		Subject:             subject,
+		WindowStart:         pgtype.Timestamptz{Time: windowStart, Valid: true},
+		PreviousWindowStart: pgtype.Timestamptz{Time: previousWindowStart, Valid: true},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(counts.CurrentCount), int64(counts.PreviousCount), nil
+}
+
+func (r *VelocityCounterRepositoryImpl) Prune(ctx context.Context, before time.Time) (int64, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return queriesFor(ctx, r.db).PruneVelocityCounters(ctx, sqlc.PruneVelocityCountersParams{
+		TenantID: tenantID,
+		Before:   pgtype.Timestamptz{Time: before, Valid: true},
+	})
+}
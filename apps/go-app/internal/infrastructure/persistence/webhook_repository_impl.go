@@ -0,0 +1,321 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-review-ingest/internal/domain/entity"
+	domainerrors "user-review-ingest/internal/domain/errors"
+	"user-review-ingest/internal/domain/repository"
+	"user-review-ingest/internal/infrastructure/persistence/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WebhookSubscriptionRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookSubscriptionRepositoryImpl(db *pgxpool.Pool) repository.WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepositoryImpl{db: db}
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateWebhookSubscription(ctx, sqlc.CreateWebhookSubscriptionParams{
+		Url:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventTypes: subscription.EventTypes,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*subscription = *toWebhookSubscriptionEntity(created)
+	return nil
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) GetByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := queriesFor(ctx, r.db).GetWebhookSubscription(ctx, sqlc.GetWebhookSubscriptionParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return toWebhookSubscriptionEntity(subscription), nil
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := queriesFor(ctx, r.db).ListWebhookSubscriptions(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscriptionEntities(subscriptions), nil
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) ListActive(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := queriesFor(ctx, r.db).ListActiveWebhookSubscriptions(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscriptionEntities(subscriptions), nil
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := queriesFor(ctx, r.db).UpdateWebhookSubscription(ctx, sqlc.UpdateWebhookSubscriptionParams{
+		Url:                 subscription.URL,
+		EventTypes:          subscription.EventTypes,
+		Active:              subscription.Active,
+		ConsecutiveFailures: int32(subscription.ConsecutiveFailures),
+		DisabledAt:          optionalTimestamptz(subscription.DisabledAt),
+		ID:                  subscription.ID,
+		TenantID:            tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerrors.ErrWebhookNotFound
+		}
+		return err
+	}
+
+	*subscription = *toWebhookSubscriptionEntity(updated)
+	return nil
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := queriesFor(ctx, r.db).DeleteWebhookSubscription(ctx, sqlc.DeleteWebhookSubscriptionParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domainerrors.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) RecordSuccess(ctx context.Context, id int64) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).RecordWebhookSubscriptionSuccess(ctx, sqlc.RecordWebhookSubscriptionSuccessParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) RecordFailure(ctx context.Context, id int64, disableAfter int) (*entity.WebhookSubscription, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := queriesFor(ctx, r.db).RecordWebhookSubscriptionFailure(ctx, sqlc.RecordWebhookSubscriptionFailureParams{
+		DisableAfter: int32(disableAfter),
+		ID:           id,
+		TenantID:     tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return toWebhookSubscriptionEntity(subscription), nil
+}
+
+func toWebhookSubscriptionEntities(subscriptions []sqlc.WebhookSubscription) []*entity.WebhookSubscription {
+	var result []*entity.WebhookSubscription
+	for _, subscription := range subscriptions {
+		result = append(result, toWebhookSubscriptionEntity(subscription))
+	}
+	return result
+}
+
+func toWebhookSubscriptionEntity(subscription sqlc.WebhookSubscription) *entity.WebhookSubscription {
+	return &entity.WebhookSubscription{
+		ID:                  subscription.ID,
+		URL:                 subscription.Url,
+		Secret:              subscription.Secret,
+		EventTypes:          subscription.EventTypes,
+		Active:              subscription.Active,
+		ConsecutiveFailures: int(subscription.ConsecutiveFailures),
+		DisabledAt:          timestamptzPtr(subscription.DisabledAt),
+		CreatedAt:           subscription.CreatedAt.Time,
+		UpdatedAt:           subscription.UpdatedAt.Time,
+	}
+}
+
+type WebhookDeliveryRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookDeliveryRepositoryImpl(db *pgxpool.Pool) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryImpl{db: db}
+}
+
+func (r *WebhookDeliveryRepositoryImpl) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := queriesFor(ctx, r.db).CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		TenantID:       tenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	*delivery = *toWebhookDeliveryEntity(created)
+	return nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) GetByID(ctx context.Context, subscriptionID, id int64) (*entity.WebhookDelivery, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := queriesFor(ctx, r.db).GetWebhookDelivery(ctx, sqlc.GetWebhookDeliveryParams{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		TenantID:       tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerrors.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return toWebhookDeliveryEntity(delivery), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) List(ctx context.Context, subscriptionID int64, opts repository.WebhookDeliveryListOptions) ([]*entity.WebhookDelivery, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := queriesFor(ctx, r.db).ListWebhookDeliveries(ctx, sqlc.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		TenantID:       tenantID,
+		Status:         optionalText(opts.Status),
+		Offset:         int32(opts.Offset),
+		Limit:          int32(opts.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDeliveryEntities(deliveries), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*entity.WebhookDelivery, error) {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := queriesFor(ctx, r.db).ClaimWebhookDeliveries(ctx, sqlc.ClaimWebhookDeliveriesParams{
+		LeaseSeconds: int32(lease / time.Second),
+		TenantID:     tenantID,
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDeliveryEntities(deliveries), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) RecordAttempt(ctx context.Context, id int64, attempt repository.WebhookAttempt) error {
+	tenantID, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return queriesFor(ctx, r.db).RecordWebhookDeliveryAttempt(ctx, sqlc.RecordWebhookDeliveryAttemptParams{
+		Status:         attempt.Status,
+		ResponseStatus: optionalInt4(attempt.ResponseStatus),
+		LastError:      optionalText(attempt.LastError),
+		NextAttemptAt:  pgtype.Timestamptz{Time: attempt.NextAttemptAt, Valid: true},
+		ID:             id,
+		TenantID:       tenantID,
+	})
+}
+
+func toWebhookDeliveryEntities(deliveries []sqlc.WebhookDelivery) []*entity.WebhookDelivery {
+	var result []*entity.WebhookDelivery
+	for _, delivery := range deliveries {
+		result = append(result, toWebhookDeliveryEntity(delivery))
+	}
+	return result
+}
+
+func toWebhookDeliveryEntity(delivery sqlc.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       int(delivery.Attempts),
+		NextAttemptAt:  delivery.NextAttemptAt.Time,
+		LastAttemptAt:  timestamptzPtr(delivery.LastAttemptAt),
+		ResponseStatus: int4Ptr(delivery.ResponseStatus),
+		LastError:      textPtr(delivery.LastError),
+		DeliveredAt:    timestamptzPtr(delivery.DeliveredAt),
+		CreatedAt:      delivery.CreatedAt.Time,
+	}
+}
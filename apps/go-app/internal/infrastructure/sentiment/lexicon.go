@@ -0,0 +1,141 @@
+package sentiment
+
+import (
+	"bufio"
+	_ "embed"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"user-review-ingest/internal/domain/valueobject"
+)
+
+//go:embed lexicon.tsv
+var lexiconData string
+
+const (
+	// negationWindow is how many words before a sentiment word a negation
+	// reaches: "not really that good".
+	negationWindow = 3
+	// negationScale flips a negated word and weakens it, as "not good" is
+	// milder than "bad".
+	negationScale = -0.74
+	boosterScale  = 1.3
+	dampenerScale = 0.7
+	// Clauses after a contrast ("nice, but broke in a week") carry the
+	// reviewer's conclusion and outweigh those before it.
+	beforeContrastScale = 0.5
+	afterContrastScale  = 1.5
+	// normalizationAlpha sets how quickly the summed weights approach ±1.
+	normalizationAlpha = 15
+)
+
+var negations = map[string]bool{
+	"not": true, "no": true, "never": true, "none": true, "nothing": true,
+	"nobody": true, "neither": true, "nor": true, "without": true,
+	"hardly": true, "cannot": true, "cant": true, "dont": true,
+	"doesnt": true, "didnt": true, "isnt": true, "wasnt": true,
+	"arent": true, "werent": true, "wont": true, "wouldnt": true,
+	"shouldnt": true, "couldnt": true, "aint": true,
+}
+
+var boosters = map[string]bool{
+	"very": true, "really": true, "extremely": true, "so": true,
+	"super": true, "incredibly": true, "totally": true, "absolutely": true,
+	"completely": true, "truly": true, "highly": true, "utterly": true,
+}
+
+var dampeners = map[string]bool{
+	"slightly": true, "somewhat": true, "barely": true, "kinda": true,
+	"fairly": true, "mostly": true, "partly": true, "almost": true,
+}
+
+var contrasts = map[string]bool{
+	"but": true, "however": true, "although": true, "though": true, "yet": true,
+}
+
+// LexiconAnalyzer scores text with a weighted word list, adjusting words
+// for negation, intensifiers and contrast, in the manner of VADER. It needs
+// no model or network access but only reads English.
+type LexiconAnalyzer struct {
+	weights map[string]float64
+}
+
+func NewLexiconAnalyzer() *LexiconAnalyzer {
+	weights := make(map[string]float64)
+	scanner := bufio.NewScanner(strings.NewReader(lexiconData))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		word, weight, ok := strings.Cut(line, "\t")
+		if !ok {
+			panic("sentiment: malformed lexicon line " + strconv.Quote(line))
+		}
+		value, err := strconv.ParseFloat(weight, 64)
+		if err != nil {
+			panic("sentiment: malformed lexicon weight " + strconv.Quote(line))
+		}
+		weights[word] = value
+	}
+	return &LexiconAnalyzer{weights: weights}
+}
+
+func (a *LexiconAnalyzer) Analyze(text string) (valueobject.Sentiment, bool) {
+	words := tokenize(text)
+
+	contrastAt := -1
+	for i, word := range words {
+		if contrasts[word] {
+			contrastAt = i
+		}
+	}
+
+	var sum float64
+	var found bool
+	for i, word := range words {
+		weight, ok := a.weights[word]
+		if !ok {
+			continue
+		}
+		found = true
+
+		if i > 0 {
+			switch {
+			case boosters[words[i-1]]:
+				weight *= boosterScale
+			case dampeners[words[i-1]]:
+				weight *= dampenerScale
+			}
+		}
+		for j := i - 1; j >= 0 && j >= i-negationWindow; j-- {
+			if negations[words[j]] {
+				weight *= negationScale
+				break
+			}
+		}
+		if contrastAt >= 0 {
+			if i < contrastAt {
+				weight *= beforeContrastScale
+			} else {
+				weight *= afterContrastScale
+			}
+		}
+		sum += weight
+	}
+	if !found {
+		return valueobject.Sentiment{}, false
+	}
+
+	return valueobject.NewSentiment(sum / math.Sqrt(sum*sum+normalizationAlpha)), true
+}
+
+// tokenize lowercases text and splits it into words, dropping apostrophes so
+// that "don't" and "dont" read the same.
+func tokenize(text string) []string {
+	text = strings.NewReplacer("'", "", "’", "").Replace(strings.ToLower(text))
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-'
+	})
+}
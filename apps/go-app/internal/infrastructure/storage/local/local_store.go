@@ -0,0 +1,103 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"user-review-ingest/internal/infrastructure/storage"
+)
+
+// Store keeps blobs as files below a root directory. Objects are expected to
+// be served to clients from publicBaseURL, e.g. by a static file route.
+type Store struct {
+	root          string
+	publicBaseURL string
+}
+
+func NewStore(root, publicBaseURL string) (*Store, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &Store{
+		root:          root,
+		publicBaseURL: strings.TrimRight(publicBaseURL, "/"),
+	}, nil
+}
+
+// Root returns the directory blobs are stored under.
+func (s *Store) Root() string {
+	return s.root
+}
+
+func (s *Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never observe a partial object.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("short write for %s: wrote %d of %d bytes", key, written, size)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrBlobNotFound
+	}
+	return f, err
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *Store) URL(key string) string {
+	return s.publicBaseURL + "/" + key
+}
+
+// path maps a key to a file below root, refusing keys that would escape it.
+func (s *Store) path(key string) (string, error) {
+	if !fs.ValidPath(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,218 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"user-review-ingest/internal/infrastructure/storage"
+)
+
+// fakeS3 is a path-style bucket that keeps objects in memory and rejects
+// requests whose signature does not match the one it computes itself.
+type fakeS3 struct {
+	store  *Store
+	mu     sync.Mutex
+	bucket string
+	object map[string][]byte
+	types  map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !f.validSignature(r) {
+		http.Error(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>", http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.object[key] = body
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := f.object[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	case http.MethodDelete:
+		if _, ok := f.object[key]; !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		delete(f.object, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) validSignature(r *http.Request) bool {
+	now, err := time.Parse(amzDateFormat, r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+	// Server-side requests carry the path in RequestURI only; rebuild the
+	// URL the client signed.
+	signed := r.Clone(context.Background())
+	signed.URL.Scheme = "http"
+	signed.URL.Host = r.Host
+	f.store.sign(signed, now)
+	return r.Header.Get("Authorization") == signed.Header.Get("Authorization")
+}
+
+func newTestStore(t *testing.T, cfg Config) (*Store, *fakeS3) {
+	t.Helper()
+	fake := &fakeS3{bucket: "media", object: make(map[string][]byte), types: make(map[string]string)}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg.Endpoint = server.URL
+	cfg.Bucket = fake.bucket
+	cfg.UsePathStyle = true
+	store, err := NewStore(cfg, server.Client())
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	// The server verifies with the real credentials even if the client is
+	// configured with others.
+	verifier := *store
+	verifier.cfg.SecretAccessKey = "secret"
+	fake.store = &verifier
+	return store, fake
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	store, fake := newTestStore(t, Config{Region: "eu-west-1", AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	ctx := context.Background()
+	const key = "reviews/42/photo 1.jpg"
+	body := "jpeg bytes"
+
+	if err := store.Put(ctx, key, strings.NewReader(body), int64(len(body)), "image/jpeg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if got := fake.types[key]; got != "image/jpeg" {
+		t.Errorf("stored content type = %q, want image/jpeg", got)
+	}
+
+	rc, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	got, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(got) != body {
+		t.Errorf("Get() = %q, want %q", got, body)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(ctx, key); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Errorf("Get() after Delete error = %v, want %v", err, storage.ErrBlobNotFound)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() of a missing object error = %v, want nil", err)
+	}
+}
+
+func TestStoreErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		call    func(ctx context.Context, s *Store) error
+		wantErr error
+	}{
+		{
+			name:   "wrong secret",
+			secret: "other",
+			call: func(ctx context.Context, s *Store) error {
+				return s.Put(ctx, "a.txt", strings.NewReader("a"), 1, "text/plain")
+			},
+		},
+		{
+			name:   "unknown size",
+			secret: "secret",
+			call: func(ctx context.Context, s *Store) error {
+				return s.Put(ctx, "a.txt", strings.NewReader("a"), -1, "text/plain")
+			},
+		},
+		{
+			name:   "missing object",
+			secret: "secret",
+			call: func(ctx context.Context, s *Store) error {
+				_, err := s.Get(ctx, "missing.txt")
+				return err
+			},
+			wantErr: storage.ErrBlobNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := newTestStore(t, Config{Region: "eu-west-1", AccessKeyID: "AKID", SecretAccessKey: tt.secret})
+			err := tt.call(context.Background(), store)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStoreURL(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		key  string
+		want string
+	}{
+		{
+			name: "path style",
+			cfg:  Config{Endpoint: "http://localhost:9000", Bucket: "media", UsePathStyle: true},
+			key:  "reviews/1/a b.jpg",
+			want: "http://localhost:9000/media/reviews/1/a%20b.jpg",
+		},
+		{
+			name: "virtual hosted",
+			cfg:  Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Bucket: "media"},
+			key:  "reviews/1/a.jpg",
+			want: "https://media.s3.eu-west-1.amazonaws.com/reviews/1/a.jpg",
+		},
+		{
+			name: "public base url",
+			cfg:  Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Bucket: "media", PublicBaseURL: "https://cdn.example.com/"},
+			key:  "reviews/1/ä.jpg",
+			want: "https://cdn.example.com/reviews/1/%C3%A4.jpg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewStore(tt.cfg, nil)
+			if err != nil {
+				t.Fatalf("NewStore() error = %v", err)
+			}
+			if got := store.URL(tt.key); got != tt.want {
+				t.Errorf("URL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Headers sent with every webhook request. The signature is the HMAC-SHA256,
+// keyed with the subscription secret, of "<timestamp>.<body>", formatted as
+// "sha256=<hex>". Receivers should recompute it and reject stale timestamps to
+// defeat replays.
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// maxResponseBytes bounds how much of a response body is read before the
+// connection is reused; its content is not used.
+const maxResponseBytes = 64 << 10
+
+// ErrForbiddenAddress is returned when a webhook URL resolves to a loopback,
+// private or otherwise internal address while those are not allowed.
+var ErrForbiddenAddress = errors.New("webhook URL resolves to a non-public address")
+
+// Request is a single webhook call.
+type Request struct {
+	URL        string
+	Secret     string
+	EventType  string
+	DeliveryID int64
+	Body       []byte
+}
+
+// Sender calls webhook endpoints. It returns the response status code when a
+// response was received, and an error unless the status was 2xx.
+type Sender interface {
+	Send(ctx context.Context, req Request) (int, error)
+}
+
+// HTTPSender signs and POSTs webhook requests.
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewHTTPSender(client *http.Client) *HTTPSender {
+	if client == nil {
+		client = NewHTTPClient(10*time.Second, false)
+	}
+	return &HTTPSender{client: client, now: time.Now}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := s.now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "user-review-ingest-webhooks/1.0")
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, strconv.FormatInt(req.DeliveryID, 10))
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, timestamp, req.Body))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is valid for body sent at timestamp, for
+// receivers written in Go and for tests.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// NewHTTPClient returns a client for calling webhook endpoints. Unless
+// allowPrivateNetworks is set, connections to loopback, private, link-local
+// and unspecified addresses are refused, so that tenants cannot point webhooks
+// at internal services. The check runs on the resolved address at dial time,
+// which also covers redirects and DNS rebinding.
+func NewHTTPClient(timeout time.Duration, allowPrivateNetworks bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublic(ip) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		}
+		// A proxy would be dialled instead of the endpoint and defeat the address check
+		transport.Proxy = nil
+	}
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func isPublic(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
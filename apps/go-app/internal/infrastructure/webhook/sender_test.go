@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	const secret = "s3cret"
+	const timestamp = int64(1700000000)
+	body := []byte(`{"id":1,"type":"review.created"}`)
+	signature := Sign(secret, timestamp, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, timestamp: timestamp, body: body, signature: signature, want: true},
+		{name: "tampered body", secret: secret, timestamp: timestamp, body: []byte(`{"id":2,"type":"review.created"}`), signature: signature},
+		{name: "wrong secret", secret: "other", timestamp: timestamp, body: body, signature: signature},
+		{name: "replayed with another timestamp", secret: secret, timestamp: timestamp + 1, body: body, signature: signature},
+		{name: "missing prefix", secret: secret, timestamp: timestamp, body: body, signature: signature[len("sha256="):]},
+		{name: "empty signature", secret: secret, timestamp: timestamp, body: body},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, tt.body, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPSenderSend(t *testing.T) {
+	const secret = "s3cret"
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+		wantErr    bool
+	}{
+		{name: "ok", status: http.StatusOK, wantStatus: http.StatusOK},
+		{name: "no content", status: http.StatusNoContent, wantStatus: http.StatusNoContent},
+		{name: "not modified", status: http.StatusNotModified, wantStatus: http.StatusNotModified, wantErr: true},
+		{name: "client error", status: http.StatusGone, wantStatus: http.StatusGone, wantErr: true},
+		{name: "server error", status: http.StatusServiceUnavailable, wantStatus: http.StatusServiceUnavailable, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := []byte(`{"id":7,"type":"review.updated"}`)
+			var verified bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, _ := io.ReadAll(r.Body)
+				timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+				verified = r.Method == http.MethodPost &&
+					r.Header.Get("Content-Type") == "application/json" &&
+					r.Header.Get(EventHeader) == "review.updated" &&
+					r.Header.Get(DeliveryHeader) == "42" &&
+					timestamp == now.Unix() &&
+					Verify(secret, timestamp, received, r.Header.Get(SignatureHeader))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			sender := NewHTTPSender(server.Client())
+			sender.now = func() time.Time { return now }
+
+			status, err := sender.Send(context.Background(), Request{
+				URL:        server.URL,
+				Secret:     secret,
+				EventType:  "review.updated",
+				DeliveryID: 42,
+				Body:       body,
+			})
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want error: %v", err, tt.wantErr)
+			}
+			if !verified {
+				t.Errorf("endpoint could not verify the request's headers and signature")
+			}
+		})
+	}
+}
+
+func TestHTTPClientPrivateNetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name                 string
+		allowPrivateNetworks bool
+		wantErr              error
+	}{
+		{name: "refused by default", wantErr: ErrForbiddenAddress},
+		{name: "allowed when configured", allowPrivateNetworks: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := NewHTTPSender(NewHTTPClient(time.Second, tt.allowPrivateNetworks))
+			_, err := sender.Send(context.Background(), Request{URL: server.URL, Secret: "s3cret", Body: []byte(`{}`)})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
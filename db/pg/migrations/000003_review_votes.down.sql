@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS review_votes;
+
+DROP INDEX IF EXISTS reviews_helpful_score_idx;
+
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS helpful_score,
+    DROP COLUMN IF EXISTS unhelpful_count,
+    DROP COLUMN IF EXISTS helpful_count;
@@ -0,0 +1,33 @@
+ALTER TABLE reviews
+    ADD COLUMN helpful_count   INT NOT NULL DEFAULT 0,
+    ADD COLUMN unhelpful_count INT NOT NULL DEFAULT 0;
+
+-- Lower bound of the Wilson score interval (95% confidence) for the share of
+-- helpful votes, used to rank reviews by helpfulness.
+ALTER TABLE reviews
+    ADD COLUMN helpful_score DOUBLE PRECISION NOT NULL GENERATED ALWAYS AS (
+        CASE
+            WHEN helpful_count + unhelpful_count = 0 THEN 0
+            ELSE (
+                (helpful_count::float8 / (helpful_count + unhelpful_count))
+                + 1.9208 / (helpful_count + unhelpful_count)
+                - 1.96 * sqrt(
+                    (helpful_count::float8 * unhelpful_count) / (helpful_count + unhelpful_count)
+                    + 0.9604
+                ) / (helpful_count + unhelpful_count)
+            ) / (1 + 3.8416 / (helpful_count + unhelpful_count))
+        END
+    ) STORED;
+
+CREATE INDEX reviews_helpful_score_idx
+    ON reviews (helpful_score DESC, created_at DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE TABLE review_votes (
+    review_id  BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL,
+    helpful    BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (review_id, user_id)
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS audit_log;
+
+DROP TABLE IF EXISTS review_replies;
+
+DROP TABLE IF EXISTS product_owners;
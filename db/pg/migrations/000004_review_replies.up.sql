@@ -0,0 +1,36 @@
+-- Principals allowed to act on behalf of a product's brand.
+CREATE TABLE product_owners (
+    product_id BIGINT NOT NULL,
+    user_id    BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product_id, user_id)
+);
+
+CREATE TABLE review_replies (
+    id         BIGSERIAL PRIMARY KEY,
+    review_id  BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    author_id  BIGINT NOT NULL,
+    body       TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+-- A review carries at most one live public reply.
+CREATE UNIQUE INDEX review_replies_review_id_idx
+    ON review_replies (review_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TABLE audit_log (
+    id          BIGSERIAL PRIMARY KEY,
+    entity_type TEXT NOT NULL,
+    entity_id   BIGINT NOT NULL,
+    action      TEXT NOT NULL,
+    actor       TEXT NOT NULL,
+    before      JSONB,
+    after       JSONB,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_log_entity_idx
+    ON audit_log (entity_type, entity_id, created_at);
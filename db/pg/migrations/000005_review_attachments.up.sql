@@ -0,0 +1,16 @@
+CREATE TABLE review_attachments (
+    id             BIGSERIAL PRIMARY KEY,
+    review_id      BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    blob_key       TEXT NOT NULL,
+    thumbnail_key  TEXT NOT NULL,
+    content_type   TEXT NOT NULL,
+    size_bytes     BIGINT NOT NULL,
+    width          INT NOT NULL,
+    height         INT NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at     TIMESTAMPTZ
+);
+
+CREATE INDEX review_attachments_review_id_idx
+    ON review_attachments (review_id)
+    WHERE deleted_at IS NULL;
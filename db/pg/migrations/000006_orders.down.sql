@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS reviews_verified_purchase_idx;
+
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS order_id,
+    DROP COLUMN IF EXISTS verified_purchase;
+
+DROP TABLE IF EXISTS orders;
@@ -0,0 +1,21 @@
+CREATE TABLE orders (
+    id           BIGSERIAL PRIMARY KEY,
+    external_id  TEXT NOT NULL,
+    customer_id  BIGINT NOT NULL,
+    product_id   BIGINT NOT NULL,
+    purchased_at TIMESTAMPTZ NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (external_id, product_id)
+);
+
+CREATE INDEX orders_customer_product_idx
+    ON orders (customer_id, product_id, purchased_at DESC);
+
+ALTER TABLE reviews
+    ADD COLUMN verified_purchase BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN order_id          BIGINT REFERENCES orders (id) ON DELETE SET NULL;
+
+CREATE INDEX reviews_verified_purchase_idx
+    ON reviews (verified_purchase, created_at DESC)
+    WHERE deleted_at IS NULL;
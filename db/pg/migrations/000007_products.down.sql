@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS reviews_product_id_idx;
+
+ALTER TABLE product_owners
+    DROP CONSTRAINT IF EXISTS product_owners_product_id_fkey;
+
+ALTER TABLE reviews
+    DROP CONSTRAINT IF EXISTS reviews_product_id_fkey;
+
+DROP TABLE IF EXISTS products;
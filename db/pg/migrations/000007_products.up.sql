@@ -0,0 +1,44 @@
+-- Product catalog. Variants point at the primary product of their group
+-- through group_id so that they share reviews.
+CREATE TABLE products (
+    id          BIGSERIAL PRIMARY KEY,
+    sku         TEXT NOT NULL UNIQUE,
+    external_id TEXT UNIQUE,
+    name        TEXT NOT NULL,
+    group_id    BIGINT REFERENCES products (id) ON DELETE SET NULL,
+    archived_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX products_group_id_idx
+    ON products (group_id)
+    WHERE group_id IS NOT NULL;
+
+-- Register placeholder products for every ID already referenced so the
+-- foreign keys below can be validated; the catalog sync renames them.
+INSERT INTO products (id, sku, name)
+SELECT product_id, 'legacy-' || product_id, 'Product ' || product_id
+FROM (
+    SELECT product_id FROM reviews
+    UNION
+    SELECT product_id FROM product_owners
+) referenced;
+
+SELECT setval(
+    pg_get_serial_sequence('products', 'id'),
+    COALESCE((SELECT MAX(id) FROM products), 0) + 1,
+    false
+);
+
+ALTER TABLE reviews
+    ADD CONSTRAINT reviews_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products (id);
+
+ALTER TABLE product_owners
+    ADD CONSTRAINT product_owners_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE;
+
+CREATE INDEX reviews_product_id_idx
+    ON reviews (product_id, created_at DESC)
+    WHERE deleted_at IS NULL;
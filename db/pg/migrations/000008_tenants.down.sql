@@ -0,0 +1,60 @@
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_tenant_id_external_id_product_id_key;
+ALTER TABLE orders ADD CONSTRAINT orders_external_id_product_id_key UNIQUE (external_id, product_id);
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_tenant_id_external_id_key;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_tenant_id_sku_key;
+ALTER TABLE products ADD CONSTRAINT products_external_id_key UNIQUE (external_id);
+ALTER TABLE products ADD CONSTRAINT products_sku_key UNIQUE (sku);
+
+DROP INDEX IF EXISTS users_email_idx;
+ALTER TABLE auth ADD CONSTRAINT auth_email_key UNIQUE (email);
+CREATE UNIQUE INDEX users_email_idx ON auth (email);
+
+DROP POLICY IF EXISTS tenant_isolation ON auth;
+ALTER TABLE auth NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE auth DISABLE ROW LEVEL SECURITY;
+ALTER TABLE auth DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON products;
+ALTER TABLE products NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE products DISABLE ROW LEVEL SECURITY;
+ALTER TABLE products DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON product_owners;
+ALTER TABLE product_owners NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE product_owners DISABLE ROW LEVEL SECURITY;
+ALTER TABLE product_owners DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON reviews;
+ALTER TABLE reviews NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE reviews DISABLE ROW LEVEL SECURITY;
+ALTER TABLE reviews DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON review_votes;
+ALTER TABLE review_votes NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE review_votes DISABLE ROW LEVEL SECURITY;
+ALTER TABLE review_votes DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON review_replies;
+ALTER TABLE review_replies NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE review_replies DISABLE ROW LEVEL SECURITY;
+ALTER TABLE review_replies DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON review_attachments;
+ALTER TABLE review_attachments NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE review_attachments DISABLE ROW LEVEL SECURITY;
+ALTER TABLE review_attachments DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON orders;
+ALTER TABLE orders NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE orders DISABLE ROW LEVEL SECURITY;
+ALTER TABLE orders DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON audit_log;
+ALTER TABLE audit_log NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE audit_log DISABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_log DROP COLUMN IF EXISTS tenant_id;
+
+DROP FUNCTION IF EXISTS app_current_tenant();
+DROP TABLE IF EXISTS tenant_domains;
+DROP TABLE IF EXISTS tenants;
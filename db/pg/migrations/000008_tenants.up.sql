@@ -0,0 +1,122 @@
+CREATE TABLE tenants (
+    id         BIGSERIAL PRIMARY KEY,
+    slug       TEXT NOT NULL UNIQUE CHECK (slug = lower(slug)),
+    name       TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Hostnames of storefronts that resolve to a tenant.
+CREATE TABLE tenant_domains (
+    hostname  TEXT PRIMARY KEY CHECK (hostname = lower(hostname)),
+    tenant_id BIGINT NOT NULL REFERENCES tenants (id) ON DELETE CASCADE
+);
+
+-- Everything stored so far belongs to the single storefront served until now.
+INSERT INTO tenants (id, slug, name) VALUES (1, 'default', 'Default');
+
+SELECT setval(pg_get_serial_sequence('tenants', 'id'), 2, false);
+
+-- The tenant the current session acts for, set by the application on every
+-- connection it hands out. NULL when unset, which matches no rows.
+CREATE FUNCTION app_current_tenant() RETURNS BIGINT AS $$
+    SELECT NULLIF(current_setting('app.tenant_id', true), '')::BIGINT;
+$$ LANGUAGE sql STABLE;
+
+-- Existing rows are assigned to the default tenant; new rows default to the
+-- session tenant.
+ALTER TABLE auth
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE auth
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX auth_tenant_id_idx ON auth (tenant_id);
+
+ALTER TABLE products
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE products
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX products_tenant_id_idx ON products (tenant_id);
+
+ALTER TABLE product_owners
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE product_owners
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX product_owners_tenant_id_idx ON product_owners (tenant_id);
+
+ALTER TABLE reviews
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE reviews
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX reviews_tenant_id_idx ON reviews (tenant_id);
+
+ALTER TABLE review_votes
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE review_votes
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX review_votes_tenant_id_idx ON review_votes (tenant_id);
+
+ALTER TABLE review_replies
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE review_replies
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX review_replies_tenant_id_idx ON review_replies (tenant_id);
+
+ALTER TABLE review_attachments
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE review_attachments
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX review_attachments_tenant_id_idx ON review_attachments (tenant_id);
+
+ALTER TABLE orders
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE orders
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX orders_tenant_id_idx ON orders (tenant_id);
+
+ALTER TABLE audit_log
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+ALTER TABLE audit_log
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX audit_log_tenant_id_idx ON audit_log (tenant_id);
+
+-- Row-level security hides and rejects rows of any other tenant than the
+-- session's, also for the table owner. Superusers and roles with BYPASSRLS
+-- are not subject to it, so the application must not connect as one.
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'auth',
+        'products',
+        'product_owners',
+        'reviews',
+        'review_votes',
+        'review_replies',
+        'review_attachments',
+        'orders',
+        'audit_log'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format(
+            'CREATE POLICY tenant_isolation ON %I '
+            'USING (tenant_id = app_current_tenant()) '
+            'WITH CHECK (tenant_id = app_current_tenant())',
+            t
+        );
+    END LOOP;
+END;
+$$;
+
+-- Natural keys are unique per tenant rather than globally.
+DROP INDEX users_email_idx;
+ALTER TABLE auth DROP CONSTRAINT auth_email_key;
+CREATE UNIQUE INDEX users_email_idx ON auth (tenant_id, email);
+
+ALTER TABLE products DROP CONSTRAINT products_sku_key;
+ALTER TABLE products DROP CONSTRAINT products_external_id_key;
+ALTER TABLE products ADD CONSTRAINT products_tenant_id_sku_key UNIQUE (tenant_id, sku);
+ALTER TABLE products ADD CONSTRAINT products_tenant_id_external_id_key UNIQUE (tenant_id, external_id);
+
+ALTER TABLE orders DROP CONSTRAINT orders_external_id_product_id_key;
+ALTER TABLE orders ADD CONSTRAINT orders_tenant_id_external_id_product_id_key UNIQUE (tenant_id, external_id, product_id);
@@ -0,0 +1,31 @@
+-- Domain events written in the same transaction as the change they describe
+-- and relayed to the event publisher afterwards.
+CREATE TABLE outbox (
+    id               BIGSERIAL PRIMARY KEY,
+    tenant_id        BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    aggregate_type   TEXT NOT NULL,
+    aggregate_id     BIGINT NOT NULL,
+    event_type       TEXT NOT NULL,
+    payload          JSONB NOT NULL,
+    attempts         INT NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error       TEXT,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at     TIMESTAMPTZ,
+    dead_lettered_at TIMESTAMPTZ
+);
+
+-- Events still waiting to be published, per aggregate in write order.
+CREATE INDEX outbox_pending_idx
+    ON outbox (tenant_id, aggregate_type, aggregate_id, id)
+    WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+
+CREATE INDEX outbox_dead_lettered_idx
+    ON outbox (tenant_id, dead_lettered_at)
+    WHERE dead_lettered_at IS NOT NULL;
+
+ALTER TABLE outbox ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON outbox
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
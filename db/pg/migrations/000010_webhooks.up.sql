@@ -0,0 +1,56 @@
+-- HTTP callbacks registered by a tenant for review events.
+CREATE TABLE webhook_subscriptions (
+    id                   BIGSERIAL PRIMARY KEY,
+    tenant_id            BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    url                  TEXT NOT NULL,
+    secret               TEXT NOT NULL,
+    -- Event types to deliver; "review.*" matches every review event, "*" every event
+    event_types          TEXT[] NOT NULL,
+    active               BOOLEAN NOT NULL DEFAULT TRUE,
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    disabled_at          TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhook_subscriptions_tenant_id_idx
+    ON webhook_subscriptions (tenant_id)
+    WHERE active;
+
+-- One row per attempt series of an event to a subscription; the delivery log.
+CREATE TABLE webhook_deliveries (
+    id              BIGSERIAL PRIMARY KEY,
+    tenant_id       BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    subscription_id BIGINT NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        BIGINT NOT NULL,
+    event_type      TEXT NOT NULL,
+    -- The exact request body, so redeliveries are byte-identical
+    payload         JSONB NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'pending', -- pending | succeeded | failed
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_attempt_at TIMESTAMPTZ,
+    response_status INT,
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhook_deliveries_due_idx
+    ON webhook_deliveries (tenant_id, next_attempt_at)
+    WHERE status = 'pending';
+
+CREATE INDEX webhook_deliveries_subscription_id_idx
+    ON webhook_deliveries (subscription_id, id DESC);
+
+ALTER TABLE webhook_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_subscriptions FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON webhook_subscriptions
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON webhook_deliveries
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS outbox_notify ON outbox;
+DROP FUNCTION IF EXISTS notify_outbox_event();
+DROP INDEX IF EXISTS outbox_tenant_id_aggregate_type_id_idx;
+
+DROP INDEX IF EXISTS reviews_tenant_id_status_idx;
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_status_check;
+ALTER TABLE reviews DROP COLUMN IF EXISTS status;
@@ -0,0 +1,33 @@
+-- Moderation state of a review; only approved reviews are listed publicly.
+ALTER TABLE reviews ADD COLUMN status TEXT NOT NULL DEFAULT 'approved';
+ALTER TABLE reviews ADD CONSTRAINT reviews_status_check
+    CHECK (status IN ('pending', 'approved', 'rejected'));
+
+CREATE INDEX reviews_tenant_id_status_idx
+    ON reviews (tenant_id, status, created_at DESC)
+    WHERE deleted_at IS NULL;
+
+-- Serves consumers replaying the event history of an aggregate type
+CREATE INDEX outbox_tenant_id_aggregate_type_id_idx
+    ON outbox (tenant_id, aggregate_type, id);
+
+-- Announce committed outbox events to every API instance. The payload only
+-- names the event; listeners read it back under the tenant's scope.
+CREATE FUNCTION notify_outbox_event() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    PERFORM pg_notify(
+        'outbox_events',
+        json_build_object(
+            'tenant_id', NEW.tenant_id,
+            'id', NEW.id,
+            'aggregate_type', NEW.aggregate_type
+        )::text
+    );
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER outbox_notify
+    AFTER INSERT ON outbox
+    FOR EACH ROW EXECUTE FUNCTION notify_outbox_event();
@@ -0,0 +1,28 @@
+-- Review exports produced in the background and kept in blob storage.
+CREATE TABLE export_jobs (
+    id           BIGSERIAL PRIMARY KEY,
+    tenant_id    BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    requested_by BIGINT NOT NULL,
+    format       TEXT NOT NULL, -- csv | ndjson | parquet
+    gzip         BOOLEAN NOT NULL DEFAULT FALSE,
+    -- List filters, with the requester's permissions already applied
+    filters      JSONB NOT NULL DEFAULT '{}',
+    status       TEXT NOT NULL DEFAULT 'queued', -- queued | running | succeeded | failed
+    blob_key     TEXT,
+    row_count    BIGINT,
+    size_bytes   BIGINT,
+    error        TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at   TIMESTAMPTZ,
+    finished_at  TIMESTAMPTZ
+);
+
+CREATE INDEX export_jobs_pending_idx
+    ON export_jobs (tenant_id, id)
+    WHERE status IN ('queued', 'running');
+
+ALTER TABLE export_jobs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE export_jobs FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON export_jobs
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS reviews_capture_update ON reviews;
+DROP TRIGGER IF EXISTS reviews_capture_insert_delete ON reviews;
+DROP FUNCTION IF EXISTS capture_review_change();
+DROP TABLE IF EXISTS review_changes;
+DROP SEQUENCE IF EXISTS review_change_seq;
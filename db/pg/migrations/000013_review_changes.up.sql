@@ -0,0 +1,68 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- Orders the change feed. Numbers are handed out once changes have committed,
+-- so a reader that has seen number N never misses a later change below it.
+CREATE SEQUENCE review_change_seq;
+
+-- One row per insert, update or delete of a review, captured by trigger.
+CREATE TABLE review_changes (
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  BIGINT NOT NULL REFERENCES tenants (id),
+    review_id  BIGINT NOT NULL,
+    operation  TEXT NOT NULL CHECK (operation IN ('insert', 'update', 'delete')),
+    -- NULL until the change has been sequenced
+    seq        BIGINT UNIQUE,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX review_changes_tenant_id_seq_idx
+    ON review_changes (tenant_id, seq)
+    WHERE seq IS NOT NULL;
+
+CREATE INDEX review_changes_unsequenced_idx
+    ON review_changes (tenant_id, id)
+    WHERE seq IS NULL;
+
+-- Reviews that existed before the feed start it as inserts.
+INSERT INTO review_changes (tenant_id, review_id, operation, seq, changed_at)
+SELECT tenant_id, id, 'insert', nextval('review_change_seq'), COALESCE(updated_at, created_at)
+FROM (SELECT * FROM reviews WHERE deleted_at IS NULL ORDER BY id) existing;
+
+ALTER TABLE review_changes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_changes FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_changes
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+-- Soft deletes are recorded as deletes; updates of deleted reviews are not
+-- recorded at all.
+CREATE FUNCTION capture_review_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            INSERT INTO review_changes (tenant_id, review_id, operation)
+            VALUES (OLD.tenant_id, OLD.id, 'delete');
+        END IF;
+    ELSIF TG_OP = 'INSERT' THEN
+        INSERT INTO review_changes (tenant_id, review_id, operation)
+        VALUES (NEW.tenant_id, NEW.id, 'insert');
+    ELSIF OLD.deleted_at IS NULL THEN
+        INSERT INTO review_changes (tenant_id, review_id, operation)
+        VALUES (NEW.tenant_id, NEW.id, CASE WHEN NEW.deleted_at IS NULL THEN 'update' ELSE 'delete' END);
+    END IF;
+    PERFORM pg_notify('review_changes', COALESCE(NEW.tenant_id, OLD.tenant_id)::text);
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER reviews_capture_insert_delete
+    AFTER INSERT OR DELETE ON reviews
+    FOR EACH ROW EXECUTE FUNCTION capture_review_change();
+
+CREATE TRIGGER reviews_capture_update
+    AFTER UPDATE ON reviews
+    FOR EACH ROW
+    WHEN (OLD.* IS DISTINCT FROM NEW.*)
+    EXECUTE FUNCTION capture_review_change();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS token_revocations;
+DROP TABLE IF EXISTS data_subject_requests;
+DROP FUNCTION IF EXISTS forbid_data_subject_request_change();
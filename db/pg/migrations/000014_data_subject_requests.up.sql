@@ -0,0 +1,56 @@
+-- Fulfilled data subject access and erasure requests. Rows are the proof that
+-- a request was answered and can never be changed or removed.
+CREATE TABLE data_subject_requests (
+    id             BIGSERIAL PRIMARY KEY,
+    tenant_id      BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    kind           TEXT NOT NULL CHECK (kind IN ('export', 'erasure')),
+    user_id        BIGINT NOT NULL,
+    -- The auth account covered by the request, when one was named
+    account_id     UUID,
+    requested_by   TEXT NOT NULL,
+    -- What happened to the subject's reviews on erasure: anonymize | delete
+    review_policy  TEXT CHECK (review_policy IN ('anonymize', 'delete')),
+    -- Number of records exported or erased, per kind of record
+    summary        JSONB NOT NULL,
+    -- SHA-256 of the archive handed out for an export
+    archive_sha256 TEXT,
+    fulfilled_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX data_subject_requests_tenant_id_user_id_idx
+    ON data_subject_requests (tenant_id, user_id, fulfilled_at DESC);
+
+CREATE FUNCTION forbid_data_subject_request_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'data_subject_requests is append-only';
+END;
+$$;
+
+CREATE TRIGGER data_subject_requests_append_only
+    BEFORE UPDATE OR DELETE ON data_subject_requests
+    FOR EACH ROW EXECUTE FUNCTION forbid_data_subject_request_change();
+
+CREATE TRIGGER data_subject_requests_no_truncate
+    BEFORE TRUNCATE ON data_subject_requests
+    FOR EACH STATEMENT EXECUTE FUNCTION forbid_data_subject_request_change();
+
+-- Access tokens of a user issued before revoked_before are refused.
+CREATE TABLE token_revocations (
+    tenant_id      BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    user_id        BIGINT NOT NULL,
+    revoked_before TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, user_id)
+);
+
+ALTER TABLE data_subject_requests ENABLE ROW LEVEL SECURITY;
+ALTER TABLE data_subject_requests FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON data_subject_requests
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE token_revocations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE token_revocations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON token_revocations
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,19 @@
+-- Reviews written per user and per product in fixed time windows, shared by
+-- every instance to enforce velocity limits.
+CREATE TABLE review_velocity_counters (
+    tenant_id    BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    scope        TEXT NOT NULL, -- user | product
+    subject_id   BIGINT NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    count        INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (tenant_id, scope, subject_id, window_start)
+);
+
+CREATE INDEX review_velocity_counters_window_idx
+    ON review_velocity_counters (tenant_id, window_start);
+
+ALTER TABLE review_velocity_counters ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_velocity_counters FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_velocity_counters
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS reviews_tenant_id_created_at_idx;
+DROP TABLE IF EXISTS rating_alerts;
+ALTER TABLE products DROP COLUMN IF EXISTS hold_new_reviews;
@@ -0,0 +1,45 @@
+-- Products under review-bombing can be switched to holding every new review
+-- for moderation.
+ALTER TABLE products ADD COLUMN hold_new_reviews BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Abnormal rating activity on a product, compared with its own history.
+CREATE TABLE rating_alerts (
+    id              BIGSERIAL PRIMARY KEY,
+    tenant_id       BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    product_id      BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    kind            TEXT NOT NULL CHECK (kind IN ('volume_spike', 'rating_shift')),
+    status          TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'acknowledged', 'resolved')),
+    window_start    TIMESTAMPTZ NOT NULL,
+    window_end      TIMESTAMPTZ NOT NULL,
+    -- Reviews received in the window and their mean rating
+    review_count    INTEGER NOT NULL,
+    mean_rating     DOUBLE PRECISION NOT NULL,
+    -- What the product's history predicts for a window of the same length
+    expected_count  DOUBLE PRECISION NOT NULL,
+    baseline_mean   DOUBLE PRECISION NOT NULL,
+    baseline_stddev DOUBLE PRECISION NOT NULL,
+    -- How many standard errors the window is away from the baseline
+    score           DOUBLE PRECISION NOT NULL,
+    -- Whether the alert switched the product to holding new reviews
+    product_held    BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- At most one unresolved alert of each kind per product
+CREATE UNIQUE INDEX rating_alerts_unresolved_idx
+    ON rating_alerts (tenant_id, product_id, kind)
+    WHERE status <> 'resolved';
+
+CREATE INDEX rating_alerts_tenant_id_id_idx
+    ON rating_alerts (tenant_id, id DESC);
+
+CREATE INDEX reviews_tenant_id_created_at_idx
+    ON reviews (tenant_id, created_at)
+    WHERE deleted_at IS NULL;
+
+ALTER TABLE rating_alerts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE rating_alerts FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON rating_alerts
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
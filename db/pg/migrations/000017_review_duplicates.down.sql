@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_duplicates;
+ALTER TABLE reviews DROP COLUMN IF EXISTS comment_simhash;
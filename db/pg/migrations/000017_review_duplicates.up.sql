@@ -0,0 +1,36 @@
+-- SimHash fingerprint of the comment, NULL for comments too short to compare.
+ALTER TABLE reviews ADD COLUMN comment_simhash BIGINT;
+
+-- Near-duplicates share at least one 16-bit band of their fingerprint
+CREATE INDEX reviews_simhash_band0_idx
+    ON reviews (tenant_id, ((comment_simhash >> 48) & 65535))
+    WHERE comment_simhash IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX reviews_simhash_band1_idx
+    ON reviews (tenant_id, ((comment_simhash >> 32) & 65535))
+    WHERE comment_simhash IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX reviews_simhash_band2_idx
+    ON reviews (tenant_id, ((comment_simhash >> 16) & 65535))
+    WHERE comment_simhash IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX reviews_simhash_band3_idx
+    ON reviews (tenant_id, (comment_simhash & 65535))
+    WHERE comment_simhash IS NOT NULL AND deleted_at IS NULL;
+
+-- Reviews suspected to copy earlier ones.
+CREATE TABLE review_duplicates (
+    tenant_id   BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    review_id   BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    original_id BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    -- Bits in which the fingerprints differ
+    distance    SMALLINT NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (review_id, original_id)
+);
+
+CREATE INDEX review_duplicates_original_id_idx
+    ON review_duplicates (original_id);
+
+ALTER TABLE review_duplicates ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_duplicates FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_duplicates
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
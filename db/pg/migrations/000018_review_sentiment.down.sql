@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reviews_sentiment_mismatch_idx;
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS sentiment_mismatch,
+    DROP COLUMN IF EXISTS sentiment_label,
+    DROP COLUMN IF EXISTS sentiment_score;
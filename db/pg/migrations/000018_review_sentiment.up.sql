@@ -0,0 +1,13 @@
+-- Sentiment of the comment, NULL for comments without recognized sentiment
+-- and for reviews written before sentiment was scored.
+ALTER TABLE reviews
+    ADD COLUMN sentiment_score DOUBLE PRECISION
+        CHECK (sentiment_score BETWEEN -1 AND 1),
+    ADD COLUMN sentiment_label TEXT
+        CHECK (sentiment_label IN ('positive', 'neutral', 'negative')),
+    -- The comment clearly contradicts the star rating
+    ADD COLUMN sentiment_mismatch BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX reviews_sentiment_mismatch_idx
+    ON reviews (tenant_id, created_at)
+    WHERE sentiment_mismatch AND deleted_at IS NULL;
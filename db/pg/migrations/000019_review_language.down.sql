@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reviews_tenant_id_language_idx;
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS locale,
+    DROP COLUMN IF EXISTS detected_language;
@@ -0,0 +1,11 @@
+ALTER TABLE reviews
+    -- Language detected from the comment, NULL when it could not be told
+    ADD COLUMN detected_language TEXT,
+    -- BCP 47 tag the client wrote the review in, overriding detection
+    ADD COLUMN locale TEXT,
+    -- ISO 639 code of the locale's language, or else the detected one
+    ADD COLUMN language TEXT;
+
+CREATE INDEX reviews_tenant_id_language_idx
+    ON reviews (tenant_id, language, created_at)
+    WHERE deleted_at IS NULL;
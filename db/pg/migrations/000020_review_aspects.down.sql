@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS review_aspect_ratings;
+DROP TABLE IF EXISTS category_aspects;
+DROP INDEX IF EXISTS products_category_id_idx;
+ALTER TABLE products DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS categories;
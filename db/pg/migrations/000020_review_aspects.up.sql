@@ -0,0 +1,60 @@
+-- Product categories, which define the aspects reviewers rate besides the
+-- overall rating.
+CREATE TABLE categories (
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    slug       TEXT NOT NULL,
+    name       TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, slug)
+);
+
+ALTER TABLE products
+    ADD COLUMN category_id BIGINT REFERENCES categories (id) ON DELETE SET NULL;
+
+CREATE INDEX products_category_id_idx ON products (category_id);
+
+-- Aspects of a category's products, such as "fit" or "battery".
+CREATE TABLE category_aspects (
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    category_id BIGINT NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    key         TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    -- Whether new reviews must rate the aspect
+    required    BOOLEAN NOT NULL DEFAULT FALSE,
+    -- Display order within the category
+    position    INTEGER NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (category_id, key)
+);
+
+CREATE TABLE review_aspect_ratings (
+    tenant_id BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    review_id BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    aspect_id BIGINT NOT NULL REFERENCES category_aspects (id) ON DELETE CASCADE,
+    rating    SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    PRIMARY KEY (review_id, aspect_id)
+);
+
+CREATE INDEX review_aspect_ratings_aspect_id_idx
+    ON review_aspect_ratings (aspect_id);
+
+ALTER TABLE categories ENABLE ROW LEVEL SECURITY;
+ALTER TABLE categories FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON categories
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE category_aspects ENABLE ROW LEVEL SECURITY;
+ALTER TABLE category_aspects FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON category_aspects
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE review_aspect_ratings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_aspect_ratings FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_aspect_ratings
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,20 @@
+ALTER TABLE categories
+    DROP CONSTRAINT IF EXISTS categories_rating_scale_check,
+    DROP COLUMN IF EXISTS rating_scale_min,
+    DROP COLUMN IF EXISTS rating_scale_max,
+    DROP COLUMN IF EXISTS rating_scale_step;
+
+ALTER TABLE tenants
+    DROP CONSTRAINT IF EXISTS tenants_rating_scale_check,
+    DROP COLUMN IF EXISTS rating_scale_min,
+    DROP COLUMN IF EXISTS rating_scale_max,
+    DROP COLUMN IF EXISTS rating_scale_step;
+
+ALTER TABLE reviews
+    DROP CONSTRAINT IF EXISTS reviews_rating_check,
+    DROP COLUMN IF EXISTS rating_value,
+    DROP COLUMN IF EXISTS rating_scale_min,
+    DROP COLUMN IF EXISTS rating_scale_max,
+    DROP COLUMN IF EXISTS rating_scale_step;
+
+ALTER TABLE reviews ALTER COLUMN rating TYPE INT USING round(rating)::int;
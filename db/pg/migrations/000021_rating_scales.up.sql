@@ -0,0 +1,41 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- Ratings are stored normalized to 1–5 in reviews.rating so they aggregate
+-- across scales; the value the reviewer gave and its scale are kept beside it.
+ALTER TABLE reviews
+    ALTER COLUMN rating TYPE DOUBLE PRECISION,
+    ADD COLUMN rating_value      DOUBLE PRECISION,
+    ADD COLUMN rating_scale_min  DOUBLE PRECISION NOT NULL DEFAULT 1,
+    ADD COLUMN rating_scale_max  DOUBLE PRECISION NOT NULL DEFAULT 5,
+    ADD COLUMN rating_scale_step DOUBLE PRECISION NOT NULL DEFAULT 1;
+
+-- Backfilling is not a change of the reviews the change feed should report
+ALTER TABLE reviews DISABLE TRIGGER reviews_capture_update;
+UPDATE reviews SET rating_value = rating;
+ALTER TABLE reviews ENABLE TRIGGER reviews_capture_update;
+
+ALTER TABLE reviews
+    ALTER COLUMN rating_value SET NOT NULL,
+    ADD CONSTRAINT reviews_rating_check CHECK (rating BETWEEN 1 AND 5);
+
+-- Scales reviewers of a tenant, or of a category's products, rate on. A
+-- category's scale overrides its tenant's; without either reviews use 1–5
+-- stars.
+ALTER TABLE tenants
+    ADD COLUMN rating_scale_min  DOUBLE PRECISION,
+    ADD COLUMN rating_scale_max  DOUBLE PRECISION,
+    ADD COLUMN rating_scale_step DOUBLE PRECISION,
+    ADD CONSTRAINT tenants_rating_scale_check CHECK (
+        (rating_scale_min IS NULL) = (rating_scale_max IS NULL)
+        AND (rating_scale_min IS NULL) = (rating_scale_step IS NULL)
+    );
+
+ALTER TABLE categories
+    ADD COLUMN rating_scale_min  DOUBLE PRECISION,
+    ADD COLUMN rating_scale_max  DOUBLE PRECISION,
+    ADD COLUMN rating_scale_step DOUBLE PRECISION,
+    ADD CONSTRAINT categories_rating_scale_check CHECK (
+        (rating_scale_min IS NULL) = (rating_scale_max IS NULL)
+        AND (rating_scale_min IS NULL) = (rating_scale_step IS NULL)
+    );
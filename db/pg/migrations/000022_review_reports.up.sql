@@ -0,0 +1,27 @@
+-- Reports of abusive reviews by shoppers, one per reporter and review.
+CREATE TABLE review_reports (
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    review_id   BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    reporter_id BIGINT NOT NULL,
+    reason      TEXT NOT NULL CHECK (reason IN ('spam', 'offensive', 'fake', 'other')),
+    details     TEXT,
+    -- Open until a moderator upholds or dismisses it
+    status      TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'upheld', 'dismissed')),
+    resolved_by BIGINT,
+    resolved_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (review_id, reporter_id)
+);
+
+CREATE INDEX review_reports_open_idx
+    ON review_reports (tenant_id, review_id)
+    WHERE status = 'open';
+CREATE INDEX review_reports_reporter_id_idx
+    ON review_reports (reporter_id);
+
+ALTER TABLE review_reports ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_reports FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_reports
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,88 @@
+-- Questions shoppers ask about products, and their answers. Like reviews,
+-- they are moderated and soft-deleted.
+CREATE TABLE product_questions (
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    product_id BIGINT NOT NULL REFERENCES products (id),
+    user_id    BIGINT NOT NULL,
+    body       TEXT NOT NULL,
+    status     TEXT NOT NULL DEFAULT 'approved' CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX product_questions_product_id_idx
+    ON product_questions (tenant_id, product_id, created_at DESC)
+    WHERE deleted_at IS NULL;
+CREATE INDEX product_questions_user_id_idx
+    ON product_questions (user_id);
+
+CREATE TABLE product_answers (
+    id              BIGSERIAL PRIMARY KEY,
+    tenant_id       BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    question_id     BIGINT NOT NULL REFERENCES product_questions (id) ON DELETE CASCADE,
+    user_id         BIGINT NOT NULL,
+    body            TEXT NOT NULL,
+    -- Answered on behalf of the brand by an owner of the product
+    brand           BOOLEAN NOT NULL DEFAULT FALSE,
+    status          TEXT NOT NULL DEFAULT 'approved' CHECK (status IN ('pending', 'approved', 'rejected')),
+    helpful_count   INT NOT NULL DEFAULT 0,
+    unhelpful_count INT NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at      TIMESTAMPTZ
+);
+
+-- Lower bound of the Wilson score interval, as for reviews
+ALTER TABLE product_answers
+    ADD COLUMN helpful_score DOUBLE PRECISION NOT NULL GENERATED ALWAYS AS (
+        CASE
+            WHEN helpful_count + unhelpful_count = 0 THEN 0
+            ELSE (
+                (helpful_count::float8 / (helpful_count + unhelpful_count))
+                + 1.9208 / (helpful_count + unhelpful_count)
+                - 1.96 * sqrt(
+                    (helpful_count::float8 * unhelpful_count) / (helpful_count + unhelpful_count)
+                    + 0.9604
+                ) / (helpful_count + unhelpful_count)
+            ) / (1 + 3.8416 / (helpful_count + unhelpful_count))
+        END
+    ) STORED;
+
+CREATE INDEX product_answers_question_id_idx
+    ON product_answers (question_id)
+    WHERE deleted_at IS NULL;
+CREATE INDEX product_answers_user_id_idx
+    ON product_answers (user_id);
+
+CREATE TABLE product_answer_votes (
+    tenant_id  BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    answer_id  BIGINT NOT NULL REFERENCES product_answers (id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL,
+    helpful    BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (answer_id, user_id)
+);
+
+CREATE INDEX product_answer_votes_user_id_idx
+    ON product_answer_votes (user_id);
+
+ALTER TABLE product_questions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE product_questions FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON product_questions
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE product_answers ENABLE ROW LEVEL SECURITY;
+ALTER TABLE product_answers FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON product_answers
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE product_answer_votes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE product_answer_votes FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON product_answer_votes
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
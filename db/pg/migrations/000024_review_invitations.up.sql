@@ -0,0 +1,27 @@
+-- Invitations to review a purchase, sent to customers as signed links. Each
+-- is bound to one order line and can be redeemed once.
+CREATE TABLE review_invitations (
+    id           BIGSERIAL PRIMARY KEY,
+    tenant_id    BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    order_id     BIGINT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    customer_id  BIGINT NOT NULL,
+    product_id   BIGINT NOT NULL REFERENCES products (id),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    -- First time the link was followed
+    opened_at    TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    review_id    BIGINT REFERENCES reviews (id) ON DELETE SET NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, order_id)
+);
+
+CREATE INDEX review_invitations_created_at_idx
+    ON review_invitations (tenant_id, created_at);
+CREATE INDEX review_invitations_customer_id_idx
+    ON review_invitations (customer_id);
+
+ALTER TABLE review_invitations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_invitations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_invitations
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS reviews_track_reviewer_update ON reviews;
+DROP TRIGGER IF EXISTS reviews_track_reviewer_insert_delete ON reviews;
+DROP FUNCTION IF EXISTS track_reviewer_stats();
+DROP TABLE IF EXISTS reviewer_stats;
@@ -0,0 +1,125 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- Running totals of each author's live reviews, kept current by a trigger on
+-- reviews. Anonymized reviews (user 0) are not counted.
+CREATE TABLE reviewer_stats (
+    tenant_id       BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    user_id         BIGINT NOT NULL,
+    review_count    INT NOT NULL DEFAULT 0,
+    approved_count  INT NOT NULL DEFAULT 0,
+    rejected_count  INT NOT NULL DEFAULT 0,
+    verified_count  INT NOT NULL DEFAULT 0,
+    -- Votes received on the author's reviews
+    helpful_votes   INT NOT NULL DEFAULT 0,
+    unhelpful_votes INT NOT NULL DEFAULT 0,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, user_id)
+);
+
+-- Reputation from 0 to 100: the Wilson lower bound of the share of helpful
+-- votes received (40%), the share of verified purchases (30%) and the share of
+-- reviews not rejected by moderation (30%), scaled down for authors with few
+-- reviews.
+ALTER TABLE reviewer_stats
+    ADD COLUMN reputation DOUBLE PRECISION NOT NULL GENERATED ALWAYS AS (
+        CASE
+            WHEN review_count <= 0 THEN 0
+            ELSE 100 * (1 - power(0.8::float8, review_count)) * (
+                0.4 * CASE
+                    WHEN helpful_votes + unhelpful_votes <= 0 THEN 0
+                    ELSE (
+                        (helpful_votes::float8 / (helpful_votes + unhelpful_votes))
+                        + 1.9208 / (helpful_votes + unhelpful_votes)
+                        - 1.96 * sqrt(
+                            (helpful_votes::float8 * unhelpful_votes) / (helpful_votes + unhelpful_votes)
+                            + 0.9604
+                        ) / (helpful_votes + unhelpful_votes)
+                    ) / (1 + 3.8416 / (helpful_votes + unhelpful_votes))
+                END
+                + 0.3 * verified_count::float8 / review_count
+                + 0.3 * (1 - rejected_count::float8 / review_count)
+            )
+        END
+    ) STORED;
+
+ALTER TABLE reviewer_stats ENABLE ROW LEVEL SECURITY;
+ALTER TABLE reviewer_stats FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON reviewer_stats
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+-- Takes the old version of a review out of its author's totals and adds the
+-- new one; soft-deleted reviews count for nothing.
+CREATE FUNCTION track_reviewer_stats() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' AND OLD.deleted_at IS NULL AND OLD.user_id <> 0 THEN
+        INSERT INTO reviewer_stats (tenant_id, user_id, review_count, approved_count, rejected_count, verified_count, helpful_votes, unhelpful_votes)
+        VALUES (
+            OLD.tenant_id, OLD.user_id, -1,
+            -(OLD.status = 'approved')::int,
+            -(OLD.status = 'rejected')::int,
+            -OLD.verified_purchase::int,
+            -OLD.helpful_count,
+            -OLD.unhelpful_count
+        )
+        ON CONFLICT (tenant_id, user_id) DO UPDATE SET
+            review_count = reviewer_stats.review_count + EXCLUDED.review_count,
+            approved_count = reviewer_stats.approved_count + EXCLUDED.approved_count,
+            rejected_count = reviewer_stats.rejected_count + EXCLUDED.rejected_count,
+            verified_count = reviewer_stats.verified_count + EXCLUDED.verified_count,
+            helpful_votes = reviewer_stats.helpful_votes + EXCLUDED.helpful_votes,
+            unhelpful_votes = reviewer_stats.unhelpful_votes + EXCLUDED.unhelpful_votes,
+            updated_at = NOW();
+    END IF;
+    IF TG_OP <> 'DELETE' AND NEW.deleted_at IS NULL AND NEW.user_id <> 0 THEN
+        INSERT INTO reviewer_stats (tenant_id, user_id, review_count, approved_count, rejected_count, verified_count, helpful_votes, unhelpful_votes)
+        VALUES (
+            NEW.tenant_id, NEW.user_id, 1,
+            (NEW.status = 'approved')::int,
+            (NEW.status = 'rejected')::int,
+            NEW.verified_purchase::int,
+            NEW.helpful_count,
+            NEW.unhelpful_count
+        )
+        ON CONFLICT (tenant_id, user_id) DO UPDATE SET
+            review_count = reviewer_stats.review_count + EXCLUDED.review_count,
+            approved_count = reviewer_stats.approved_count + EXCLUDED.approved_count,
+            rejected_count = reviewer_stats.rejected_count + EXCLUDED.rejected_count,
+            verified_count = reviewer_stats.verified_count + EXCLUDED.verified_count,
+            helpful_votes = reviewer_stats.helpful_votes + EXCLUDED.helpful_votes,
+            unhelpful_votes = reviewer_stats.unhelpful_votes + EXCLUDED.unhelpful_votes,
+            updated_at = NOW();
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER reviews_track_reviewer_insert_delete
+    AFTER INSERT OR DELETE ON reviews
+    FOR EACH ROW EXECUTE FUNCTION track_reviewer_stats();
+
+CREATE TRIGGER reviews_track_reviewer_update
+    AFTER UPDATE ON reviews
+    FOR EACH ROW
+    WHEN (
+        (OLD.user_id, OLD.status, OLD.verified_purchase, OLD.helpful_count, OLD.unhelpful_count, OLD.deleted_at IS NULL)
+        IS DISTINCT FROM
+        (NEW.user_id, NEW.status, NEW.verified_purchase, NEW.helpful_count, NEW.unhelpful_count, NEW.deleted_at IS NULL)
+    )
+    EXECUTE FUNCTION track_reviewer_stats();
+
+INSERT INTO reviewer_stats (tenant_id, user_id, review_count, approved_count, rejected_count, verified_count, helpful_votes, unhelpful_votes)
+SELECT
+    tenant_id,
+    user_id,
+    COUNT(*),
+    COUNT(*) FILTER (WHERE status = 'approved'),
+    COUNT(*) FILTER (WHERE status = 'rejected'),
+    COUNT(*) FILTER (WHERE verified_purchase),
+    SUM(helpful_count),
+    SUM(unhelpful_count)
+FROM reviews
+WHERE deleted_at IS NULL AND user_id <> 0
+GROUP BY tenant_id, user_id;
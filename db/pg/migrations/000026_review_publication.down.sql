@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reviews_unpublished_idx;
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE tenants DROP COLUMN IF EXISTS review_cooling_off_hours;
@@ -0,0 +1,27 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- Hours new reviews of a tenant wait before going public, during which their
+-- authors can still edit or withdraw them.
+ALTER TABLE tenants
+    ADD COLUMN review_cooling_off_hours INT NOT NULL DEFAULT 0
+        CONSTRAINT tenants_review_cooling_off_hours_check CHECK (review_cooling_off_hours >= 0);
+
+-- A review goes public at publish_at; published_at is set once the scheduler
+-- has published it, or right away when there is no cooling-off period.
+ALTER TABLE reviews
+    ADD COLUMN publish_at   TIMESTAMPTZ,
+    ADD COLUMN published_at TIMESTAMPTZ;
+
+-- Backfilling is not a change of the reviews the change feed should report
+ALTER TABLE reviews DISABLE TRIGGER reviews_capture_update;
+UPDATE reviews SET publish_at = created_at, published_at = created_at;
+ALTER TABLE reviews ENABLE TRIGGER reviews_capture_update;
+
+ALTER TABLE reviews
+    ALTER COLUMN publish_at SET NOT NULL,
+    ALTER COLUMN publish_at SET DEFAULT NOW();
+
+CREATE INDEX reviews_unpublished_idx
+    ON reviews (tenant_id, publish_at)
+    WHERE published_at IS NULL AND deleted_at IS NULL;
@@ -0,0 +1,35 @@
+-- Rules reviews of a product, or of a category's products, are held to. Rules
+-- left NULL are inherited: a product's policy overrides its group's, which
+-- overrides its category's.
+CREATE TABLE review_policies (
+    id                 BIGSERIAL PRIMARY KEY,
+    tenant_id          BIGINT NOT NULL DEFAULT app_current_tenant() REFERENCES tenants (id),
+    product_id         BIGINT REFERENCES products (id) ON DELETE CASCADE,
+    category_id        BIGINT REFERENCES categories (id) ON DELETE CASCADE,
+    -- Minimum length of a comment, in characters
+    min_comment_length INT CHECK (min_comment_length >= 0),
+    comment_required   BOOLEAN,
+    -- Whether only verified purchases can be reviewed
+    verified_only      BOOLEAN,
+    -- auto_approve publishes new reviews unless they are held; pre_moderate
+    -- holds every new or edited review for moderation
+    moderation         TEXT CHECK (moderation IN ('auto_approve', 'pre_moderate')),
+    -- Whether reviews can no longer be written or edited
+    closed             BOOLEAN,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT review_policies_target_check CHECK ((product_id IS NULL) <> (category_id IS NULL))
+);
+
+CREATE UNIQUE INDEX review_policies_product_id_idx
+    ON review_policies (product_id)
+    WHERE product_id IS NOT NULL;
+CREATE UNIQUE INDEX review_policies_category_id_idx
+    ON review_policies (category_id)
+    WHERE category_id IS NOT NULL;
+
+ALTER TABLE review_policies ENABLE ROW LEVEL SECURITY;
+ALTER TABLE review_policies FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON review_policies
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,11 @@
+DROP POLICY IF EXISTS tenant_isolation ON user_profiles;
+ALTER TABLE user_profiles NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE user_profiles DISABLE ROW LEVEL SECURITY;
+DROP INDEX IF EXISTS user_profiles_tenant_id_idx;
+ALTER TABLE user_profiles DROP COLUMN IF EXISTS tenant_id;
+
+DROP POLICY IF EXISTS tenant_isolation ON oauth_providers;
+ALTER TABLE oauth_providers NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE oauth_providers DISABLE ROW LEVEL SECURITY;
+DROP INDEX IF EXISTS oauth_providers_tenant_id_idx;
+ALTER TABLE oauth_providers DROP COLUMN IF EXISTS tenant_id;
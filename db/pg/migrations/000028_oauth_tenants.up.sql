@@ -0,0 +1,63 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- The OAuth provider and profile tables used to be created outside this
+-- repository; create them where they are missing so they can be scoped here.
+CREATE TABLE IF NOT EXISTS oauth_providers (
+    id               uuid PRIMARY KEY,
+    user_id          uuid NOT NULL,
+    provider_name    text NOT NULL,
+    provider_user_id text NOT NULL,
+    email            text,
+    name             text,
+    access_token     text,
+    refresh_token    text,
+    expires_at       timestamptz,
+    scopes           text[],
+    created_at       timestamptz DEFAULT now(),
+    updated_at       timestamptz DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS user_profiles (
+    id         uuid PRIMARY KEY,
+    email      text NOT NULL,
+    name       text,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
+
+-- Provider links and profiles belong to the tenant of the account they were
+-- created for; anything left over predates tenants and belongs to the default
+-- tenant. New rows default to the session tenant.
+ALTER TABLE oauth_providers
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+UPDATE oauth_providers
+SET tenant_id = auth.tenant_id
+FROM auth
+WHERE auth.id = oauth_providers.user_id;
+ALTER TABLE oauth_providers
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX oauth_providers_tenant_id_idx
+    ON oauth_providers (tenant_id, provider_name, provider_user_id);
+
+ALTER TABLE user_profiles
+    ADD COLUMN tenant_id BIGINT NOT NULL DEFAULT 1 REFERENCES tenants (id);
+UPDATE user_profiles
+SET tenant_id = auth.tenant_id
+FROM auth
+WHERE auth.id = user_profiles.id;
+ALTER TABLE user_profiles
+    ALTER COLUMN tenant_id SET DEFAULT app_current_tenant();
+CREATE INDEX user_profiles_tenant_id_idx ON user_profiles (tenant_id, email);
+
+ALTER TABLE oauth_providers ENABLE ROW LEVEL SECURITY;
+ALTER TABLE oauth_providers FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON oauth_providers
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
+
+ALTER TABLE user_profiles ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_profiles FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON user_profiles
+    USING (tenant_id = app_current_tenant())
+    WITH CHECK (tenant_id = app_current_tenant());
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS outbox_unsequenced_idx;
+DROP INDEX IF EXISTS outbox_tenant_id_aggregate_type_seq_idx;
+
+CREATE INDEX IF NOT EXISTS outbox_tenant_id_aggregate_type_id_idx
+    ON outbox (tenant_id, aggregate_type, id);
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS seq;
+DROP SEQUENCE IF EXISTS outbox_seq;
@@ -0,0 +1,30 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- Orders the outbox for consumers resuming from the last event they saw.
+-- Event IDs are handed out when events are written, not when they commit, so
+-- an event can become visible after one with a higher ID; numbers from this
+-- sequence are handed out once events have committed, like review_change_seq.
+CREATE SEQUENCE outbox_seq;
+
+-- NULL until the event has been sequenced
+ALTER TABLE outbox ADD COLUMN seq BIGINT UNIQUE;
+
+-- Events written before the sequence keep their ID order.
+UPDATE outbox
+SET seq = numbered.seq
+FROM (
+    SELECT existing.id, nextval('outbox_seq') AS seq
+    FROM (SELECT id FROM outbox ORDER BY id) existing
+) numbered
+WHERE outbox.id = numbered.id;
+
+DROP INDEX IF EXISTS outbox_tenant_id_aggregate_type_id_idx;
+
+CREATE INDEX outbox_tenant_id_aggregate_type_seq_idx
+    ON outbox (tenant_id, aggregate_type, seq)
+    WHERE seq IS NOT NULL;
+
+CREATE INDEX outbox_unsequenced_idx
+    ON outbox (tenant_id, id)
+    WHERE seq IS NULL;
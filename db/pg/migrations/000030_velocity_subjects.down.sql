@@ -0,0 +1,5 @@
+-- Counters only matter for a window or two and client addresses have no
+-- numeric subject, so they are dropped rather than converted back.
+TRUNCATE review_velocity_counters;
+ALTER TABLE review_velocity_counters ALTER COLUMN subject TYPE BIGINT USING subject::bigint;
+ALTER TABLE review_velocity_counters RENAME COLUMN subject TO subject_id;
@@ -0,0 +1,4 @@
+-- Reviews written without logging in are counted per client address, so
+-- velocity subjects become text: user IDs, product IDs or client addresses.
+ALTER TABLE review_velocity_counters RENAME COLUMN subject_id TO subject;
+ALTER TABLE review_velocity_counters ALTER COLUMN subject TYPE TEXT USING subject::text;
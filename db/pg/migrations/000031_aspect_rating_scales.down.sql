@@ -0,0 +1,3 @@
+ALTER TABLE review_aspect_ratings
+    DROP COLUMN IF EXISTS rating_value,
+    ALTER COLUMN rating TYPE SMALLINT USING round(rating);
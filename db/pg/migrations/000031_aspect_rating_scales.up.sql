@@ -0,0 +1,28 @@
+-- Backfills every tenant's rows; see Database Migration Commands in the README.
+SET row_security = off;
+
+-- Aspects are rated on the scale of their review. As in reviews, rating holds
+-- the value normalized to 1–5 so summaries aggregate across scales, and
+-- rating_value the value the reviewer gave.
+ALTER TABLE review_aspect_ratings
+    DROP CONSTRAINT review_aspect_ratings_rating_check,
+    ALTER COLUMN rating TYPE DOUBLE PRECISION,
+    ADD COLUMN rating_value DOUBLE PRECISION;
+
+-- Aspect ratings so far were given in 1–5 stars whatever the review's scale;
+-- they are mapped onto it, to the nearest step
+UPDATE review_aspect_ratings
+SET rating_value = reviews.rating_scale_min + round(
+    (review_aspect_ratings.rating - 1) / 4 * (reviews.rating_scale_max - reviews.rating_scale_min) / reviews.rating_scale_step
+) * reviews.rating_scale_step
+FROM reviews
+WHERE reviews.id = review_aspect_ratings.review_id;
+
+UPDATE review_aspect_ratings
+SET rating = 1 + (review_aspect_ratings.rating_value - reviews.rating_scale_min) / (reviews.rating_scale_max - reviews.rating_scale_min) * 4
+FROM reviews
+WHERE reviews.id = review_aspect_ratings.review_id;
+
+ALTER TABLE review_aspect_ratings
+    ALTER COLUMN rating_value SET NOT NULL,
+    ADD CONSTRAINT review_aspect_ratings_rating_check CHECK (rating BETWEEN 1 AND 5);
@@ -0,0 +1,12 @@
+-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (
+    entity_type,
+    entity_id,
+    action,
+    actor,
+    before,
+    after,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
@@ -0,0 +1,68 @@
+-- name: CreateCategory :one
+INSERT INTO categories (
+    slug,
+    name,
+    rating_scale_min,
+    rating_scale_max,
+    rating_scale_step,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetCategory :one
+SELECT * FROM categories
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListCategories :many
+SELECT * FROM categories
+WHERE tenant_id = sqlc.arg(tenant_id)
+ORDER BY slug
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: UpdateCategory :one
+UPDATE categories
+SET
+    slug = $1,
+    name = $2,
+    rating_scale_min = $3,
+    rating_scale_max = $4,
+    rating_scale_step = $5,
+    updated_at = NOW()
+WHERE id = $6 AND tenant_id = $7
+RETURNING *;
+
+-- name: DeleteCategory :execrows
+DELETE FROM categories
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListCategoryAspects :many
+SELECT * FROM category_aspects
+WHERE category_id = $1 AND tenant_id = $2
+ORDER BY position, id;
+
+-- name: UpsertCategoryAspect :one
+INSERT INTO category_aspects (
+    category_id,
+    key,
+    name,
+    required,
+    position,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (category_id, key) DO UPDATE
+SET
+    name = EXCLUDED.name,
+    required = EXCLUDED.required,
+    position = EXCLUDED.position
+RETURNING *;
+
+-- name: DeleteCategoryAspectsNotInKeys :exec
+-- Ratings of the deleted aspects go with them.
+DELETE FROM category_aspects
+WHERE category_id = sqlc.arg(category_id)
+AND tenant_id = sqlc.arg(tenant_id)
+AND key <> ALL(sqlc.arg(keys)::text[]);
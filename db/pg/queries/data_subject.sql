@@ -0,0 +1,307 @@
+-- Personal data held about a user, for access and erasure requests.
+
+-- name: ListReviewsByUser :many
+SELECT * FROM reviews
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY id;
+
+-- name: ListReviewVotesByUser :many
+SELECT * FROM review_votes
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY review_id;
+
+-- name: ListReviewReportsByReporter :many
+SELECT * FROM review_reports
+WHERE tenant_id = $1 AND reporter_id = $2
+ORDER BY id;
+
+-- name: ListProductQuestionsByUser :many
+SELECT * FROM product_questions
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY id;
+
+-- name: ListProductAnswersByUser :many
+SELECT * FROM product_answers
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY id;
+
+-- name: ListProductAnswerVotesByUser :many
+SELECT * FROM product_answer_votes
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY answer_id;
+
+-- name: ListReviewRepliesByAuthor :many
+SELECT * FROM review_replies
+WHERE tenant_id = $1 AND author_id = $2
+ORDER BY id;
+
+-- name: ListReviewAttachmentsByUser :many
+SELECT review_attachments.* FROM review_attachments
+JOIN reviews ON reviews.id = review_attachments.review_id
+WHERE review_attachments.tenant_id = sqlc.arg(tenant_id)
+AND reviews.tenant_id = sqlc.arg(tenant_id)
+AND reviews.user_id = sqlc.arg(user_id)
+ORDER BY review_attachments.id;
+
+-- name: ListOrdersByCustomer :many
+SELECT * FROM orders
+WHERE tenant_id = $1 AND customer_id = $2
+ORDER BY id;
+
+-- name: ListReviewInvitationsByCustomer :many
+SELECT * FROM review_invitations
+WHERE tenant_id = $1 AND customer_id = $2
+ORDER BY id;
+
+-- name: GetReviewerStatsByUser :one
+SELECT * FROM reviewer_stats
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: ListProductOwnershipsByUser :many
+SELECT * FROM product_owners
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY product_id;
+
+-- name: ListExportJobsByRequester :many
+SELECT * FROM export_jobs
+WHERE tenant_id = $1 AND requested_by = $2
+ORDER BY id;
+
+-- name: GetAuthAccount :one
+SELECT * FROM auth
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListUserProfilesByEmail :many
+SELECT * FROM user_profiles
+WHERE tenant_id = $1 AND email = $2
+ORDER BY created_at;
+
+-- name: ListOAuthProvidersByUser :many
+SELECT * FROM oauth_providers
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY created_at;
+
+-- name: EraseReviewVotesByUser :one
+-- Removes the user's votes and takes them off the reviews' vote counts.
+WITH removed AS (
+    DELETE FROM review_votes
+    WHERE review_votes.tenant_id = sqlc.arg(tenant_id) AND review_votes.user_id = sqlc.arg(user_id)
+    RETURNING review_votes.review_id, review_votes.helpful
+),
+totals AS (
+    SELECT
+        removed.review_id,
+        (COUNT(*) FILTER (WHERE removed.helpful))::int AS helpful,
+        (COUNT(*) FILTER (WHERE NOT removed.helpful))::int AS unhelpful
+    FROM removed
+    GROUP BY removed.review_id
+),
+adjusted AS (
+    UPDATE reviews
+    SET
+        helpful_count = reviews.helpful_count - totals.helpful,
+        unhelpful_count = reviews.unhelpful_count - totals.unhelpful
+    FROM totals
+    WHERE reviews.id = totals.review_id AND reviews.tenant_id = sqlc.arg(tenant_id)
+    RETURNING reviews.id
+)
+SELECT COUNT(*) FROM removed;
+
+-- name: EraseProductAnswerVotesByUser :one
+-- Removes the user's answer votes and takes them off the answers' vote counts.
+WITH removed AS (
+    DELETE FROM product_answer_votes
+    WHERE product_answer_votes.tenant_id = sqlc.arg(tenant_id) AND product_answer_votes.user_id = sqlc.arg(user_id)
+    RETURNING product_answer_votes.answer_id, product_answer_votes.helpful
+),
+totals AS (
+    SELECT
+        removed.answer_id,
+        (COUNT(*) FILTER (WHERE removed.helpful))::int AS helpful,
+        (COUNT(*) FILTER (WHERE NOT removed.helpful))::int AS unhelpful
+    FROM removed
+    GROUP BY removed.answer_id
+),
+adjusted AS (
+    UPDATE product_answers
+    SET
+        helpful_count = product_answers.helpful_count - totals.helpful,
+        unhelpful_count = product_answers.unhelpful_count - totals.unhelpful
+    FROM totals
+    WHERE product_answers.id = totals.answer_id AND product_answers.tenant_id = sqlc.arg(tenant_id)
+    RETURNING product_answers.id
+)
+SELECT COUNT(*) FROM removed;
+
+-- name: RedactAuditSnapshotsByUser :execrows
+-- Removes the snapshots of the user's reviews, replies, questions and answers
+-- from the audit trail; what was done to them stays on record. Runs before the
+-- user's content is deleted or anonymized.
+UPDATE audit_log
+SET
+    before = NULL,
+    after = NULL
+WHERE audit_log.tenant_id = sqlc.arg(tenant_id)
+AND (
+    (audit_log.entity_type = 'review' AND audit_log.entity_id IN (
+        SELECT reviews.id FROM reviews
+        WHERE reviews.tenant_id = sqlc.arg(tenant_id) AND reviews.user_id = sqlc.arg(user_id)
+    ))
+    OR (audit_log.entity_type = 'review_reply' AND audit_log.entity_id IN (
+        SELECT review_replies.id FROM review_replies
+        WHERE review_replies.tenant_id = sqlc.arg(tenant_id) AND review_replies.author_id = sqlc.arg(user_id)
+    ))
+    OR (audit_log.entity_type = 'product_question' AND audit_log.entity_id IN (
+        SELECT product_questions.id FROM product_questions
+        WHERE product_questions.tenant_id = sqlc.arg(tenant_id) AND product_questions.user_id = sqlc.arg(user_id)
+    ))
+    OR (audit_log.entity_type = 'product_answer' AND audit_log.entity_id IN (
+        SELECT product_answers.id FROM product_answers
+        WHERE product_answers.tenant_id = sqlc.arg(tenant_id) AND product_answers.user_id = sqlc.arg(user_id)
+    ))
+);
+
+-- name: RedactAuditActor :execrows
+UPDATE audit_log
+SET actor = 'erased'
+WHERE tenant_id = $1 AND actor = $2;
+
+-- name: RedactOutboxEventsByUser :many
+-- Cuts the events about the user's reviews down to the review and product
+-- IDs. Runs before the user's reviews are deleted or anonymized.
+UPDATE outbox
+SET payload = jsonb_build_object('id', outbox.payload->'id', 'product_id', outbox.payload->'product_id')
+WHERE outbox.tenant_id = sqlc.arg(tenant_id)
+AND outbox.aggregate_type = 'review'
+AND outbox.aggregate_id IN (
+    SELECT reviews.id FROM reviews
+    WHERE reviews.tenant_id = sqlc.arg(tenant_id) AND reviews.user_id = sqlc.arg(user_id)
+)
+RETURNING outbox.id;
+
+-- name: RedactWebhookDeliveriesByEvents :execrows
+-- Cuts the data of deliveries of the events down like the events themselves.
+UPDATE webhook_deliveries
+SET payload = jsonb_set(payload, '{data}', jsonb_build_object(
+    'id', payload->'data'->'id',
+    'product_id', payload->'data'->'product_id'
+))
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND event_id = ANY(sqlc.arg(event_ids)::bigint[]);
+
+-- name: DeleteVelocityCountersByUser :execrows
+DELETE FROM review_velocity_counters
+WHERE tenant_id = $1 AND scope = 'user' AND subject = $2;
+
+-- name: DeleteReviewReportsByReporter :execrows
+DELETE FROM review_reports
+WHERE tenant_id = $1 AND reporter_id = $2;
+
+-- name: AnonymizeReviewsByUser :many
+-- Detaches the reviews from their author; their content stays published.
+UPDATE reviews
+SET
+    user_id = 0,
+    created_by = NULL,
+    updated_at = NOW()
+WHERE tenant_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteReviewsByUser :many
+-- Removes the reviews together with their votes, replies and attachments.
+DELETE FROM reviews
+WHERE tenant_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: AnonymizeReviewRepliesByAuthor :execrows
+-- Replies speak for the brand, so only their author is removed.
+UPDATE review_replies
+SET author_id = 0
+WHERE tenant_id = $1 AND author_id = $2;
+
+-- name: AnonymizeProductQuestionsByUser :execrows
+UPDATE product_questions
+SET user_id = 0
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: DeleteProductQuestionsByUser :execrows
+-- Removes the questions together with their answers.
+DELETE FROM product_questions
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: AnonymizeProductAnswersByUser :execrows
+UPDATE product_answers
+SET user_id = 0
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: DeleteProductAnswersByUser :execrows
+-- Brand answers speak for the brand and are anonymized instead.
+DELETE FROM product_answers
+WHERE tenant_id = $1 AND user_id = $2 AND NOT brand;
+
+-- name: DeleteReviewerStatsByUser :execrows
+-- Runs after the user's reviews are deleted or anonymized, which empty the totals.
+DELETE FROM reviewer_stats
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: DeleteReviewInvitationsByCustomer :execrows
+DELETE FROM review_invitations
+WHERE tenant_id = $1 AND customer_id = $2;
+
+-- name: DeleteOrdersByCustomer :execrows
+DELETE FROM orders
+WHERE tenant_id = $1 AND customer_id = $2;
+
+-- name: DeleteProductOwnershipsByUser :execrows
+DELETE FROM product_owners
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: DeleteExportJobsByRequester :execrows
+DELETE FROM export_jobs
+WHERE tenant_id = $1 AND requested_by = $2;
+
+-- name: DeleteOAuthProvidersByUser :execrows
+DELETE FROM oauth_providers
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: DeleteUserProfilesByEmail :execrows
+DELETE FROM user_profiles
+WHERE tenant_id = $1 AND email = $2;
+
+-- name: DeleteAuthAccount :execrows
+DELETE FROM auth
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: RevokeTokens :exec
+INSERT INTO token_revocations (
+    user_id,
+    revoked_before,
+    tenant_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (tenant_id, user_id) DO UPDATE
+SET revoked_before = GREATEST(token_revocations.revoked_before, EXCLUDED.revoked_before);
+
+-- name: GetTokenRevocation :one
+SELECT revoked_before FROM token_revocations
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: CreateDataSubjectRequest :one
+INSERT INTO data_subject_requests (
+    kind,
+    user_id,
+    account_id,
+    requested_by,
+    review_policy,
+    summary,
+    archive_sha256,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: ListDataSubjectRequests :many
+SELECT * FROM data_subject_requests
+WHERE tenant_id = $1 AND user_id = $2
+ORDER BY fulfilled_at DESC, id DESC;
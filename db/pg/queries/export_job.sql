@@ -0,0 +1,53 @@
+-- name: CreateExportJob :one
+INSERT INTO export_jobs (
+    requested_by,
+    format,
+    gzip,
+    filters,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetExportJob :one
+SELECT * FROM export_jobs
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ClaimExportJob :one
+-- Takes the oldest queued job, or a running one whose worker has gone silent
+-- for longer than the lease.
+UPDATE export_jobs
+SET
+    status = 'running',
+    started_at = NOW()
+WHERE export_jobs.id = (
+    SELECT queued.id FROM export_jobs queued
+    WHERE queued.tenant_id = sqlc.arg(tenant_id)
+    AND (
+        queued.status = 'queued'
+        OR (queued.status = 'running' AND queued.started_at < NOW() - make_interval(secs => sqlc.arg(lease_seconds)::int))
+    )
+    ORDER BY queued.id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteExportJob :exec
+UPDATE export_jobs
+SET
+    status = 'succeeded',
+    blob_key = sqlc.arg(blob_key),
+    row_count = sqlc.arg(row_count),
+    size_bytes = sqlc.arg(size_bytes),
+    error = NULL,
+    finished_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: FailExportJob :exec
+UPDATE export_jobs
+SET
+    status = 'failed',
+    error = sqlc.arg(error),
+    finished_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
@@ -0,0 +1,69 @@
+-- name: CreateAuthUser :one
+INSERT INTO auth (
+    id, email, password_hash, status, tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, email, password_hash, status, created_at, updated_at, deleted_at;
+
+-- name: CreateOAuthProvider :one
+INSERT INTO oauth_providers (
+    id, user_id, provider_name, provider_user_id, email, name, 
+    access_token, refresh_token, expires_at, scopes, tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, created_at, updated_at;
+
+-- name: CreateUserProfile :one
+INSERT INTO user_profiles (
+    id, email, name, created_at, updated_at, tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, email, name, created_at, updated_at;
+
+-- name: GetAuthUserByEmail :one
+SELECT id, email, password_hash, status, created_at, updated_at, deleted_at
+FROM auth
+WHERE email = $1 AND tenant_id = $2;
+
+-- name: GetAuthUserByID :one
+SELECT id, email, password_hash, status, created_at, updated_at, deleted_at
+FROM auth
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: GetOAuthProviderByProviderID :one
+SELECT id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, created_at, updated_at
+FROM oauth_providers
+WHERE provider_name = $1 AND provider_user_id = $2 AND tenant_id = $3;
+
+-- name: GetUserProfileByEmail :one
+SELECT id, email, name, created_at, updated_at
+FROM user_profiles
+WHERE email = $1 AND tenant_id = $2;
+
+-- name: UpdateAuthUser :one
+UPDATE auth
+SET
+    email = $2,
+    status = $3,
+    updated_at = $4
+WHERE id = $1 AND tenant_id = $5
+RETURNING id, email, password_hash, status, created_at, updated_at, deleted_at;
+
+-- name: UpdateOAuthProvider :one
+UPDATE oauth_providers
+SET 
+    access_token = $2,
+    refresh_token = $3,
+    expires_at = $4,
+    updated_at = now()
+WHERE id = $1 AND tenant_id = $5
+RETURNING id, user_id, provider_name, provider_user_id, email, name, access_token, refresh_token, expires_at, created_at, updated_at;
+
+-- name: UpdateUserProfile :one
+UPDATE user_profiles
+SET
+    email = $2,
+    name = $3,
+    updated_at = $4
+WHERE id = $1 AND tenant_id = $5
+RETURNING id, email, name, created_at, updated_at;
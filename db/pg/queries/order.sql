@@ -0,0 +1,44 @@
+-- name: UpsertOrder :one
+INSERT INTO orders (
+    external_id,
+    customer_id,
+    product_id,
+    purchased_at,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (tenant_id, external_id, product_id) DO UPDATE
+SET
+    customer_id = EXCLUDED.customer_id,
+    purchased_at = EXCLUDED.purchased_at,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetLatestOrderForCustomerProduct :one
+SELECT * FROM orders
+WHERE customer_id = $1
+AND product_id = $2
+AND tenant_id = $3
+AND purchased_at <= NOW()
+ORDER BY purchased_at DESC
+LIMIT 1;
+
+-- name: MarkReviewsVerifiedByOrder :execrows
+-- Verifies reviews the customer wrote for the product after buying it.
+UPDATE reviews
+SET
+    verified_purchase = TRUE,
+    order_id = sqlc.arg(order_id)
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND user_id = sqlc.arg(customer_id)
+AND product_id = sqlc.arg(product_id)
+AND created_at >= sqlc.arg(purchased_at)
+AND verified_purchase = FALSE
+AND deleted_at IS NULL;
+
+-- name: GetOrderByExternalID :one
+SELECT * FROM orders
+WHERE external_id = $1
+AND product_id = $2
+AND tenant_id = $3;
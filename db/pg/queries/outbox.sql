@@ -0,0 +1,92 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ClaimOutboxEvents :many
+-- Locks the oldest due event of each aggregate. Later events of an aggregate
+-- wait until every earlier one was published or dead-lettered, which keeps
+-- delivery in order per aggregate; SKIP LOCKED lets relays run concurrently.
+SELECT * FROM outbox pending
+WHERE pending.tenant_id = sqlc.arg(tenant_id)
+AND pending.published_at IS NULL
+AND pending.dead_lettered_at IS NULL
+AND pending.next_attempt_at <= NOW()
+AND NOT EXISTS (
+    SELECT 1 FROM outbox earlier
+    WHERE earlier.tenant_id = pending.tenant_id
+    AND earlier.aggregate_type = pending.aggregate_type
+    AND earlier.aggregate_id = pending.aggregate_id
+    AND earlier.id < pending.id
+    AND earlier.published_at IS NULL
+    AND earlier.dead_lettered_at IS NULL
+)
+ORDER BY pending.id
+LIMIT sqlc.arg('limit')
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    published_at = NOW()
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    last_error = sqlc.arg(last_error),
+    next_attempt_at = sqlc.arg(next_attempt_at)
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: DeadLetterOutboxEvent :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    last_error = sqlc.arg(last_error),
+    dead_lettered_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: GetOutboxEvent :one
+SELECT * FROM outbox
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: LockOutboxSequencer :exec
+-- Serializes sequencing per tenant until the transaction ends, so that every
+-- batch is numbered after the previous one committed.
+SELECT pg_advisory_xact_lock(hashtext('outbox'), sqlc.arg(tenant_id)::int);
+
+-- name: SequenceOutboxEvents :execrows
+WITH pending AS (
+    SELECT unsequenced.id FROM outbox unsequenced
+    WHERE unsequenced.tenant_id = $1 AND unsequenced.seq IS NULL
+    ORDER BY unsequenced.id
+),
+numbered AS (
+    SELECT pending.id, nextval('outbox_seq') AS seq FROM pending
+)
+UPDATE outbox
+SET seq = numbered.seq
+FROM numbered
+WHERE outbox.id = numbered.id;
+
+-- name: GetLatestOutboxSeq :one
+SELECT COALESCE(MAX(seq), 0)::bigint FROM outbox
+WHERE tenant_id = sqlc.arg(tenant_id) AND aggregate_type = sqlc.arg(aggregate_type);
+
+-- name: ListOutboxEventsAfter :many
+-- Reads the event history of an aggregate type in commit order, for consumers
+-- resuming from the last event they saw.
+SELECT * FROM outbox
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND aggregate_type = sqlc.arg(aggregate_type)
+AND seq > sqlc.arg(after_seq)::bigint
+ORDER BY seq
+LIMIT sqlc.arg('limit');
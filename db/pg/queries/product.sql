@@ -0,0 +1,84 @@
+-- name: CreateProduct :one
+INSERT INTO products (
+    sku,
+    external_id,
+    name,
+    group_id,
+    category_id,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetProduct :one
+SELECT * FROM products
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: GetProductBySKU :one
+SELECT * FROM products
+WHERE sku = $1 AND tenant_id = $2;
+
+-- name: ListProducts :many
+SELECT * FROM products
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND (sqlc.arg(include_archived)::boolean OR archived_at IS NULL)
+AND (sqlc.narg(group_id)::bigint IS NULL OR id = sqlc.narg(group_id) OR group_id = sqlc.narg(group_id))
+ORDER BY id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: UpdateProduct :one
+UPDATE products
+SET
+    sku = sqlc.arg(sku),
+    external_id = sqlc.narg(external_id),
+    name = sqlc.arg(name),
+    group_id = sqlc.narg(group_id),
+    category_id = sqlc.narg(category_id),
+    archived_at = sqlc.narg(archived_at),
+    hold_new_reviews = sqlc.arg(hold_new_reviews),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id)
+RETURNING *;
+
+-- name: UpsertProductBySKU :one
+-- Used by the catalog sync; a synced product is live again even if it was archived.
+INSERT INTO products (
+    sku,
+    external_id,
+    name,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (tenant_id, sku) DO UPDATE
+SET
+    external_id = EXCLUDED.external_id,
+    name = EXCLUDED.name,
+    archived_at = NULL,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ArchiveProductsNotInSKUs :execrows
+UPDATE products
+SET
+    archived_at = NOW(),
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND archived_at IS NULL
+AND sku <> ALL(sqlc.arg(skus)::text[]);
+
+-- name: RegroupProducts :exec
+-- Moves every variant of one group into another.
+UPDATE products
+SET
+    group_id = sqlc.arg(to_group_id),
+    updated_at = NOW()
+WHERE group_id = sqlc.arg(from_group_id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: HoldProductReviews :exec
+UPDATE products
+SET
+    hold_new_reviews = TRUE,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND NOT hold_new_reviews;
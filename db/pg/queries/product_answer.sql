@@ -0,0 +1,87 @@
+-- name: CreateProductAnswer :one
+INSERT INTO product_answers (
+    question_id,
+    user_id,
+    body,
+    brand,
+    status,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetProductAnswer :one
+SELECT * FROM product_answers
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- name: ListProductAnswersByQuestionIDs :many
+-- Brand answers come first, then the most helpful.
+SELECT * FROM product_answers
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND question_id = ANY(sqlc.arg(question_ids)::bigint[])
+AND deleted_at IS NULL
+AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY question_id, brand DESC, helpful_score DESC, created_at;
+
+-- name: UpdateProductAnswer :one
+UPDATE product_answers
+SET
+    body = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SetProductAnswerStatus :one
+UPDATE product_answers
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteProductAnswer :execrows
+UPDATE product_answers
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- name: LockProductAnswer :one
+SELECT id FROM product_answers
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetProductAnswerVote :one
+SELECT * FROM product_answer_votes
+WHERE answer_id = $1 AND user_id = $2 AND tenant_id = $3;
+
+-- name: UpsertProductAnswerVote :one
+INSERT INTO product_answer_votes (
+    answer_id,
+    user_id,
+    helpful,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (answer_id, user_id) DO UPDATE
+SET
+    helpful = EXCLUDED.helpful,
+    updated_at = NOW()
+WHERE product_answer_votes.tenant_id = EXCLUDED.tenant_id
+RETURNING *;
+
+-- name: DeleteProductAnswerVote :one
+DELETE FROM product_answer_votes
+WHERE answer_id = $1 AND user_id = $2 AND tenant_id = $3
+RETURNING *;
+
+-- name: AdjustProductAnswerVoteCounts :exec
+UPDATE product_answers
+SET
+    helpful_count = helpful_count + sqlc.arg(helpful_delta)::int,
+    unhelpful_count = unhelpful_count + sqlc.arg(unhelpful_delta)::int
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: DeleteProductAnswersByQuestion :exec
+UPDATE product_answers
+SET deleted_at = NOW()
+WHERE question_id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
@@ -0,0 +1,24 @@
+-- name: IsProductOwner :one
+SELECT EXISTS (
+    SELECT 1 FROM product_owners
+    WHERE product_id = $1 AND user_id = $2 AND tenant_id = $3
+);
+
+-- name: ListProductOwners :many
+SELECT * FROM product_owners
+WHERE product_id = $1 AND tenant_id = $2
+ORDER BY created_at, user_id;
+
+-- name: AddProductOwner :execrows
+INSERT INTO product_owners (
+    product_id,
+    user_id,
+    tenant_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (product_id, user_id) DO NOTHING;
+
+-- name: RemoveProductOwner :execrows
+DELETE FROM product_owners
+WHERE product_id = $1 AND user_id = $2 AND tenant_id = $3;
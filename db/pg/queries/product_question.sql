@@ -0,0 +1,78 @@
+-- name: CreateProductQuestion :one
+INSERT INTO product_questions (
+    product_id,
+    user_id,
+    body,
+    status,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetProductQuestion :one
+SELECT * FROM product_questions
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- name: ListProductQuestions :many
+-- Questions about the product and its variants, newest first.
+SELECT * FROM product_questions
+WHERE product_questions.tenant_id = sqlc.arg(tenant_id)
+AND deleted_at IS NULL
+AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+AND product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = sqlc.arg(product_id)
+    AND requested.tenant_id = sqlc.arg(tenant_id)
+    AND variant.tenant_id = sqlc.arg(tenant_id)
+)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: UpdateProductQuestion :one
+UPDATE product_questions
+SET
+    body = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SetProductQuestionStatus :one
+UPDATE product_questions
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteProductQuestion :execrows
+UPDATE product_questions
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- name: SummarizeProductQuestions :one
+-- Counts the approved questions about the product and its variants, those
+-- with an approved answer, and their approved answers.
+SELECT
+    COUNT(*) AS question_count,
+    COUNT(*) FILTER (WHERE answers.answer_count > 0) AS answered_count,
+    COALESCE(SUM(answers.answer_count), 0)::bigint AS answer_count
+FROM product_questions
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS answer_count FROM product_answers
+    WHERE product_answers.question_id = product_questions.id
+    AND product_answers.tenant_id = sqlc.arg(tenant_id)
+    AND product_answers.deleted_at IS NULL
+    AND product_answers.status = 'approved'
+) answers
+WHERE product_questions.tenant_id = sqlc.arg(tenant_id)
+AND product_questions.deleted_at IS NULL
+AND product_questions.status = 'approved'
+AND product_questions.product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = sqlc.arg(product_id)
+    AND requested.tenant_id = sqlc.arg(tenant_id)
+    AND variant.tenant_id = sqlc.arg(tenant_id)
+);
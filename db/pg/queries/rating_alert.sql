@@ -0,0 +1,59 @@
+-- name: ListRatingWindowStats :many
+-- Compares each product's reviews since window_start with those received
+-- between baseline_start and window_start. Only products with at least
+-- min_reviews reviews in the window are returned.
+SELECT
+    product_id,
+    COUNT(*) FILTER (WHERE created_at >= sqlc.arg(window_start)) AS window_count,
+    COALESCE(AVG(rating) FILTER (WHERE created_at >= sqlc.arg(window_start)), 0)::float8 AS window_mean,
+    COUNT(*) FILTER (WHERE created_at < sqlc.arg(window_start)) AS baseline_count,
+    COALESCE(AVG(rating) FILTER (WHERE created_at < sqlc.arg(window_start)), 0)::float8 AS baseline_mean,
+    COALESCE(STDDEV_SAMP(rating) FILTER (WHERE created_at < sqlc.arg(window_start)), 0)::float8 AS baseline_stddev
+FROM reviews
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND deleted_at IS NULL
+AND created_at >= sqlc.arg(baseline_start)
+GROUP BY product_id
+HAVING COUNT(*) FILTER (WHERE created_at >= sqlc.arg(window_start)) >= sqlc.arg(min_reviews)::bigint;
+
+-- name: CreateRatingAlert :one
+-- Returns no row when the product already has an unresolved alert of the kind.
+INSERT INTO rating_alerts (
+    product_id,
+    kind,
+    window_start,
+    window_end,
+    review_count,
+    mean_rating,
+    expected_count,
+    baseline_mean,
+    baseline_stddev,
+    score,
+    product_held,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+ON CONFLICT (tenant_id, product_id, kind) WHERE status <> 'resolved' DO NOTHING
+RETURNING *;
+
+-- name: GetRatingAlert :one
+SELECT * FROM rating_alerts
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListRatingAlerts :many
+SELECT * FROM rating_alerts
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+AND (sqlc.narg(product_id)::bigint IS NULL OR product_id = sqlc.narg(product_id))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: UpdateRatingAlertStatus :one
+UPDATE rating_alerts
+SET
+    status = sqlc.arg(status),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id)
+RETURNING *;
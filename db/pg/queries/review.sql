@@ -4,34 +4,116 @@ INSERT INTO reviews (
     product_id,
     rating,
     comment,
-    created_by
+    created_by,
+    verified_purchase,
+    order_id,
+    status,
+    comment_simhash,
+    sentiment_score,
+    sentiment_label,
+    sentiment_mismatch,
+    detected_language,
+    locale,
+    language,
+    rating_value,
+    rating_scale_min,
+    rating_scale_max,
+    rating_scale_step,
+    publish_at,
+    published_at,
+    tenant_id
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 ) RETURNING *;
 
 -- name: GetReview :one
 SELECT * FROM reviews
-WHERE id = $1 AND deleted_at IS NULL;
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
 
 -- name: ListReviews :many
 SELECT * FROM reviews
-WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1
-OFFSET $2;
+WHERE reviews.tenant_id = sqlc.arg(tenant_id)
+AND deleted_at IS NULL
+AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+AND (sqlc.narg(verified_purchase)::boolean IS NULL OR verified_purchase = sqlc.narg(verified_purchase))
+AND (sqlc.narg(sentiment_label)::text IS NULL OR sentiment_label = sqlc.narg(sentiment_label))
+AND (sqlc.narg(sentiment_mismatch)::boolean IS NULL OR sentiment_mismatch = sqlc.narg(sentiment_mismatch))
+AND (sqlc.narg(language)::text IS NULL OR language = sqlc.narg(language))
+AND (sqlc.narg(published)::boolean IS NULL OR (published_at IS NOT NULL) = sqlc.narg(published))
+AND (sqlc.narg(product_id)::bigint IS NULL OR product_id IN (
+    -- Variants of a product share its reviews
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = sqlc.narg(product_id)
+    AND requested.tenant_id = sqlc.arg(tenant_id)
+    AND variant.tenant_id = sqlc.arg(tenant_id)
+))
+ORDER BY
+    -- Reviews in the preferred languages come first, in order of preference
+    array_position(sqlc.arg(preferred_languages)::text[], language) NULLS LAST,
+    -- Relevance weighs helpfulness and the author's reputation equally
+    CASE WHEN sqlc.arg(sort)::text = 'relevant' THEN 0.5 * helpful_score + 0.005 * COALESCE((
+        SELECT reputation FROM reviewer_stats
+        WHERE reviewer_stats.tenant_id = reviews.tenant_id
+        AND reviewer_stats.user_id = reviews.user_id
+    ), 0) END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'most_helpful' THEN helpful_score END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'verified' THEN verified_purchase END DESC,
+    created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
 
 -- name: UpdateReview :one
 UPDATE reviews
 SET
     rating = COALESCE(sqlc.narg(rating), rating),
+    rating_value = COALESCE(sqlc.narg(rating_value), rating_value),
     comment = COALESCE(sqlc.narg(comment), comment),
+    comment_simhash = sqlc.narg(comment_simhash),
+    sentiment_score = sqlc.narg(sentiment_score),
+    sentiment_label = sqlc.narg(sentiment_label),
+    sentiment_mismatch = sqlc.arg(sentiment_mismatch),
+    detected_language = sqlc.narg(detected_language),
+    locale = sqlc.narg(locale),
+    language = sqlc.narg(language),
     updated_at = NOW()
 WHERE
-    id = $1
+    id = sqlc.arg(id)
+AND tenant_id = sqlc.arg(tenant_id)
 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: SetReviewStatus :one
+UPDATE reviews
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
+RETURNING *;
+
 -- name: DeleteReview :exec
 UPDATE reviews
 SET deleted_at = NOW()
-WHERE id = $1;
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListReviewsByIDs :many
+SELECT * FROM reviews
+WHERE tenant_id = sqlc.arg(tenant_id) AND id = ANY(sqlc.arg(ids)::bigint[]) AND deleted_at IS NULL;
+
+-- name: PublishDueReviews :many
+-- Publishes up to limit reviews whose cooling-off period is over. Reviews
+-- being published by another instance are skipped.
+UPDATE reviews
+SET published_at = NOW()
+WHERE id IN (
+    SELECT id FROM reviews due
+    WHERE due.tenant_id = sqlc.arg(tenant_id)
+    AND due.published_at IS NULL
+    AND due.deleted_at IS NULL
+    AND due.publish_at <= NOW()
+    ORDER BY due.publish_at
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+AND tenant_id = sqlc.arg(tenant_id)
+RETURNING *;
@@ -0,0 +1,85 @@
+-- name: DeleteReviewAspectRatings :exec
+DELETE FROM review_aspect_ratings
+WHERE review_id = $1 AND tenant_id = $2;
+
+-- name: CreateReviewAspectRating :exec
+INSERT INTO review_aspect_ratings (
+    review_id,
+    aspect_id,
+    rating,
+    rating_value,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: ListReviewAspectRatings :many
+-- Aspects are rated on the scale of their review.
+SELECT
+    review_aspect_ratings.review_id,
+    review_aspect_ratings.aspect_id,
+    category_aspects.key,
+    review_aspect_ratings.rating_value,
+    reviews.rating_scale_min,
+    reviews.rating_scale_max,
+    reviews.rating_scale_step
+FROM review_aspect_ratings
+JOIN category_aspects ON category_aspects.id = review_aspect_ratings.aspect_id
+JOIN reviews ON reviews.id = review_aspect_ratings.review_id
+WHERE review_aspect_ratings.tenant_id = sqlc.arg(tenant_id)
+AND reviews.tenant_id = sqlc.arg(tenant_id)
+AND review_aspect_ratings.review_id = ANY(sqlc.arg(review_ids)::bigint[])
+ORDER BY review_aspect_ratings.review_id, category_aspects.position, category_aspects.id;
+
+-- name: SummarizeProductRatings :many
+-- Counts the published approved reviews of the product and its variants per
+-- whole normalized star, and sums their normalized ratings.
+SELECT
+    round(rating)::int AS stars,
+    COUNT(*) AS review_count,
+    SUM(rating)::float8 AS rating_sum
+FROM reviews
+WHERE reviews.tenant_id = sqlc.arg(tenant_id)
+AND deleted_at IS NULL
+AND status = 'approved'
+AND published_at IS NOT NULL
+AND product_id IN (
+    SELECT variant.id FROM products variant
+    JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+    WHERE requested.id = sqlc.arg(product_id)
+    AND requested.tenant_id = sqlc.arg(tenant_id)
+    AND variant.tenant_id = sqlc.arg(tenant_id)
+)
+GROUP BY stars
+ORDER BY stars;
+
+-- name: SummarizeProductAspects :many
+-- Averages the ratings of each aspect of the category over the published
+-- approved reviews of the product and its variants. Aspects nobody rated yet
+-- are included with a count of zero.
+SELECT
+    category_aspects.key,
+    category_aspects.name,
+    COUNT(rated.rating) AS rating_count,
+    COALESCE(AVG(rated.rating), 0)::float8 AS average_rating
+FROM category_aspects
+LEFT JOIN (
+    SELECT review_aspect_ratings.aspect_id, review_aspect_ratings.rating
+    FROM review_aspect_ratings
+    JOIN reviews ON reviews.id = review_aspect_ratings.review_id
+    WHERE reviews.tenant_id = sqlc.arg(tenant_id)
+    AND reviews.deleted_at IS NULL
+    AND reviews.status = 'approved'
+    AND reviews.published_at IS NOT NULL
+    AND reviews.product_id IN (
+        SELECT variant.id FROM products variant
+        JOIN products requested ON COALESCE(variant.group_id, variant.id) = COALESCE(requested.group_id, requested.id)
+        WHERE requested.id = sqlc.arg(product_id)
+        AND requested.tenant_id = sqlc.arg(tenant_id)
+        AND variant.tenant_id = sqlc.arg(tenant_id)
+    )
+) rated ON rated.aspect_id = category_aspects.id
+WHERE category_aspects.tenant_id = sqlc.arg(tenant_id)
+AND category_aspects.category_id = sqlc.arg(category_id)
+GROUP BY category_aspects.id
+ORDER BY category_aspects.position, category_aspects.id;
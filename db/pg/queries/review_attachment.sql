@@ -0,0 +1,33 @@
+-- name: CreateReviewAttachment :one
+INSERT INTO review_attachments (
+    review_id,
+    blob_key,
+    thumbnail_key,
+    content_type,
+    size_bytes,
+    width,
+    height,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetReviewAttachment :one
+SELECT * FROM review_attachments
+WHERE id = $1 AND review_id = $2 AND tenant_id = $3 AND deleted_at IS NULL;
+
+-- name: ListReviewAttachmentsByReviewIDs :many
+SELECT * FROM review_attachments
+WHERE review_id = ANY(sqlc.arg(review_ids)::bigint[])
+AND tenant_id = sqlc.arg(tenant_id)
+AND deleted_at IS NULL
+ORDER BY id;
+
+-- name: CountReviewAttachments :one
+SELECT COUNT(*) FROM review_attachments
+WHERE review_id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- name: DeleteReviewAttachment :exec
+UPDATE review_attachments
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2;
@@ -0,0 +1,24 @@
+-- name: LockReviewChangeSequencer :exec
+-- Serializes sequencing per tenant until the transaction ends, so that every
+-- batch is numbered after the previous one committed.
+SELECT pg_advisory_xact_lock(hashtext('review_changes'), sqlc.arg(tenant_id)::int);
+
+-- name: SequenceReviewChanges :execrows
+WITH pending AS (
+    SELECT unsequenced.id FROM review_changes unsequenced
+    WHERE unsequenced.tenant_id = $1 AND unsequenced.seq IS NULL
+    ORDER BY unsequenced.id
+),
+numbered AS (
+    SELECT pending.id, nextval('review_change_seq') AS seq FROM pending
+)
+UPDATE review_changes
+SET seq = numbered.seq
+FROM numbered
+WHERE review_changes.id = numbered.id;
+
+-- name: ListReviewChangesAfter :many
+SELECT * FROM review_changes
+WHERE tenant_id = sqlc.arg(tenant_id) AND seq > sqlc.arg(after_seq)::bigint
+ORDER BY seq
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,37 @@
+-- name: ListSimHashCandidates :many
+-- Recent reviews whose fingerprint shares a band with the given one.
+SELECT id, comment_simhash::bigint AS comment_simhash FROM reviews
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND comment_simhash IS NOT NULL
+AND deleted_at IS NULL
+AND id <> sqlc.arg(review_id)
+AND created_at >= sqlc.arg(since)
+AND (
+    ((comment_simhash >> 48) & 65535) = sqlc.arg(band0)::bigint
+    OR ((comment_simhash >> 32) & 65535) = sqlc.arg(band1)::bigint
+    OR ((comment_simhash >> 16) & 65535) = sqlc.arg(band2)::bigint
+    OR (comment_simhash & 65535) = sqlc.arg(band3)::bigint
+)
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: DeleteReviewDuplicates :exec
+DELETE FROM review_duplicates
+WHERE review_id = $1 AND tenant_id = $2;
+
+-- name: CreateReviewDuplicate :exec
+INSERT INTO review_duplicates (
+    review_id,
+    original_id,
+    distance,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (review_id, original_id) DO UPDATE
+SET distance = EXCLUDED.distance;
+
+-- name: ListReviewDuplicates :many
+SELECT * FROM review_duplicates
+WHERE review_id = $1 AND tenant_id = $2
+ORDER BY distance, original_id;
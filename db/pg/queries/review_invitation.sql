@@ -0,0 +1,55 @@
+-- name: IssueReviewInvitation :one
+-- Issuing again for the same order returns the existing invitation, with its
+-- expiry extended unless it was redeemed.
+INSERT INTO review_invitations (
+    order_id,
+    customer_id,
+    product_id,
+    expires_at,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (tenant_id, order_id) DO UPDATE
+SET expires_at = CASE
+    WHEN review_invitations.completed_at IS NULL
+    THEN GREATEST(review_invitations.expires_at, EXCLUDED.expires_at)
+    ELSE review_invitations.expires_at
+END
+RETURNING *;
+
+-- name: GetReviewInvitation :one
+SELECT * FROM review_invitations
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: OpenReviewInvitation :one
+UPDATE review_invitations
+SET opened_at = COALESCE(opened_at, NOW())
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- name: CompleteReviewInvitation :one
+-- Redeems the invitation unless it was redeemed already or has expired.
+UPDATE review_invitations
+SET
+    opened_at = COALESCE(opened_at, NOW()),
+    completed_at = NOW(),
+    review_id = sqlc.arg(review_id)
+WHERE id = sqlc.arg(id)
+AND tenant_id = sqlc.arg(tenant_id)
+AND completed_at IS NULL
+AND expires_at > NOW()
+RETURNING *;
+
+-- name: SummarizeReviewInvitations :one
+-- Conversion of the invitations issued in the period.
+SELECT
+    COUNT(*) AS issued,
+    COUNT(*) FILTER (WHERE opened_at IS NOT NULL) AS opened,
+    COUNT(*) FILTER (WHERE completed_at IS NOT NULL) AS completed,
+    COUNT(*) FILTER (WHERE completed_at IS NULL AND expires_at <= NOW()) AS expired
+FROM review_invitations
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND (sqlc.narg(product_id)::bigint IS NULL OR product_id = sqlc.narg(product_id))
+AND (sqlc.narg(issued_from)::timestamptz IS NULL OR created_at >= sqlc.narg(issued_from))
+AND (sqlc.narg(issued_to)::timestamptz IS NULL OR created_at < sqlc.narg(issued_to));
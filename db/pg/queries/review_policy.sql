@@ -0,0 +1,74 @@
+-- name: UpsertProductReviewPolicy :one
+INSERT INTO review_policies (
+    product_id,
+    min_comment_length,
+    comment_required,
+    verified_only,
+    moderation,
+    closed,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (product_id) WHERE product_id IS NOT NULL DO UPDATE SET
+    min_comment_length = EXCLUDED.min_comment_length,
+    comment_required = EXCLUDED.comment_required,
+    verified_only = EXCLUDED.verified_only,
+    moderation = EXCLUDED.moderation,
+    closed = EXCLUDED.closed,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: UpsertCategoryReviewPolicy :one
+INSERT INTO review_policies (
+    category_id,
+    min_comment_length,
+    comment_required,
+    verified_only,
+    moderation,
+    closed,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (category_id) WHERE category_id IS NOT NULL DO UPDATE SET
+    min_comment_length = EXCLUDED.min_comment_length,
+    comment_required = EXCLUDED.comment_required,
+    verified_only = EXCLUDED.verified_only,
+    moderation = EXCLUDED.moderation,
+    closed = EXCLUDED.closed,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetProductReviewPolicy :one
+SELECT * FROM review_policies
+WHERE product_id = $1 AND tenant_id = $2;
+
+-- name: GetCategoryReviewPolicy :one
+SELECT * FROM review_policies
+WHERE category_id = $1 AND tenant_id = $2;
+
+-- name: DeleteProductReviewPolicy :execrows
+DELETE FROM review_policies
+WHERE product_id = $1 AND tenant_id = $2;
+
+-- name: DeleteCategoryReviewPolicy :execrows
+DELETE FROM review_policies
+WHERE category_id = $1 AND tenant_id = $2;
+
+-- name: ListApplicableReviewPolicies :many
+-- Returns the policies of the product, of its group's primary product and of
+-- its category, most specific first.
+SELECT * FROM review_policies
+WHERE tenant_id = sqlc.arg(tenant_id)
+AND (
+    product_id = sqlc.arg(product_id)
+    OR product_id = sqlc.arg(group_id)
+    OR category_id = sqlc.narg(category_id)
+)
+ORDER BY
+    CASE
+        WHEN product_id = sqlc.arg(product_id) THEN 0
+        WHEN product_id IS NOT NULL THEN 1
+        ELSE 2
+    END;
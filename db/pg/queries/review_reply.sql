@@ -0,0 +1,35 @@
+-- name: CreateReviewReply :one
+INSERT INTO review_replies (
+    review_id,
+    author_id,
+    body,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetReviewReplyByReviewID :one
+SELECT * FROM review_replies
+WHERE review_id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- name: ListReviewRepliesByReviewIDs :many
+SELECT * FROM review_replies
+WHERE review_id = ANY(sqlc.arg(review_ids)::bigint[])
+AND tenant_id = sqlc.arg(tenant_id)
+AND deleted_at IS NULL;
+
+-- name: UpdateReviewReply :one
+UPDATE review_replies
+SET
+    body = $2,
+    updated_at = NOW()
+WHERE
+    id = $1
+AND tenant_id = $3
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteReviewReply :exec
+UPDATE review_replies
+SET deleted_at = NOW()
+WHERE id = $1 AND tenant_id = $2;
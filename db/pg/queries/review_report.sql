@@ -0,0 +1,54 @@
+-- name: CreateReviewReport :one
+INSERT INTO review_reports (
+    review_id,
+    reporter_id,
+    reason,
+    details,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: CountOpenReviewReports :one
+SELECT COUNT(*) FROM review_reports
+WHERE review_id = $1 AND tenant_id = $2 AND status = 'open';
+
+-- name: ListReviewReports :many
+SELECT * FROM review_reports
+WHERE review_id = $1 AND tenant_id = $2
+ORDER BY created_at, id;
+
+-- name: ListReportedReviews :many
+-- Counts the reports of each reported review by reason and resolution, most
+-- open reports first. Deleted reviews are left out.
+SELECT
+    review_reports.review_id,
+    COUNT(*) FILTER (WHERE review_reports.status = 'open') AS open_count,
+    COUNT(*) FILTER (WHERE review_reports.status = 'upheld') AS upheld_count,
+    COUNT(*) FILTER (WHERE review_reports.status = 'dismissed') AS dismissed_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'spam') AS spam_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'offensive') AS offensive_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'fake') AS fake_count,
+    COUNT(*) FILTER (WHERE review_reports.reason = 'other') AS other_count,
+    MAX(review_reports.created_at)::timestamptz AS last_reported_at
+FROM review_reports
+JOIN reviews ON reviews.id = review_reports.review_id
+WHERE review_reports.tenant_id = sqlc.arg(tenant_id)
+AND reviews.tenant_id = sqlc.arg(tenant_id)
+AND reviews.deleted_at IS NULL
+GROUP BY review_reports.review_id
+HAVING NOT sqlc.arg(open_only)::boolean OR COUNT(*) FILTER (WHERE review_reports.status = 'open') > 0
+ORDER BY open_count DESC, last_reported_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ResolveReviewReports :many
+UPDATE review_reports
+SET
+    status = sqlc.arg(status),
+    resolved_by = sqlc.arg(resolved_by),
+    resolved_at = NOW()
+WHERE review_id = sqlc.arg(review_id)
+AND tenant_id = sqlc.arg(tenant_id)
+AND status = 'open'
+RETURNING *;
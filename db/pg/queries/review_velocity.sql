@@ -0,0 +1,24 @@
+-- name: HitVelocityCounter :one
+-- Counts a review in the current window and returns the counts of the current
+-- and the previous window.
+WITH hit AS (
+    INSERT INTO review_velocity_counters (tenant_id, scope, subject, window_start, count)
+    VALUES (sqlc.arg(tenant_id), sqlc.arg(scope), sqlc.arg(subject), sqlc.arg(window_start), 1)
+    ON CONFLICT (tenant_id, scope, subject, window_start)
+    DO UPDATE SET count = review_velocity_counters.count + 1
+    RETURNING review_velocity_counters.count
+)
+SELECT
+    hit.count AS current_count,
+    COALESCE((
+        SELECT previous.count FROM review_velocity_counters previous
+        WHERE previous.tenant_id = sqlc.arg(tenant_id)
+        AND previous.scope = sqlc.arg(scope)
+        AND previous.subject = sqlc.arg(subject)
+        AND previous.window_start = sqlc.arg(previous_window_start)
+    ), 0)::int AS previous_count
+FROM hit;
+
+-- name: PruneVelocityCounters :execrows
+DELETE FROM review_velocity_counters
+WHERE tenant_id = sqlc.arg(tenant_id) AND window_start < sqlc.arg(before);
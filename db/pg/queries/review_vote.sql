@@ -0,0 +1,36 @@
+-- name: LockReview :one
+SELECT id FROM reviews
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetReviewVote :one
+SELECT * FROM review_votes
+WHERE review_id = $1 AND user_id = $2 AND tenant_id = $3;
+
+-- name: UpsertReviewVote :one
+INSERT INTO review_votes (
+    review_id,
+    user_id,
+    helpful,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (review_id, user_id) DO UPDATE
+SET
+    helpful = EXCLUDED.helpful,
+    updated_at = NOW()
+WHERE review_votes.tenant_id = EXCLUDED.tenant_id
+RETURNING *;
+
+-- name: DeleteReviewVote :one
+DELETE FROM review_votes
+WHERE review_id = $1 AND user_id = $2 AND tenant_id = $3
+RETURNING *;
+
+-- name: AdjustReviewVoteCounts :exec
+UPDATE reviews
+SET
+    helpful_count = helpful_count + sqlc.arg(helpful_delta)::int,
+    unhelpful_count = unhelpful_count + sqlc.arg(unhelpful_delta)::int
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
@@ -0,0 +1,3 @@
+-- name: GetReviewerStats :one
+SELECT * FROM reviewer_stats
+WHERE tenant_id = $1 AND user_id = $2;
@@ -0,0 +1,12 @@
+-- name: GetTenantBySlug :one
+SELECT * FROM tenants
+WHERE slug = $1;
+
+-- name: GetTenantByHostname :one
+SELECT tenants.* FROM tenants
+JOIN tenant_domains ON tenant_domains.tenant_id = tenants.id
+WHERE tenant_domains.hostname = $1;
+
+-- name: ListTenants :many
+SELECT * FROM tenants
+ORDER BY id;
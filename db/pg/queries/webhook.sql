@@ -0,0 +1,113 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    url,
+    secret,
+    event_types,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE tenant_id = $1
+ORDER BY id;
+
+-- name: ListActiveWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE tenant_id = $1 AND active
+ORDER BY id;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET
+    url = sqlc.arg(url),
+    event_types = sqlc.arg(event_types),
+    active = sqlc.arg(active),
+    consecutive_failures = sqlc.arg(consecutive_failures),
+    disabled_at = sqlc.narg(disabled_at),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id)
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: RecordWebhookSubscriptionSuccess :exec
+UPDATE webhook_subscriptions
+SET consecutive_failures = 0
+WHERE id = $1 AND tenant_id = $2 AND consecutive_failures <> 0;
+
+-- name: RecordWebhookSubscriptionFailure :one
+-- Counts a failed attempt and disables the subscription once disable_after
+-- attempts in a row have failed.
+UPDATE webhook_subscriptions
+SET
+    consecutive_failures = consecutive_failures + 1,
+    active = active AND consecutive_failures + 1 < sqlc.arg(disable_after)::int,
+    disabled_at = CASE
+        WHEN active AND consecutive_failures + 1 >= sqlc.arg(disable_after)::int THEN NOW()
+        ELSE disabled_at
+    END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id)
+RETURNING *;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_id,
+    event_type,
+    payload,
+    tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 AND subscription_id = $2 AND tenant_id = $3;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id)
+AND tenant_id = sqlc.arg(tenant_id)
+AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ClaimWebhookDeliveries :many
+-- Leases due deliveries of active subscriptions by pushing their next attempt
+-- past the lease, so that concurrent dispatchers skip them while they are sent.
+UPDATE webhook_deliveries
+SET next_attempt_at = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::int)
+WHERE webhook_deliveries.id IN (
+    SELECT due.id FROM webhook_deliveries due
+    JOIN webhook_subscriptions subscription ON subscription.id = due.subscription_id
+    WHERE due.tenant_id = sqlc.arg(tenant_id)
+    AND due.status = 'pending'
+    AND due.next_attempt_at <= NOW()
+    AND subscription.active
+    ORDER BY due.next_attempt_at
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE OF due SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET
+    status = sqlc.arg(status),
+    attempts = attempts + 1,
+    last_attempt_at = NOW(),
+    response_status = sqlc.narg(response_status),
+    last_error = sqlc.narg(last_error),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    delivered_at = COALESCE(delivered_at, CASE WHEN sqlc.arg(status) = 'succeeded' THEN NOW() END)
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);